@@ -2,6 +2,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -9,75 +10,147 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"github.com/milansax96/movie-terminal-api/config"
 	"github.com/milansax96/movie-terminal-api/internal/database"
 	"github.com/milansax96/movie-terminal-api/internal/handlers"
 	"github.com/milansax96/movie-terminal-api/internal/middleware"
+	"github.com/milansax96/movie-terminal-api/internal/models"
 	"github.com/milansax96/movie-terminal-api/internal/repository"
 	"github.com/milansax96/movie-terminal-api/internal/service"
+	"github.com/milansax96/movie-terminal-api/internal/signing"
 	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
 )
 
 func main() {
 	testToken := flag.Bool("test-token", false, "Print a valid JWT for testing and exit")
-	userID := flag.String("user-id", "test-user", "User ID to embed in the test token")
+	userID := flag.String("user-id", "", "ID of the existing user to start a test session for")
+	grantAdmin := flag.String("grant-admin", "", "Give the user with this ID the admin role and exit")
 	flag.Parse()
 
 	cfg := config.Load()
 
-	if *testToken {
-		token, err := generateTestToken(*userID, cfg.JWTSecret)
-		if err != nil {
-			log.Fatalf("Failed to generate token: %v", err)
-		}
-
-		fmt.Print(token)
-		os.Exit(0)
-	}
-
 	db := database.InitDB(cfg)
 	database.Migrate(db)
 
 	tmdbClient := tmdb.NewCachedClient(tmdb.NewClient())
 
 	// Repositories
-	userRepo := repository.NewUserRepository(db)
-	watchlistRepo := repository.NewWatchlistRepository(db)
+	watchlistRepo := repository.NewCachedWatchlistRepository(repository.NewWatchlistRepository(db))
+	userRepo := repository.NewUserRepository(db, watchlistRepo.Invalidate)
 	friendshipRepo := repository.NewFriendshipRepository(db)
 	postRepo := repository.NewPostRepository(db)
+	sessionRepo := repository.NewSessionRepository(db)
+	deviceRepo := repository.NewDeviceAuthRepository(db)
+	tokenRepo := repository.NewTokenRepository(db)
+	identityRepo := repository.NewIdentityRepository(db)
+	streamingRepo := repository.NewStreamingServiceRepository(db)
+	availabilityRepo := repository.NewAvailabilityRepository(db)
+	progressRepo := repository.NewProgressRepository(db)
+	diaryRepo := repository.NewDiaryRepository(db, watchlistRepo.Invalidate)
+	listRepo := repository.NewListRepository(db)
+
+	keys, err := signing.LoadKeySet(cfg.JWTKeysDir, cfg.JWTActiveKID, cfg.JWTSecret)
+	if err != nil {
+		log.Fatalf("Failed to load signing keys: %v", err)
+	}
+
+	providers, err := service.IdentityProvidersFromConfig(context.Background(), cfg)
+	if err != nil {
+		log.Fatalf("Failed to configure identity providers: %v", err)
+	}
 
 	// Services
-	authSvc := service.NewAuthService(userRepo, cfg)
+	authSvc := service.NewAuthService(userRepo, sessionRepo, deviceRepo, identityRepo, keys, cfg, providers...)
+	tokenSvc := service.NewTokenService(tokenRepo)
 	userSvc := service.NewUserService(userRepo)
-	movieSvc := service.NewMovieService(tmdbClient, watchlistRepo, cfg.CloudinaryCloudName)
+	accountSvc := service.NewAccountService(userRepo, identityRepo, watchlistRepo, friendshipRepo, postRepo, progressRepo, diaryRepo, listRepo)
+	adminSvc := service.NewAdminService(userRepo, sessionRepo)
+	catalogSvc := service.NewCatalogService(streamingRepo)
+	movieSvc := service.NewMovieService(tmdbClient, watchlistRepo, userRepo, cfg.CloudinaryCloudName, cfg.AvailabilityRegions)
+	tvSvc := service.NewTVService(tmdbClient, watchlistRepo)
+	personSvc := service.NewPersonService(tmdbClient)
+	recommendationSvc := service.NewRecommendationService(tmdbClient, watchlistRepo, postRepo)
+	progressSvc := service.NewProgressService(tmdbClient, watchlistRepo, progressRepo, service.SystemClock{})
+	diarySvc := service.NewDiaryService(diaryRepo, service.SystemClock{})
+	listSvc := service.NewListService(listRepo, friendshipRepo)
+	// The availability job compares fresh provider lists against the last run,
+	// so it must not read them through the response cache.
+	availabilitySvc := service.NewAvailabilityService(tmdb.NewClient(), availabilityRepo, watchlistRepo, userRepo, service.LogNotifier{}, service.SystemClock{}, cfg.AvailabilityRegions)
 	socialSvc := service.NewSocialService(friendshipRepo, postRepo, userRepo)
+	watchlistDetailsSvc := service.NewWatchlistDetailsService(tmdbClient, watchlistRepo, service.SystemClock{})
+
+	if *testToken {
+		token, err := generateTestToken(authSvc, userRepo, *userID)
+		if err != nil {
+			log.Fatalf("Failed to generate token: %v", err)
+		}
+
+		fmt.Print(token)
+		os.Exit(0)
+	}
+
+	if *grantAdmin != "" {
+		if err := grantAdminRole(userRepo, *grantAdmin); err != nil {
+			log.Fatalf("Failed to grant admin role: %v", err)
+		}
+
+		fmt.Printf("User %s is now an admin\n", *grantAdmin)
+		os.Exit(0)
+	}
+
+	go accountSvc.RunPurge(context.Background(), time.Hour)
+	go availabilitySvc.Run(context.Background(), 6*time.Hour)
+	go watchlistDetailsSvc.Run(context.Background(), time.Hour)
 
 	// Router
 	r := gin.Default()
 	r.Use(middleware.CORS())
 
-	handlers.RegisterAuthRoutes(r, authSvc)
-	handlers.RegisterProtectedRoutes(r, cfg.JWTSecret, userSvc, movieSvc, socialSvc)
+	handlers.RegisterWellKnownRoutes(r, keys)
+	handlers.RegisterAuthRoutes(r, authSvc, cfg.GoogleClientID)
+	handlers.RegisterProtectedRoutes(r, keys, authSvc, tokenSvc, userSvc, accountSvc, adminSvc, catalogSvc, movieSvc, tvSvc, personSvc, recommendationSvc, availabilitySvc, progressSvc, diarySvc, listSvc, socialSvc)
 
 	log.Printf("Server starting on port %s", cfg.Port)
-	err := r.Run(":" + cfg.Port)
-	if err != nil {
+	if err := r.Run(":" + cfg.Port); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
 
-func generateTestToken(userID string, jwtSecret string) (string, error) {
-	claims := &middleware.Claims{
-		UserID: userID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(1 * time.Hour)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+// generateTestToken starts a real session for an existing user so the printed
+// access token passes the session check in middleware.AuthRequired.
+func generateTestToken(authSvc *service.AuthService, userRepo repository.UserRepository, userID string) (string, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return "", fmt.Errorf("invalid -user-id %q: %w", userID, err)
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	user, err := userRepo.FindByID(uid)
+	if err != nil {
+		return "", fmt.Errorf("looking up user: %w", err)
+	}
+
+	result, err := authSvc.IssueTokens(user, service.ClientInfo{UserAgent: "test-token"})
+	if err != nil {
+		return "", err
+	}
+
+	return result.Token, nil
+}
+
+// grantAdminRole bootstraps the first admin, who can then promote others
+// through the admin API. The role takes effect on the user's next login or
+// token refresh.
+func grantAdminRole(userRepo repository.UserRepository, userID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid -grant-admin %q: %w", userID, err)
+	}
+
+	if _, err := userRepo.FindByID(uid); err != nil {
+		return fmt.Errorf("looking up user: %w", err)
+	}
 
-	return token.SignedString([]byte(jwtSecret))
+	return userRepo.SetRole(uid, models.RoleAdmin)
 }
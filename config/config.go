@@ -4,6 +4,7 @@ package config
 import (
 	"log"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -17,10 +18,18 @@ type Config struct {
 	DBPort              string
 	TMDBAPIKey          string
 	JWTSecret           string
+	JWTKeysDir          string
+	JWTActiveKID        string
 	GoogleClientID      string
+	GoogleUserInfoURL   string
+	OIDCProviderName    string
+	OIDCIssuer          string
+	OIDCUserInfoURL     string
+	PublicURL           string
 	Port                string
 	Environment         string
 	CloudinaryCloudName string
+	AvailabilityRegions []string
 }
 
 // Load reads configuration from environment variables, optionally loading from .env files.
@@ -34,6 +43,26 @@ func Load(paths ...string) *Config {
 		port = "8080"
 	}
 
+	googleUserInfoURL := os.Getenv("GOOGLE_USERINFO_URL")
+	if googleUserInfoURL == "" {
+		googleUserInfoURL = "https://www.googleapis.com/oauth2/v3/userinfo"
+	}
+
+	publicURL := os.Getenv("PUBLIC_URL")
+	if publicURL == "" {
+		publicURL = "http://localhost:" + port
+	}
+
+	availabilityRegions := []string{"US"}
+	if regions := os.Getenv("AVAILABILITY_REGIONS"); regions != "" {
+		availabilityRegions = nil
+		for _, region := range strings.Split(regions, ",") {
+			if region = strings.ToUpper(strings.TrimSpace(region)); region != "" {
+				availabilityRegions = append(availabilityRegions, region)
+			}
+		}
+	}
+
 	return &Config{
 		DBHost:              os.Getenv("DB_HOST"),
 		DBUser:              os.Getenv("DB_USER"),
@@ -42,9 +71,17 @@ func Load(paths ...string) *Config {
 		DBPort:              os.Getenv("DB_PORT"),
 		TMDBAPIKey:          os.Getenv("TMDB_API_KEY"),
 		JWTSecret:           os.Getenv("JWT_SECRET"),
+		JWTKeysDir:          os.Getenv("JWT_KEYS_DIR"),
+		JWTActiveKID:        os.Getenv("JWT_ACTIVE_KID"),
 		GoogleClientID:      os.Getenv("GOOGLE_CLIENT_ID"),
+		GoogleUserInfoURL:   googleUserInfoURL,
+		OIDCProviderName:    os.Getenv("OIDC_PROVIDER_NAME"),
+		OIDCIssuer:          os.Getenv("OIDC_ISSUER"),
+		OIDCUserInfoURL:     os.Getenv("OIDC_USERINFO_URL"),
+		PublicURL:           publicURL,
 		Port:                port,
 		Environment:         os.Getenv("ENVIRONMENT"),
 		CloudinaryCloudName: os.Getenv("CLOUDINARY_CLOUD_NAME"),
+		AvailabilityRegions: availabilityRegions,
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/milansax96/movie-terminal-api/config"
+	"github.com/milansax96/movie-terminal-api/internal/handle"
 	"github.com/milansax96/movie-terminal-api/internal/models"
 )
 
@@ -23,7 +24,8 @@ func InitDB(cfg *config.Config) *gorm.DB {
 		cfg.DBHost, cfg.DBUser, cfg.DBPassword, cfg.DBName, cfg.DBPort,
 	)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	// TranslateError maps unique violations to gorm.ErrDuplicatedKey.
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatal("Failed to connect to database:", err)
 	}
@@ -41,31 +43,151 @@ func Migrate(db *gorm.DB) {
 		&models.Friendship{},
 		&models.Post{},
 		&models.Watchlist{},
+		&models.WatchlistGenre{},
+		&models.Session{},
+		&models.RefreshToken{},
+		&models.DeviceAuthorization{},
+		&models.PersonalAccessToken{},
+		&models.UserIdentity{},
+		&models.TrackedTitle{},
+		&models.TitleAvailability{},
+		&models.AvailabilityChange{},
+		&models.EpisodeProgress{},
+		&models.DiaryEntry{},
+		&models.List{},
+		&models.ListItem{},
+		&models.ListMember{},
+		&models.ListChange{},
 	)
 
 	if err != nil {
 		log.Fatal("Failed to migrate database:", err)
 	}
 
+	DropLegacyIndexes(db)
+	BackfillGoogleIdentities(db)
+	BackfillHandles(db)
+
 	SeedStreamingServices(db)
 
 	log.Println("Database migration completed")
 }
 
-// SeedStreamingServices inserts default streaming services if they don't exist.
+// legacyIndexes are indexes AutoMigrate no longer declares but does not drop.
+var legacyIndexes = []struct {
+	model any
+	name  string
+}{
+	// List items were unique by TMDB ID alone, which kept a movie and a TV
+	// show sharing an ID off the same list; idx_list_item_title replaces it.
+	{&models.ListItem{}, "idx_list_title"},
+}
+
+// DropLegacyIndexes drops indexes replaced by ones with different columns.
+func DropLegacyIndexes(db *gorm.DB) {
+	for _, idx := range legacyIndexes {
+		if !db.Migrator().HasIndex(idx.model, idx.name) {
+			continue
+		}
+
+		if err := db.Migrator().DropIndex(idx.model, idx.name); err != nil {
+			log.Fatal("Failed to drop index "+idx.name+":", err)
+		}
+	}
+}
+
+// BackfillGoogleIdentities copies the legacy users.google_id column into
+// user_identities so accounts created before multi-provider login keep working.
+func BackfillGoogleIdentities(db *gorm.DB) {
+	err := db.Exec(`
+		INSERT INTO user_identities (user_id, provider, subject, email, created_at)
+		SELECT id, 'google', google_id, email, created_at FROM users
+		WHERE google_id IS NOT NULL AND google_id <> ''
+		ON CONFLICT (provider, subject) DO NOTHING`).Error
+	if err != nil {
+		log.Fatal("Failed to backfill user identities:", err)
+	}
+}
+
+// BackfillHandles fills display names for accounts created before handles
+// existed, when Username held the Google display name, and replaces any
+// username that is not a valid handle with one derived from it.
+func BackfillHandles(db *gorm.DB) {
+	err := db.Exec(`UPDATE users SET display_name = username WHERE display_name = ''`).Error
+	if err != nil {
+		log.Fatal("Failed to backfill display names:", err)
+	}
+
+	var users []models.User
+	if err := db.Select("id", "username").Find(&users).Error; err != nil {
+		log.Fatal("Failed to load users for handle backfill:", err)
+	}
+
+	taken := make(map[string]bool, len(users))
+	for _, u := range users {
+		taken[u.Username] = true
+	}
+
+	for _, u := range users {
+		if handle.Validate(u.Username) == nil {
+			continue
+		}
+
+		base := handle.FromName(u.Username)
+		username := base
+		for i := 2; taken[username]; i++ {
+			username = fmt.Sprintf("%s%d", base, i)
+		}
+		taken[username] = true
+
+		if err := db.Model(&models.User{}).Where("id = ?", u.ID).Update("username", username).Error; err != nil {
+			log.Fatal("Failed to backfill handle:", err)
+		}
+	}
+}
+
+// defaultStreamingServices seeds an empty catalog. After that the catalog is
+// managed through the admin API.
+var defaultStreamingServices = []models.StreamingService{
+	{Name: "Netflix", Slug: "netflix", TMDBProviderID: intPtr(8)},
+	{Name: "Hulu", Slug: "hulu", TMDBProviderID: intPtr(15), Regions: []string{"US"}},
+	{Name: "Disney+", Slug: "disney_plus", TMDBProviderID: intPtr(337)},
+	{Name: "HBO Max", Slug: "hbo_max", TMDBProviderID: intPtr(1899)},
+	{Name: "Amazon Prime Video", Slug: "prime_video", TMDBProviderID: intPtr(9)},
+	{Name: "Apple TV+", Slug: "apple_tv_plus", TMDBProviderID: intPtr(350)},
+	{Name: "Paramount+", Slug: "paramount_plus", TMDBProviderID: intPtr(531)},
+	{Name: "Peacock", Slug: "peacock", TMDBProviderID: intPtr(386), Regions: []string{"US"}},
+}
+
+// SeedStreamingServices inserts the default streaming services into an empty
+// catalog. In an existing catalog it only fills in TMDB provider IDs and
+// regions for default services that predate them, so services an admin has
+// removed or edited stay that way.
 func SeedStreamingServices(db *gorm.DB) {
-	services := []models.StreamingService{
-		{Name: "Netflix", Slug: "netflix"},
-		{Name: "Hulu", Slug: "hulu"},
-		{Name: "Disney+", Slug: "disney_plus"},
-		{Name: "HBO Max", Slug: "hbo_max"},
-		{Name: "Amazon Prime Video", Slug: "prime_video"},
-		{Name: "Apple TV+", Slug: "apple_tv_plus"},
-		{Name: "Paramount+", Slug: "paramount_plus"},
-		{Name: "Peacock", Slug: "peacock"},
+	var count int64
+	if err := db.Model(&models.StreamingService{}).Count(&count).Error; err != nil {
+		log.Fatal("Failed to count streaming services:", err)
 	}
 
-	for _, s := range services {
-		db.Where("slug = ?", s.Slug).FirstOrCreate(&s)
+	for _, s := range defaultStreamingServices {
+		if count == 0 {
+			if err := db.Create(&s).Error; err != nil {
+				log.Fatal("Failed to seed streaming services:", err)
+			}
+
+			continue
+		}
+
+		err := db.Model(&models.StreamingService{}).
+			Where("slug = ? AND tmdb_provider_id IS NULL", s.Slug).
+			Where("NOT EXISTS (SELECT 1 FROM streaming_services WHERE tmdb_provider_id = ?)", *s.TMDBProviderID).
+			Updates(&models.StreamingService{TMDBProviderID: s.TMDBProviderID, Regions: s.Regions}).Error
+		if err != nil {
+			log.Fatal("Failed to backfill streaming service providers:", err)
+		}
 	}
 }
+
+func intPtr(n int) *int {
+	return &n
+}
@@ -0,0 +1,111 @@
+// Package handle validates and generates the unique @handles users are known by.
+package handle
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Length bounds for handles.
+const (
+	MinLength = 3
+	MaxLength = 30
+)
+
+var (
+	// ErrInvalid is returned for handles outside the allowed syntax.
+	ErrInvalid = errors.New("handle must be 3-30 characters of lowercase letters, digits and underscores, starting with a letter")
+	// ErrReserved is returned for handles set aside for the product or staff.
+	ErrReserved = errors.New("handle is reserved")
+)
+
+var pattern = regexp.MustCompile(`^[a-z][a-z0-9_]{2,29}$`)
+
+// reserved holds handles nobody may claim: routes, roles, and names that
+// could be mistaken for official accounts.
+var reserved = map[string]bool{
+	"about": true, "account": true, "admin": true, "administrator": true,
+	"api": true, "auth": true, "discover": true, "everyone": true,
+	"feed": true, "friends": true, "help": true, "login": true,
+	"logout": true, "me": true, "mod": true, "moderator": true,
+	"movie_terminal": true, "movieterminal": true, "null": true,
+	"official": true, "privacy": true, "root": true, "search": true,
+	"security": true, "settings": true, "signup": true, "staff": true,
+	"support": true, "system": true, "terms": true, "undefined": true,
+	"user": true, "users": true, "watchlist": true,
+}
+
+// Normalize lowercases a handle and strips a leading @ and surrounding space.
+func Normalize(h string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(h), "@"))
+}
+
+// Validate checks a normalized handle.
+func Validate(h string) error {
+	if !pattern.MatchString(h) {
+		return ErrInvalid
+	}
+
+	if IsReserved(h) {
+		return ErrReserved
+	}
+
+	return nil
+}
+
+// IsReserved reports whether h is on the reserved list.
+func IsReserved(h string) bool {
+	return reserved[h]
+}
+
+// FromName derives a valid base handle from a display name or email local
+// part, e.g. "John Smith" becomes "john_smith". It never returns a reserved
+// handle, but the result may still be taken; see Candidates.
+func FromName(name string) string {
+	var b strings.Builder
+	lastUnderscore := true
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+			lastUnderscore = false
+		case !lastUnderscore:
+			b.WriteByte('_')
+			lastUnderscore = true
+		}
+	}
+
+	base := strings.Trim(b.String(), "_")
+	if base == "" || base[0] < 'a' || base[0] > 'z' {
+		base = "user" + base
+	}
+
+	// Leave room for the numeric suffixes Candidates appends.
+	if len(base) > MaxLength-5 {
+		base = strings.TrimRight(base[:MaxLength-5], "_")
+	}
+
+	for len(base) < MinLength || IsReserved(base) {
+		base += "_"
+	}
+
+	return base
+}
+
+// Candidates returns handles to try, in order of preference, for a base
+// from FromName: the base itself, then the base with numeric suffixes. The
+// caller supplies random suffixes so results are deterministic in tests.
+func Candidates(base string, suffixes ...string) []string {
+	candidates := []string{base}
+	for i := 2; i <= 9; i++ {
+		candidates = append(candidates, base+string(rune('0'+i)))
+	}
+
+	for _, suffix := range suffixes {
+		candidates = append(candidates, base+"_"+suffix)
+	}
+
+	return candidates
+}
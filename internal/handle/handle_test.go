@@ -0,0 +1,62 @@
+package handle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromName(t *testing.T) {
+	tests := map[string]string{
+		"John Smith":               "john_smith",
+		"  jane.doe-99 ":           "jane_doe_99",
+		"Zoë Ångström":             "zo_ngstr_m",
+		"007":                      "user007",
+		"李小龙":                      "user_",
+		"Me":                       "me_",
+		"admin":                    "admin_",
+		"ab":                       "ab_",
+		strings.Repeat("a", 40):    strings.Repeat("a", 25),
+		"Someone With A Long Name": "someone_with_a_long_name",
+	}
+
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FromName(name)
+			assert.Equal(t, want, got)
+			assert.NoError(t, Validate(got))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]error{
+		"movie_fan":             nil,
+		"abc":                   nil,
+		"ab":                    ErrInvalid,
+		"1abc":                  ErrInvalid,
+		"Upper":                 ErrInvalid,
+		"has space":             ErrInvalid,
+		strings.Repeat("a", 31): ErrInvalid,
+		"support":               ErrReserved,
+	}
+
+	for h, want := range tests {
+		t.Run(h, func(t *testing.T) {
+			assert.ErrorIs(t, Validate(h), want)
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "movie_fan", Normalize("  @Movie_Fan "))
+}
+
+func TestCandidates(t *testing.T) {
+	got := Candidates("jo_", "0042")
+	assert.Equal(t, []string{"jo_", "jo_2", "jo_3", "jo_4", "jo_5", "jo_6", "jo_7", "jo_8", "jo_9", "jo__0042"}, got)
+	for _, c := range got {
+		assert.NoError(t, Validate(c))
+	}
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// AccountHandler handles personal data export and account deletion.
+type AccountHandler struct {
+	svc service.AccountServiceInterface
+}
+
+// NewAccountHandler creates a new AccountHandler.
+func NewAccountHandler(svc service.AccountServiceInterface) *AccountHandler {
+	return &AccountHandler{svc: svc}
+}
+
+// Export downloads the user's data as a single JSON document, or with
+// ?format=zip as an archive holding one JSON file per section.
+func (h *AccountHandler) Export(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "zip" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or zip"})
+
+		return
+	}
+
+	export, err := h.svc.Export(userID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export data"})
+
+		return
+	}
+
+	filename := fmt.Sprintf("movie-terminal-export-%s.%s", export.ExportedAt.Format("2006-01-02"), format)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	if format == "json" {
+		c.JSON(http.StatusOK, export)
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", "application/zip")
+	if err := writeExportZip(c.Writer, export); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+func writeExportZip(w http.ResponseWriter, export *service.AccountExport) error {
+	zw := zip.NewWriter(w)
+
+	sections := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", export.Profile},
+		{"identities.json", export.Identities},
+		{"watchlist.json", export.Watchlist},
+		{"friendships.json", export.Friendships},
+		{"posts.json", export.Posts},
+	}
+
+	for _, section := range sections {
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: section.name, Method: zip.Deflate, Modified: export.ExportedAt})
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(section.data); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// DeleteAccount schedules the account for deletion. It can be restored
+// until the returned delete_after time.
+func (h *AccountHandler) DeleteAccount(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	deleteAfter, err := h.svc.RequestDeletion(userID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete account"})
+
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message":      "Account scheduled for deletion",
+		"delete_after": deleteAfter,
+	})
+}
+
+// RestoreAccount cancels a pending account deletion.
+func (h *AccountHandler) RestoreAccount(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.CancelDeletion(userID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore account"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Account restored"})
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func testExport() *service.AccountExport {
+	return &service.AccountExport{
+		ExportedAt: time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+		Profile:    &models.User{Username: "film_fan"},
+		Watchlist:  []models.Watchlist{{TMDBId: 550, Title: "Fight Club"}},
+	}
+}
+
+func TestExport_JSON(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Accounts.Exports(testExport())
+
+	w := ts.Do(httptest.NewRequest("GET", "/user/export", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "movie-terminal-export-2026-10-16.json")
+
+	var resp service.AccountExport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "film_fan", resp.Profile.Username)
+	assert.Equal(t, 550, resp.Watchlist[0].TMDBId)
+}
+
+func TestExport_Zip(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Accounts.Exports(testExport())
+
+	w := ts.Do(httptest.NewRequest("GET", "/user/export?format=zip", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	require.NoError(t, err)
+
+	names := make([]string, len(zr.File))
+	for i, f := range zr.File {
+		names[i] = f.Name
+	}
+	assert.Equal(t, []string{"profile.json", "identities.json", "watchlist.json", "friendships.json", "posts.json"}, names)
+
+	f, err := zr.File[2].Open()
+	require.NoError(t, err)
+	var watchlist []models.Watchlist
+	require.NoError(t, json.NewDecoder(f).Decode(&watchlist))
+	assert.Equal(t, "Fight Club", watchlist[0].Title)
+}
+
+func TestExport_BadFormat(t *testing.T) {
+	ts := newTestServer(t)
+
+	w := ts.Do(httptest.NewRequest("GET", "/user/export?format=xml", nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestDeleteAccount(t *testing.T) {
+	ts := newTestServer(t)
+	at := time.Date(2026, 10, 23, 12, 0, 0, 0, time.UTC)
+	ts.Accounts.SchedulesDeletion(at)
+
+	w := ts.Do(httptest.NewRequest("DELETE", "/user", nil))
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Contains(t, w.Body.String(), `"delete_after":"2026-10-23T12:00:00Z"`)
+}
+
+func TestRestoreAccount(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Accounts.CancelsDeletion()
+
+	w := ts.Do(httptest.NewRequest("POST", "/user/restore", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// AdminHandler handles user moderation endpoints for administrators.
+type AdminHandler struct {
+	svc service.AdminServiceInterface
+}
+
+// NewAdminHandler creates a new AdminHandler.
+func NewAdminHandler(svc service.AdminServiceInterface) *AdminHandler {
+	return &AdminHandler{svc: svc}
+}
+
+// SearchUsers finds users by handle, display name or email.
+func (h *AdminHandler) SearchUsers(c *gin.Context) {
+	users, err := h.svc.SearchUsers(c.Query("q"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search users"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": users})
+}
+
+// GetUser returns a user with their moderation state and active sessions.
+func (h *AdminHandler) GetUser(c *gin.Context) {
+	userID, ok := parseTargetUserID(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.svc.GetUser(userID)
+	if err != nil {
+		writeAdminError(c, err, "Failed to get user")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// BanUser bans a user and signs them out everywhere.
+func (h *AdminHandler) BanUser(c *gin.Context) {
+	adminID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	userID, ok := parseTargetUserID(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	if err := h.svc.Ban(adminID, userID, req.Reason); err != nil {
+		writeAdminError(c, err, "Failed to ban user")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "User banned"})
+}
+
+// UnbanUser lifts a user's ban.
+func (h *AdminHandler) UnbanUser(c *gin.Context) {
+	adminID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	userID, ok := parseTargetUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.Unban(adminID, userID); err != nil {
+		writeAdminError(c, err, "Failed to unban user")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "User unbanned"})
+}
+
+// RevokeSessions signs a user out of every session.
+func (h *AdminHandler) RevokeSessions(c *gin.Context) {
+	adminID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	userID, ok := parseTargetUserID(c)
+	if !ok {
+		return
+	}
+
+	revoked, err := h.svc.RevokeSessions(adminID, userID)
+	if err != nil {
+		writeAdminError(c, err, "Failed to revoke sessions")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revoked": revoked})
+}
+
+// SetRole changes a user's role.
+func (h *AdminHandler) SetRole(c *gin.Context) {
+	adminID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	userID, ok := parseTargetUserID(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Role string `json:"role" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	user, err := h.svc.SetRole(adminID, userID, req.Role)
+	if err != nil {
+		writeAdminError(c, err, "Failed to update role")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// parseTargetUserID reads the :id path parameter, writing a 400 if it is not a UUID.
+func parseTargetUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func writeAdminError(c *gin.Context, err error, fallback string) {
+	var verr *service.ValidationError
+
+	switch {
+	case errors.As(err, &verr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "fields": verr.Fields})
+	case errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	case errors.Is(err, service.ErrProtectedUser):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Action not allowed on this user"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
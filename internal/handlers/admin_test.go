@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestAdminSearchUsers(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Admin.SearchReturns("film", []models.User{{Username: "film_fan"}})
+
+	w := ts.Do(httptest.NewRequest("GET", "/admin/users?q=film", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "film_fan")
+}
+
+func TestAdminGetUser(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"found": {"/admin/users/" + uuid.NewString(), func(ts *TestServer) {
+			ts.Admin.GetsUser(&service.AdminUserView{User: &models.User{Username: "film_fan"}, BanReason: "spam"}, nil)
+		}, http.StatusOK},
+		"not found": {"/admin/users/" + uuid.NewString(), func(ts *TestServer) {
+			ts.Admin.GetsUser(nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"invalid ID": {"/admin/users/abc", func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+
+	t.Run("includes moderation state", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.Admin.GetsUser(&service.AdminUserView{User: &models.User{Username: "film_fan"}, BanReason: "spam"}, nil)
+
+		w := ts.Do(httptest.NewRequest("GET", "/admin/users/"+uuid.NewString(), nil))
+
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "film_fan", resp["username"])
+		assert.Equal(t, "spam", resp["ban_reason"])
+	})
+}
+
+func TestAdminBanUser(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"bans":           {`{"reason": "spam"}`, func(ts *TestServer) { ts.Admin.Bans("spam", nil) }, http.StatusOK},
+		"protected user": {`{"reason": "spam"}`, func(ts *TestServer) { ts.Admin.Bans("spam", service.ErrProtectedUser) }, http.StatusForbidden},
+		"unknown user":   {`{"reason": "spam"}`, func(ts *TestServer) { ts.Admin.Bans("spam", service.ErrNotFound) }, http.StatusNotFound},
+		"missing reason": {`{}`, func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("POST", "/admin/users/"+uuid.NewString()+"/ban", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestAdminUnbanUser(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Admin.Unbans(nil)
+
+	w := ts.Do(httptest.NewRequest("POST", "/admin/users/"+uuid.NewString()+"/unban", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAdminRevokeSessions(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Admin.RevokesSessions(2)
+
+	w := ts.Do(httptest.NewRequest("POST", "/admin/users/"+uuid.NewString()+"/revoke-sessions", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"revoked": 2}`, w.Body.String())
+}
+
+func TestAdminSetRole(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"promotes": {`{"role": "admin"}`, func(ts *TestServer) {
+			ts.Admin.SetsRole("admin", &models.User{Role: models.RoleAdmin}, nil)
+		}, http.StatusOK},
+		"unknown role": {`{"role": "superuser"}`, func(ts *TestServer) {
+			ts.Admin.SetsRole("superuser", nil, &service.ValidationError{Fields: map[string]string{"role": "must be one of user, admin"}})
+		}, http.StatusBadRequest},
+		"own role": {`{"role": "user"}`, func(ts *TestServer) {
+			ts.Admin.SetsRole("user", nil, service.ErrProtectedUser)
+		}, http.StatusForbidden},
+		"missing role": {`{}`, func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("PUT", "/admin/users/"+uuid.NewString()+"/role", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -20,8 +20,22 @@ func NewAuthHandler(svc service.AuthServiceInterface) *AuthHandler {
 	return &AuthHandler{svc: svc}
 }
 
+// ListProviders returns the identity providers available for login.
+func (h *AuthHandler) ListProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"results": h.svc.Providers()})
+}
+
 // GoogleLogin handles Google OAuth login requests.
 func (h *AuthHandler) GoogleLogin(c *gin.Context) {
+	h.login(c, service.ProviderGoogle)
+}
+
+// ProviderLogin handles login with an access token from the provider named in the path.
+func (h *AuthHandler) ProviderLogin(c *gin.Context) {
+	h.login(c, c.Param("provider"))
+}
+
+func (h *AuthHandler) login(c *gin.Context, provider string) {
 	var req struct {
 		AccessToken string `json:"access_token" binding:"required"`
 	}
@@ -32,15 +46,19 @@ func (h *AuthHandler) GoogleLogin(c *gin.Context) {
 		return
 	}
 
-	result, err := h.svc.GoogleLogin(c.Request.Context(), req.AccessToken)
+	result, err := h.svc.Login(c.Request.Context(), provider, req.AccessToken, clientInfo(c))
 	if err != nil {
 		switch {
+		case errors.Is(err, service.ErrUnknownProvider):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Unknown identity provider"})
 		case errors.Is(err, service.ErrInvalidToken):
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid Google access token"})
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid access token"})
 		case errors.Is(err, service.ErrMissingClaims):
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Google token missing required claims"})
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Token missing required claims"})
 		case errors.Is(err, service.ErrAlreadyExists):
 			c.JSON(http.StatusConflict, gin.H{"error": "Account with this email already exists"})
+		case errors.Is(err, service.ErrAccountBanned):
+			c.JSON(http.StatusForbidden, gin.H{"error": "Account is banned"})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process login"})
 		}
@@ -54,7 +72,69 @@ func (h *AuthHandler) GoogleLogin(c *gin.Context) {
 	}
 
 	c.JSON(status, gin.H{
-		"user":  result.User,
-		"token": result.Token,
+		"user":          result.User,
+		"token":         result.Token,
+		"refresh_token": result.RefreshToken,
+	})
+}
+
+// Refresh rotates a refresh token into a new access/refresh token pair.
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	result, err := h.svc.Refresh(c.Request.Context(), req.RefreshToken, clientInfo(c))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidToken):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		case errors.Is(err, service.ErrTokenReused):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token reuse detected; session revoked"})
+		case errors.Is(err, service.ErrAccountBanned):
+			c.JSON(http.StatusForbidden, gin.H{"error": "Account is banned"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh session"})
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user":          result.User,
+		"token":         result.Token,
+		"refresh_token": result.RefreshToken,
 	})
 }
+
+// Logout revokes the session that owns the given refresh token.
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	if err := h.svc.Logout(c.Request.Context(), req.RefreshToken); err != nil {
+		if errors.Is(err, service.ErrInvalidToken) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to log out"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out"})
+}
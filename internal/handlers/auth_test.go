@@ -39,6 +39,9 @@ func TestGoogleLogin(t *testing.T) {
 		"missing claims": {`{"access_token": "missing-claims-token"}`, func(ts *TestServer) {
 			ts.Auth.LoginFails("missing-claims-token", service.ErrMissingClaims)
 		}, http.StatusBadRequest},
+		"banned account": {`{"access_token": "banned-token"}`, func(ts *TestServer) {
+			ts.Auth.LoginFails("banned-token", service.ErrAccountBanned)
+		}, http.StatusForbidden},
 		"missing body": {`{}`, func(_ *TestServer) {}, http.StatusBadRequest},
 	}
 
@@ -55,3 +58,116 @@ func TestGoogleLogin(t *testing.T) {
 		})
 	}
 }
+
+func TestRefresh(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {`{"refresh_token": "refresh-1"}`, func(ts *TestServer) {
+			ts.Auth.Refreshes("refresh-1", &service.AuthResult{
+				User:         &models.User{ID: uuid.New(), Username: "existing"},
+				Token:        "jwt-token-789",
+				RefreshToken: "refresh-2",
+			})
+		}, http.StatusOK},
+		"invalid token": {`{"refresh_token": "bad"}`, func(ts *TestServer) {
+			ts.Auth.RefreshFails("bad", service.ErrInvalidToken)
+		}, http.StatusUnauthorized},
+		"reused token": {`{"refresh_token": "stale"}`, func(ts *TestServer) {
+			ts.Auth.RefreshFails("stale", service.ErrTokenReused)
+		}, http.StatusUnauthorized},
+		"banned account": {`{"refresh_token": "refresh-1"}`, func(ts *TestServer) {
+			ts.Auth.RefreshFails("refresh-1", service.ErrAccountBanned)
+		}, http.StatusForbidden},
+		"missing body": {`{}`, func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("POST", "/auth/refresh", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {`{"refresh_token": "refresh-1"}`, func(ts *TestServer) {
+			ts.Auth.LogsOut("refresh-1", nil)
+		}, http.StatusOK},
+		"unknown token": {`{"refresh_token": "bad"}`, func(ts *TestServer) {
+			ts.Auth.LogsOut("bad", service.ErrInvalidToken)
+		}, http.StatusUnauthorized},
+		"missing body": {`{}`, func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("POST", "/auth/logout", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestProviderLogin(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"oidc provider": {"/auth/login/acme", `{"access_token": "acme-token"}`, func(ts *TestServer) {
+			ts.Auth.LogsInWith("acme", "acme-token", &service.AuthResult{
+				User:  &models.User{ID: uuid.New()},
+				Token: "jwt-token",
+			})
+		}, http.StatusOK},
+		"unknown provider": {"/auth/login/myspace", `{"access_token": "token"}`, func(ts *TestServer) {
+			ts.Auth.LoginFails("token", service.ErrUnknownProvider)
+		}, http.StatusNotFound},
+		"email taken": {"/auth/login/acme", `{"access_token": "token"}`, func(ts *TestServer) {
+			ts.Auth.LoginFails("token", service.ErrAlreadyExists)
+		}, http.StatusConflict},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("POST", tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestListProviders(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Auth.On("Providers").Return([]string{"acme", "google"})
+
+	w := ts.Do(httptest.NewRequest("GET", "/auth/providers", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"results": ["acme", "google"]}`, w.Body.String())
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// AvailabilityHandler handles watchlist streaming availability endpoints.
+type AvailabilityHandler struct {
+	svc service.AvailabilityServiceInterface
+}
+
+// NewAvailabilityHandler creates a new AvailabilityHandler.
+func NewAvailabilityHandler(svc service.AvailabilityServiceInterface) *AvailabilityHandler {
+	return &AvailabilityHandler{svc: svc}
+}
+
+// GetWatchlistAvailability returns the watchlist items streaming in ?region=
+// on the user's services, most recently arrived first.
+func (h *AvailabilityHandler) GetWatchlistAvailability(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.svc.GetAvailability(userID, c.Query("region"))
+	if err != nil {
+		var verr *service.ValidationError
+		switch {
+		case errors.As(err, &verr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid region", "fields": verr.Fields})
+		case errors.Is(err, service.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch availability"})
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": items})
+}
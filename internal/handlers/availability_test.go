@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestGetWatchlistAvailability(t *testing.T) {
+	since := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/watchlist/availability", func(ts *TestServer) {
+			ts.Availability.ReturnsAvailability("", []service.WatchlistAvailability{{
+				Watchlist:      models.Watchlist{TMDBId: 550, Title: "Fight Club", MediaType: "movie"},
+				AvailableSince: since,
+				Services:       []service.AvailableService{{ServiceID: 1, Name: "Netflix", AvailableSince: since}},
+			}}, nil)
+		}, http.StatusOK},
+		"untracked region": {"/watchlist/availability?region=GB", func(ts *TestServer) {
+			ts.Availability.ReturnsAvailability("GB", nil, &service.ValidationError{Fields: map[string]string{"region": "must be one of US"}})
+		}, http.StatusBadRequest},
+		"user not found": {"/watchlist/availability", func(ts *TestServer) {
+			ts.Availability.ReturnsAvailability("", nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"internal error": {"/watchlist/availability", func(ts *TestServer) {
+			ts.Availability.ReturnsAvailability("", nil, errors.New("db down"))
+		}, http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
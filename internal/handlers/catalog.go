@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// CatalogHandler handles the streaming service catalog.
+type CatalogHandler struct {
+	svc service.CatalogServiceInterface
+}
+
+// NewCatalogHandler creates a new CatalogHandler.
+func NewCatalogHandler(svc service.CatalogServiceInterface) *CatalogHandler {
+	return &CatalogHandler{svc: svc}
+}
+
+// ListServices returns every streaming service users can subscribe to.
+func (h *CatalogHandler) ListServices(c *gin.Context) {
+	services, err := h.svc.ListServices()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch streaming services"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": services})
+}
+
+// CreateService adds a streaming service to the catalog.
+func (h *CatalogHandler) CreateService(c *gin.Context) {
+	var req service.StreamingServiceInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	created, err := h.svc.CreateService(req)
+	if err != nil {
+		writeCatalogError(c, err, "Failed to create streaming service")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// UpdateService replaces a streaming service's details.
+func (h *CatalogHandler) UpdateService(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid streaming service ID"})
+
+		return
+	}
+
+	var req service.StreamingServiceInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	updated, err := h.svc.UpdateService(id, req)
+	if err != nil {
+		writeCatalogError(c, err, "Failed to update streaming service")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteService removes a streaming service from the catalog.
+func (h *CatalogHandler) DeleteService(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid streaming service ID"})
+
+		return
+	}
+
+	if err := h.svc.DeleteService(id); err != nil {
+		writeCatalogError(c, err, "Failed to delete streaming service")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Streaming service deleted"})
+}
+
+func writeCatalogError(c *gin.Context, err error, fallback string) {
+	var verr *service.ValidationError
+
+	switch {
+	case errors.As(err, &verr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid streaming service", "fields": verr.Fields})
+	case errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Streaming service not found"})
+	case errors.Is(err, service.ErrAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "A streaming service with this slug or TMDB provider ID already exists"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestListStreamingServices(t *testing.T) {
+	ts := newTestServer(t)
+	providerID := 8
+	ts.Catalog.ListsServices([]models.StreamingService{{ID: 1, Name: "Netflix", Slug: "netflix", TMDBProviderID: &providerID}})
+
+	w := ts.Do(httptest.NewRequest("GET", "/streaming-services", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"tmdb_provider_id":8`)
+}
+
+func TestCreateStreamingService(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"created": {`{"name": "Crunchyroll", "slug": "crunchyroll", "tmdb_provider_id": 283}`, func(ts *TestServer) {
+			ts.Catalog.CreatesService(&models.StreamingService{ID: 9, Name: "Crunchyroll", Slug: "crunchyroll"}, nil)
+		}, http.StatusCreated},
+		"invalid": {`{"name": "", "slug": "crunchyroll"}`, func(ts *TestServer) {
+			ts.Catalog.CreatesService(nil, &service.ValidationError{Fields: map[string]string{"name": "must not be empty"}})
+		}, http.StatusBadRequest},
+		"duplicate": {`{"name": "Netflix", "slug": "netflix"}`, func(ts *TestServer) {
+			ts.Catalog.CreatesService(nil, service.ErrAlreadyExists)
+		}, http.StatusConflict},
+		"malformed body": {`{"regions": "US"}`, func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("POST", "/admin/streaming-services", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestUpdateStreamingService(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"updated": {"/admin/streaming-services/4", func(ts *TestServer) {
+			ts.Catalog.UpdatesService(4, &models.StreamingService{ID: 4, Name: "Max", Slug: "max"}, nil)
+		}, http.StatusOK},
+		"not found": {"/admin/streaming-services/99", func(ts *TestServer) {
+			ts.Catalog.UpdatesService(99, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"invalid ID": {"/admin/streaming-services/max", func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("PUT", tt.path, strings.NewReader(`{"name": "Max", "slug": "max"}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestDeleteStreamingService(t *testing.T) {
+	tests := map[string]struct {
+		err    error
+		status int
+	}{
+		"deleted":   {nil, http.StatusOK},
+		"not found": {service.ErrNotFound, http.StatusNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			ts.Catalog.DeletesService(4, tt.err)
+
+			w := ts.Do(httptest.NewRequest("DELETE", "/admin/streaming-services/4", nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// verificationPage lets the user sign in with Google in a browser and approve
+// the user code shown by their terminal.
+var verificationPage = template.Must(template.New("device").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Movie Terminal: connect a device</title>
+<script src="https://accounts.google.com/gsi/client" async></script>
+</head>
+<body>
+<h1>Connect your terminal</h1>
+<p>Enter the code shown in your terminal, then sign in with Google.</p>
+<input id="user_code" value="{{.UserCode}}" placeholder="XXXX-XXXX" autocomplete="off">
+<button id="approve">Sign in and approve</button>
+<p id="status"></p>
+<script>
+document.getElementById("approve").addEventListener("click", function () {
+  var status = document.getElementById("status");
+  var userCode = document.getElementById("user_code").value;
+  google.accounts.oauth2.initTokenClient({
+    client_id: {{.ClientID}},
+    scope: "openid email profile",
+    callback: function (resp) {
+      if (!resp.access_token) {
+        status.textContent = "Google sign-in was cancelled.";
+        return;
+      }
+      fetch("device/verify", {
+        method: "POST",
+        headers: {"Content-Type": "application/json"},
+        body: JSON.stringify({user_code: userCode, access_token: resp.access_token})
+      }).then(function (r) {
+        status.textContent = r.ok
+          ? "Device approved. You can return to your terminal."
+          : "That code is invalid or has expired.";
+      });
+    }
+  }).requestAccessToken();
+});
+</script>
+</body>
+</html>
+`))
+
+// DeviceHandler handles the OAuth 2.0 device authorization flow for terminal clients.
+type DeviceHandler struct {
+	svc            service.AuthServiceInterface
+	googleClientID string
+}
+
+// NewDeviceHandler creates a new DeviceHandler.
+func NewDeviceHandler(svc service.AuthServiceInterface, googleClientID string) *DeviceHandler {
+	return &DeviceHandler{svc: svc, googleClientID: googleClientID}
+}
+
+// RequestCode starts a device authorization grant and returns the codes to show the user.
+func (h *DeviceHandler) RequestCode(c *gin.Context) {
+	code, err := h.svc.RequestDeviceCode(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start device authorization"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, code)
+}
+
+// PollToken exchanges an approved device code for tokens. Errors use the
+// RFC 8628 error codes so standard device-flow clients can poll it.
+func (h *DeviceHandler) PollToken(c *gin.Context) {
+	var req struct {
+		DeviceCode string `json:"device_code" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	result, err := h.svc.PollDeviceToken(c.Request.Context(), req.DeviceCode, clientInfo(c))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrAuthorizationPending),
+			errors.Is(err, service.ErrSlowDown),
+			errors.Is(err, service.ErrExpiredToken):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, service.ErrInvalidToken):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_grant"})
+		case errors.Is(err, service.ErrAccountBanned):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "access_denied"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to complete device authorization"})
+		}
+
+		return
+	}
+
+	status := http.StatusOK
+	if result.IsNew {
+		status = http.StatusCreated
+	}
+
+	c.JSON(status, gin.H{
+		"user":          result.User,
+		"token":         result.Token,
+		"refresh_token": result.RefreshToken,
+	})
+}
+
+// VerificationPage renders the browser page where the user approves a device.
+func (h *DeviceHandler) VerificationPage(c *gin.Context) {
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", "text/html; charset=utf-8")
+
+	err := verificationPage.Execute(c.Writer, gin.H{
+		"UserCode": c.Query("user_code"),
+		"ClientID": h.googleClientID,
+	})
+	if err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// Verify approves a pending user code using a Google access token obtained in the browser.
+func (h *DeviceHandler) Verify(c *gin.Context) {
+	var req struct {
+		UserCode    string `json:"user_code" binding:"required"`
+		AccessToken string `json:"access_token" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	if err := h.svc.ApproveDevice(c.Request.Context(), req.UserCode, req.AccessToken); err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Invalid or expired code"})
+		case errors.Is(err, service.ErrInvalidToken):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid Google access token"})
+		case errors.Is(err, service.ErrMissingClaims):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Google token missing required claims"})
+		case errors.Is(err, service.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "Account with this email already exists"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to approve device"})
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Device approved"})
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestRequestDeviceCode(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Auth.IssuesDeviceCode(&service.DeviceCode{
+		DeviceCode:      "device-code",
+		UserCode:        "BCDF-GHJK",
+		VerificationURI: "http://api.test/api/v1/auth/device",
+		ExpiresIn:       600,
+		Interval:        5,
+	})
+
+	w := ts.Do(httptest.NewRequest("POST", "/auth/device/code", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp service.DeviceCode
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "BCDF-GHJK", resp.UserCode)
+	assert.Equal(t, 5, resp.Interval)
+}
+
+func TestPollDeviceToken(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+		error  string
+	}{
+		"approved": {`{"device_code": "dc"}`, func(ts *TestServer) {
+			ts.Auth.PollsDevice("dc", &service.AuthResult{
+				User:         &models.User{ID: uuid.New()},
+				Token:        "jwt",
+				RefreshToken: "refresh",
+			}, nil)
+		}, http.StatusOK, ""},
+		"pending": {`{"device_code": "dc"}`, func(ts *TestServer) {
+			ts.Auth.PollsDevice("dc", nil, service.ErrAuthorizationPending)
+		}, http.StatusBadRequest, "authorization_pending"},
+		"slow down": {`{"device_code": "dc"}`, func(ts *TestServer) {
+			ts.Auth.PollsDevice("dc", nil, service.ErrSlowDown)
+		}, http.StatusBadRequest, "slow_down"},
+		"expired": {`{"device_code": "dc"}`, func(ts *TestServer) {
+			ts.Auth.PollsDevice("dc", nil, service.ErrExpiredToken)
+		}, http.StatusBadRequest, "expired_token"},
+		"missing body": {`{}`, func(_ *TestServer) {}, http.StatusBadRequest, ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("POST", "/auth/device/token", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+			if tt.error != "" {
+				var resp map[string]string
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tt.error, resp["error"])
+			}
+		})
+	}
+}
+
+func TestDeviceVerificationPage(t *testing.T) {
+	ts := newTestServer(t)
+
+	w := ts.Do(httptest.NewRequest("GET", "/auth/device?user_code=BCDF-GHJK", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/html")
+	assert.Contains(t, w.Body.String(), `value="BCDF-GHJK"`)
+	assert.Contains(t, w.Body.String(), "test-client-id")
+}
+
+func TestVerifyDevice(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"approved": {`{"user_code": "BCDF-GHJK", "access_token": "google"}`, func(ts *TestServer) {
+			ts.Auth.ApprovesDevice("BCDF-GHJK", "google", nil)
+		}, http.StatusOK},
+		"unknown code": {`{"user_code": "XXXX-XXXX", "access_token": "google"}`, func(ts *TestServer) {
+			ts.Auth.ApprovesDevice("XXXX-XXXX", "google", service.ErrNotFound)
+		}, http.StatusNotFound},
+		"invalid google token": {`{"user_code": "BCDF-GHJK", "access_token": "bad"}`, func(ts *TestServer) {
+			ts.Auth.ApprovesDevice("BCDF-GHJK", "bad", service.ErrInvalidToken)
+		}, http.StatusUnauthorized},
+		"missing body": {`{}`, func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("POST", "/auth/device/verify", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// DiaryHandler handles the viewing diary endpoints.
+type DiaryHandler struct {
+	svc service.DiaryServiceInterface
+}
+
+// NewDiaryHandler creates a new DiaryHandler.
+func NewDiaryHandler(svc service.DiaryServiceInterface) *DiaryHandler {
+	return &DiaryHandler{svc: svc}
+}
+
+// ListEntries returns the user's diary, most recently watched first.
+func (h *DiaryHandler) ListEntries(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	entries, err := h.svc.ListEntries(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch diary"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": entries})
+}
+
+// LogEntry logs a viewing, optionally taking the title off the watchlist.
+func (h *DiaryHandler) LogEntry(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.DiaryEntryInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	entry, removed, err := h.svc.LogEntry(userID, req)
+	if err != nil {
+		writeDiaryError(c, err, "Failed to log viewing")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"entry": entry, "removed_from_watchlist": removed})
+}
+
+// UpdateEntry edits a diary entry.
+func (h *DiaryHandler) UpdateEntry(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseDiaryEntryID(c)
+	if !ok {
+		return
+	}
+
+	var req service.DiaryEntryUpdate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	entry, err := h.svc.UpdateEntry(userID, id, req)
+	if err != nil {
+		writeDiaryError(c, err, "Failed to update diary entry")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
+
+// DeleteEntry removes a diary entry.
+func (h *DiaryHandler) DeleteEntry(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseDiaryEntryID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.DeleteEntry(userID, id); err != nil {
+		writeDiaryError(c, err, "Failed to delete diary entry")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Diary entry deleted"})
+}
+
+// parseDiaryEntryID reads the :id path parameter, writing a 400 if it is not a UUID.
+func parseDiaryEntryID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid diary entry ID"})
+
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+func writeDiaryError(c *gin.Context, err error, fallback string) {
+	var verr *service.ValidationError
+
+	switch {
+	case errors.As(err, &verr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid diary entry", "fields": verr.Fields})
+	case errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Diary entry not found"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
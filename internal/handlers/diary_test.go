@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestListDiaryEntries(t *testing.T) {
+	tests := map[string]struct {
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {func(ts *TestServer) {
+			ts.Diary.ReturnsEntries([]models.DiaryEntry{{TMDBId: 550}}, nil)
+		}, http.StatusOK},
+		"internal error": {func(ts *TestServer) {
+			ts.Diary.ReturnsEntries(nil, errors.New("db down"))
+		}, http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", "/diary", nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestLogDiaryEntry(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {`{"tmdb_id":550,"media_type":"movie","rating":4.5,"remove_from_watchlist":true}`, func(ts *TestServer) {
+			ts.Diary.LogsEntry(&models.DiaryEntry{TMDBId: 550}, true, nil)
+		}, http.StatusCreated},
+		"malformed body": {`{"tmdb_id":"550"}`, func(_ *TestServer) {}, http.StatusBadRequest},
+		"invalid fields": {`{"tmdb_id":550,"media_type":"movie","rating":7}`, func(ts *TestServer) {
+			ts.Diary.LogsEntry(nil, false, &service.ValidationError{Fields: map[string]string{"rating": "must be from 0.5 to 5 in steps of 0.5"}})
+		}, http.StatusBadRequest},
+		"internal error": {`{"tmdb_id":550,"media_type":"movie"}`, func(ts *TestServer) {
+			ts.Diary.LogsEntry(nil, false, errors.New("db down"))
+		}, http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("POST", "/diary", strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestLogDiaryEntry_ReportsWatchlistRemoval(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Diary.LogsEntry(&models.DiaryEntry{TMDBId: 550}, true, nil)
+
+	w := ts.Do(httptest.NewRequest("POST", "/diary", strings.NewReader(`{"tmdb_id":550,"media_type":"movie","remove_from_watchlist":true}`)))
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var body struct {
+		Entry   models.DiaryEntry `json:"entry"`
+		Removed bool              `json:"removed_from_watchlist"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, 550, body.Entry.TMDBId)
+	assert.True(t, body.Removed)
+}
+
+func TestUpdateDiaryEntry(t *testing.T) {
+	id := uuid.New()
+
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/diary/" + id.String(), func(ts *TestServer) {
+			ts.Diary.UpdatesEntry(id, &models.DiaryEntry{ID: id}, nil)
+		}, http.StatusOK},
+		"invalid id": {"/diary/abc", func(_ *TestServer) {}, http.StatusBadRequest},
+		"not found": {"/diary/" + id.String(), func(ts *TestServer) {
+			ts.Diary.UpdatesEntry(id, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"invalid fields": {"/diary/" + id.String(), func(ts *TestServer) {
+			ts.Diary.UpdatesEntry(id, nil, &service.ValidationError{Fields: map[string]string{"watched_on": "must not be in the future"}})
+		}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("PATCH", tt.path, strings.NewReader(`{"rating":3.5}`)))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestDeleteDiaryEntry(t *testing.T) {
+	id := uuid.New()
+
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/diary/" + id.String(), func(ts *TestServer) {
+			ts.Diary.DeletesEntry(id, nil)
+		}, http.StatusOK},
+		"invalid id": {"/diary/abc", func(_ *TestServer) {}, http.StatusBadRequest},
+		"not found": {"/diary/" + id.String(), func(ts *TestServer) {
+			ts.Diary.DeletesEntry(id, service.ErrNotFound)
+		}, http.StatusNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("DELETE", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
 )
 
 // parseUserID extracts and validates the user_id from the Gin context.
@@ -19,3 +21,11 @@ func parseUserID(c *gin.Context) (uuid.UUID, bool) {
 
 	return uid, true
 }
+
+// clientInfo describes the calling device for session bookkeeping.
+func clientInfo(c *gin.Context) service.ClientInfo {
+	return service.ClientInfo{
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+	}
+}
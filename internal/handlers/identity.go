@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// IdentityHandler handles endpoints for linking external identity providers to an account.
+type IdentityHandler struct {
+	svc service.AuthServiceInterface
+}
+
+// NewIdentityHandler creates a new IdentityHandler.
+func NewIdentityHandler(svc service.AuthServiceInterface) *IdentityHandler {
+	return &IdentityHandler{svc: svc}
+}
+
+// ListIdentities returns the provider accounts the user can log in with.
+func (h *IdentityHandler) ListIdentities(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	identities, err := h.svc.ListIdentities(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch identities"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": identities})
+}
+
+// LinkIdentity links the provider account behind an access token to the user.
+func (h *IdentityHandler) LinkIdentity(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		AccessToken string `json:"access_token" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	identity, err := h.svc.LinkIdentity(c.Request.Context(), userID, c.Param("provider"), req.AccessToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnknownProvider):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Unknown identity provider"})
+		case errors.Is(err, service.ErrInvalidToken):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid access token"})
+		case errors.Is(err, service.ErrMissingClaims):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Token missing required claims"})
+		case errors.Is(err, service.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "This account is linked to another user"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to link identity"})
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, identity)
+}
+
+// UnlinkIdentity removes a linked provider account.
+func (h *IdentityHandler) UnlinkIdentity(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	identityID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid identity ID"})
+
+		return
+	}
+
+	if err := h.svc.UnlinkIdentity(userID, identityID); err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Identity not found"})
+		case errors.Is(err, service.ErrLastIdentity):
+			c.JSON(http.StatusConflict, gin.H{"error": "Cannot unlink your only login method"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unlink identity"})
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Identity unlinked"})
+}
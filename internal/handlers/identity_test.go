@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestListIdentities(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Auth.ListsIdentities([]models.UserIdentity{{ID: uuid.New(), Provider: "google", Subject: "secret-sub"}})
+
+	w := ts.Do(httptest.NewRequest("GET", "/user/identities", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"provider":"google"`)
+	assert.NotContains(t, w.Body.String(), "secret-sub")
+}
+
+func TestLinkIdentity(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/user/identities/acme", `{"access_token": "acme-token"}`, func(ts *TestServer) {
+			ts.Auth.LinksIdentity("acme", "acme-token", &models.UserIdentity{ID: uuid.New(), Provider: "acme"}, nil)
+		}, http.StatusCreated},
+		"owned by another user": {"/user/identities/acme", `{"access_token": "acme-token"}`, func(ts *TestServer) {
+			ts.Auth.LinksIdentity("acme", "acme-token", nil, service.ErrAlreadyExists)
+		}, http.StatusConflict},
+		"invalid token": {"/user/identities/acme", `{"access_token": "bad"}`, func(ts *TestServer) {
+			ts.Auth.LinksIdentity("acme", "bad", nil, service.ErrInvalidToken)
+		}, http.StatusUnauthorized},
+		"missing token": {"/user/identities/acme", `{}`, func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("POST", tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestUnlinkIdentity(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/user/identities/" + uuid.NewString(), func(ts *TestServer) {
+			ts.Auth.UnlinksIdentity(nil)
+		}, http.StatusOK},
+		"last identity": {"/user/identities/" + uuid.NewString(), func(ts *TestServer) {
+			ts.Auth.UnlinksIdentity(service.ErrLastIdentity)
+		}, http.StatusConflict},
+		"not found": {"/user/identities/" + uuid.NewString(), func(ts *TestServer) {
+			ts.Auth.UnlinksIdentity(service.ErrNotFound)
+		}, http.StatusNotFound},
+		"invalid id": {"/user/identities/abc", func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+			w := ts.Do(httptest.NewRequest("DELETE", tt.path, nil))
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milansax96/movie-terminal-api/internal/signing"
+)
+
+// KeysHandler publishes the public keys access tokens are signed with.
+type KeysHandler struct {
+	keys *signing.KeySet
+}
+
+// NewKeysHandler creates a new KeysHandler.
+func NewKeysHandler(keys *signing.KeySet) *KeysHandler {
+	return &KeysHandler{keys: keys}
+}
+
+// JWKS serves the JSON Web Key Set other services verify our tokens with.
+// Caches are kept short so a newly added key is picked up well before it
+// becomes the signing key.
+func (h *KeysHandler) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.keys.JWKS())
+}
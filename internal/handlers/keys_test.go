@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWKS(t *testing.T) {
+	ts := newTestServer(t)
+
+	w := ts.Do(httptest.NewRequest("GET", "/.well-known/jwks.json", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"keys": []}`, w.Body.String(), "shared secrets are never published")
+	assert.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+}
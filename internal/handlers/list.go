@@ -0,0 +1,372 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// ListHandler handles custom list endpoints.
+type ListHandler struct {
+	svc service.ListServiceInterface
+}
+
+// NewListHandler creates a new ListHandler.
+func NewListHandler(svc service.ListServiceInterface) *ListHandler {
+	return &ListHandler{svc: svc}
+}
+
+// GetLists returns the user's custom lists.
+func (h *ListHandler) GetLists(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	lists, err := h.svc.GetLists(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch lists"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": lists})
+}
+
+// CreateList creates a custom list.
+func (h *ListHandler) CreateList(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.ListInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	list, err := h.svc.CreateList(userID, req)
+	if err != nil {
+		writeListError(c, err, "List not found", "Failed to create list")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, list)
+}
+
+// GetList returns a list with its items.
+func (h *ListHandler) GetList(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseListID(c)
+	if !ok {
+		return
+	}
+
+	list, err := h.svc.GetList(userID, id)
+	if err != nil {
+		writeListError(c, err, "List not found", "Failed to fetch list")
+
+		return
+	}
+
+	c.Header("ETag", listETag(list.Version))
+	c.JSON(http.StatusOK, list)
+}
+
+// UpdateList edits a list's title, description or visibility.
+func (h *ListHandler) UpdateList(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseListID(c)
+	if !ok {
+		return
+	}
+
+	var req service.ListUpdate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	list, err := h.svc.UpdateList(userID, id, req)
+	if err != nil {
+		writeListError(c, err, "List not found", "Failed to update list")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+// DeleteList removes a list and its items.
+func (h *ListHandler) DeleteList(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseListID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.DeleteList(userID, id); err != nil {
+		writeListError(c, err, "List not found", "Failed to delete list")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "List deleted"})
+}
+
+// AddItem appends a title to a list.
+func (h *ListHandler) AddItem(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseListID(c)
+	if !ok {
+		return
+	}
+
+	var req service.ListItemInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	item, err := h.svc.AddItem(userID, id, req)
+	if err != nil {
+		writeListError(c, err, "List not found", "Failed to add to list")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, item)
+}
+
+// RemoveItem takes an item off a list.
+func (h *ListHandler) RemoveItem(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseListID(c)
+	if !ok {
+		return
+	}
+
+	itemID, err := uuid.Parse(c.Param("item_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid item ID"})
+
+		return
+	}
+
+	if err := h.svc.RemoveItem(userID, id, itemID); err != nil {
+		writeListError(c, err, "Item not on list", "Failed to remove from list")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Removed from list"})
+}
+
+// ReorderItems sets the order of a list's items. The list version the client
+// last saw must be sent as an If-Match ETag or as "version" in the body; a
+// stale version gets a 412 so the client can refetch and retry.
+func (h *ListHandler) ReorderItems(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseListID(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		ItemIDs []uuid.UUID `json:"item_ids" binding:"required"`
+		Version *int        `json:"version"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	if ifMatch := c.GetHeader("If-Match"); ifMatch != "" {
+		version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid If-Match header"})
+
+			return
+		}
+
+		req.Version = &version
+	}
+
+	if req.Version == nil {
+		c.JSON(http.StatusPreconditionRequired, gin.H{"error": "If-Match header or version is required"})
+
+		return
+	}
+
+	list, err := h.svc.ReorderItems(userID, id, *req.Version, req.ItemIDs)
+	if err != nil {
+		writeListError(c, err, "List not found", "Failed to reorder list")
+
+		return
+	}
+
+	c.Header("ETag", listETag(list.Version))
+	c.JSON(http.StatusOK, list)
+}
+
+// SetMember invites an accepted friend to a list as an editor or viewer, or
+// changes their role.
+func (h *ListHandler) SetMember(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseListID(c)
+	if !ok {
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+
+		return
+	}
+
+	var req struct {
+		Role string `json:"role" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	member, err := h.svc.SetMember(userID, id, memberID, req.Role)
+	if err != nil {
+		writeListError(c, err, "List not found", "Failed to share list")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, member)
+}
+
+// RemoveMember stops sharing a list with a member, or lets a member leave.
+func (h *ListHandler) RemoveMember(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseListID(c)
+	if !ok {
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+
+		return
+	}
+
+	if err := h.svc.RemoveMember(userID, id, memberID); err != nil {
+		writeListError(c, err, "Member not found", "Failed to remove member")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Member removed"})
+}
+
+// GetHistory returns a list's recent changes.
+func (h *ListHandler) GetHistory(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	id, ok := parseListID(c)
+	if !ok {
+		return
+	}
+
+	changes, err := h.svc.History(userID, id)
+	if err != nil {
+		writeListError(c, err, "List not found", "Failed to fetch list history")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": changes})
+}
+
+// parseListID reads the :id path parameter, writing a 400 if it is not a UUID.
+func parseListID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid list ID"})
+
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+func listETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+func writeListError(c *gin.Context, err error, notFound string, fallback string) {
+	var verr *service.ValidationError
+
+	switch {
+	case errors.As(err, &verr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid list", "fields": verr.Fields})
+	case errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": notFound})
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can't change this list"})
+	case errors.Is(err, service.ErrAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "Title already on list"})
+	case errors.Is(err, service.ErrVersionConflict):
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": "List changed since it was fetched"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,273 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestGetLists(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Lists.On("GetLists", mock.AnythingOfType("uuid.UUID")).Return([]models.List{{Title: "Halloween 2026", ItemCount: 3}}, nil)
+
+	w := ts.Do(httptest.NewRequest("GET", "/lists", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"item_count":3`)
+}
+
+func TestCreateList(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {`{"title":"Halloween 2026","visibility":"friends"}`, func(ts *TestServer) {
+			ts.Lists.CreatesList(&models.List{Title: "Halloween 2026"}, nil)
+		}, http.StatusCreated},
+		"malformed body": {`{"title":1}`, func(_ *TestServer) {}, http.StatusBadRequest},
+		"invalid fields": {`{"title":""}`, func(ts *TestServer) {
+			ts.Lists.CreatesList(nil, &service.ValidationError{Fields: map[string]string{"title": "is required"}})
+		}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("POST", "/lists", strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestGetList(t *testing.T) {
+	id := uuid.New()
+
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/lists/" + id.String(), func(ts *TestServer) {
+			ts.Lists.ReturnsList(id, &models.List{ID: id, Items: []models.ListItem{{TMDBId: 948}}}, nil)
+		}, http.StatusOK},
+		"invalid id": {"/lists/abc", func(_ *TestServer) {}, http.StatusBadRequest},
+		"hidden or missing": {"/lists/" + id.String(), func(ts *TestServer) {
+			ts.Lists.ReturnsList(id, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"internal error": {"/lists/" + id.String(), func(ts *TestServer) {
+			ts.Lists.ReturnsList(id, nil, errors.New("db down"))
+		}, http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestDeleteList(t *testing.T) {
+	id := uuid.New()
+	ts := newTestServer(t)
+	ts.Lists.On("DeleteList", mock.AnythingOfType("uuid.UUID"), id).Return(nil)
+
+	w := ts.Do(httptest.NewRequest("DELETE", "/lists/"+id.String(), nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAddListItem(t *testing.T) {
+	id := uuid.New()
+
+	tests := map[string]struct {
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {func(ts *TestServer) {
+			ts.Lists.AddsItem(id, &models.ListItem{ListID: id, TMDBId: 948, Position: 1}, nil)
+		}, http.StatusCreated},
+		"already listed": {func(ts *TestServer) {
+			ts.Lists.AddsItem(id, nil, service.ErrAlreadyExists)
+		}, http.StatusConflict},
+		"not owner": {func(ts *TestServer) {
+			ts.Lists.AddsItem(id, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			body := strings.NewReader(`{"tmdb_id":948,"media_type":"movie","title":"Halloween"}`)
+			w := ts.Do(httptest.NewRequest("POST", "/lists/"+id.String()+"/items", body))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestRemoveListItem(t *testing.T) {
+	id, itemID, missingID := uuid.New(), uuid.New(), uuid.New()
+
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/lists/" + id.String() + "/items/" + itemID.String(), func(ts *TestServer) {
+			ts.Lists.On("RemoveItem", mock.AnythingOfType("uuid.UUID"), id, itemID).Return(nil)
+		}, http.StatusOK},
+		"invalid item id": {"/lists/" + id.String() + "/items/948", func(_ *TestServer) {}, http.StatusBadRequest},
+		"not on list": {"/lists/" + id.String() + "/items/" + missingID.String(), func(ts *TestServer) {
+			ts.Lists.On("RemoveItem", mock.AnythingOfType("uuid.UUID"), id, missingID).Return(service.ErrNotFound)
+		}, http.StatusNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("DELETE", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestReorderListItems(t *testing.T) {
+	id, first, second := uuid.New(), uuid.New(), uuid.New()
+	both := fmt.Sprintf(`"item_ids":["%s","%s"]`, first, second)
+
+	tests := map[string]struct {
+		ifMatch string
+		body    string
+		setup   func(*TestServer)
+		status  int
+	}{
+		"version in body": {"", `{` + both + `,"version":3}`, func(ts *TestServer) {
+			ts.Lists.Reorders(id, 3, []uuid.UUID{first, second}, &models.List{ID: id, Version: 4}, nil)
+		}, http.StatusOK},
+		"if-match header": {`"3"`, `{` + both + `}`, func(ts *TestServer) {
+			ts.Lists.Reorders(id, 3, []uuid.UUID{first, second}, &models.List{ID: id, Version: 4}, nil)
+		}, http.StatusOK},
+		"missing version":  {"", `{` + both + `}`, func(_ *TestServer) {}, http.StatusPreconditionRequired},
+		"invalid if-match": {`"abc"`, `{` + both + `}`, func(_ *TestServer) {}, http.StatusBadRequest},
+		"missing ids":      {"", `{"version":3}`, func(_ *TestServer) {}, http.StatusBadRequest},
+		"stale version": {`"2"`, `{` + both + `}`, func(ts *TestServer) {
+			ts.Lists.Reorders(id, 2, []uuid.UUID{first, second}, nil, service.ErrVersionConflict)
+		}, http.StatusPreconditionFailed},
+		"viewer": {`"3"`, `{` + both + `}`, func(ts *TestServer) {
+			ts.Lists.Reorders(id, 3, []uuid.UUID{first, second}, nil, service.ErrForbidden)
+		}, http.StatusForbidden},
+		"mismatched ids": {`"3"`, `{"item_ids":["` + first.String() + `"]}`, func(ts *TestServer) {
+			ts.Lists.Reorders(id, 3, []uuid.UUID{first}, nil, &service.ValidationError{Fields: map[string]string{"item_ids": "must list every item on the list exactly once"}})
+		}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("PUT", "/lists/"+id.String()+"/order", strings.NewReader(tt.body))
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+			if w.Code == http.StatusOK {
+				assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestGetList_ETag(t *testing.T) {
+	id := uuid.New()
+	ts := newTestServer(t)
+	ts.Lists.ReturnsList(id, &models.List{ID: id, Version: 7}, nil)
+
+	w := ts.Do(httptest.NewRequest("GET", "/lists/"+id.String(), nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"7"`, w.Header().Get("ETag"))
+}
+
+func TestSetListMember(t *testing.T) {
+	id, friendID := uuid.New(), uuid.New()
+
+	tests := map[string]struct {
+		path   string
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/lists/" + id.String() + "/members/" + friendID.String(), `{"role":"editor"}`, func(ts *TestServer) {
+			ts.Lists.SetsMember(id, friendID, "editor", &models.ListMember{ListID: id, UserID: friendID, Role: "editor"}, nil)
+		}, http.StatusOK},
+		"invalid user id": {"/lists/" + id.String() + "/members/abc", `{"role":"editor"}`, func(_ *TestServer) {}, http.StatusBadRequest},
+		"missing role":    {"/lists/" + id.String() + "/members/" + friendID.String(), `{}`, func(_ *TestServer) {}, http.StatusBadRequest},
+		"not a friend": {"/lists/" + id.String() + "/members/" + friendID.String(), `{"role":"viewer"}`, func(ts *TestServer) {
+			ts.Lists.SetsMember(id, friendID, "viewer", nil, &service.ValidationError{Fields: map[string]string{"user_id": "must be an accepted friend"}})
+		}, http.StatusBadRequest},
+		"not owner": {"/lists/" + id.String() + "/members/" + friendID.String(), `{"role":"viewer"}`, func(ts *TestServer) {
+			ts.Lists.SetsMember(id, friendID, "viewer", nil, service.ErrForbidden)
+		}, http.StatusForbidden},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("PUT", tt.path, strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestRemoveListMember(t *testing.T) {
+	id, memberID := uuid.New(), uuid.New()
+	ts := newTestServer(t)
+	ts.Lists.On("RemoveMember", mock.AnythingOfType("uuid.UUID"), id, memberID).Return(service.ErrNotFound)
+
+	w := ts.Do(httptest.NewRequest("DELETE", "/lists/"+id.String()+"/members/"+memberID.String(), nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetListHistory(t *testing.T) {
+	id := uuid.New()
+	ts := newTestServer(t)
+	ts.Lists.On("History", mock.AnythingOfType("uuid.UUID"), id).
+		Return([]models.ListChange{{ListID: id, Action: models.ListChangeItemAdded, TMDBId: 948}}, nil)
+
+	w := ts.Do(httptest.NewRequest("GET", "/lists/"+id.String()+"/history", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"action":"item_added"`)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -22,13 +23,45 @@ func NewMovieHandler(svc service.MovieServiceInterface) *MovieHandler {
 	return &MovieHandler{svc: svc}
 }
 
-// GetDiscoverFeed returns movies for a genre or trending feed.
+// onMyServicesGenre selects the feed of titles on the user's streaming
+// services, the same as ?services=mine.
+const onMyServicesGenre = "on_my_services"
+
+// GetDiscoverFeed returns movies, or with ?media_type=tv TV shows, for a
+// genre or category feed, or, when any of discoverFilterParams is given,
+// titles matching those filters.
 func (h *MovieHandler) GetDiscoverFeed(c *gin.Context) {
 	genre := c.DefaultQuery("genre", "trending")
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 
 	uid, _ := uuid.Parse(c.GetString("user_id"))
 
+	if services, ok := c.GetQuery("services"); ok && services != "mine" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filters", "fields": gin.H{"services": "must be mine"}})
+
+		return
+	}
+
+	onMyServices := genre == onMyServicesGenre || c.Query("services") == "mine"
+
+	if hasDiscoverFilters(c) {
+		h.discoverFiltered(c, uid, page, onMyServices)
+
+		return
+	}
+
+	if onMyServices {
+		h.discoverOnMyServices(c, uid, page)
+
+		return
+	}
+
+	if c.Query("media_type") == "tv" {
+		h.discoverTV(c, uid, genre, page)
+
+		return
+	}
+
 	movies, err := h.svc.Discover(uid, genre, page)
 	if err != nil {
 		if errors.Is(err, service.ErrUnknownGenre) {
@@ -45,6 +78,158 @@ func (h *MovieHandler) GetDiscoverFeed(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"results": movies})
 }
 
+// discoverTV returns TV shows for a category or genre.
+func (h *MovieHandler) discoverTV(c *gin.Context, userID uuid.UUID, category string, page int) {
+	shows, err := h.svc.DiscoverTV(userID, category, page)
+	if err != nil {
+		if errors.Is(err, service.ErrUnknownGenre) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown genre: " + category})
+
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch TV shows"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": shows})
+}
+
+// discoverFilterParams are the query parameters that switch /discover to
+// filtered results.
+var discoverFilterParams = []string{
+	"genres", "genre_match", "year_from", "year_to", "min_rating", "min_votes",
+	"runtime_min", "runtime_max", "language", "sort",
+}
+
+func hasDiscoverFilters(c *gin.Context) bool {
+	for _, param := range discoverFilterParams {
+		if _, ok := c.GetQuery(param); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// discoverFiltered returns titles matching the filter query parameters. A
+// ?genre= slug is combined with ?genres=.
+func (h *MovieHandler) discoverFiltered(c *gin.Context, userID uuid.UUID, page int, onMyServices bool) {
+	filters, fields := parseDiscoverFilters(c)
+	filters.OnMyServices = onMyServices
+	filters.Page = page
+
+	if len(fields) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filters", "fields": fields})
+
+		return
+	}
+
+	movies, err := h.svc.DiscoverFiltered(userID, filters)
+	if err != nil {
+		var verr *service.ValidationError
+		switch {
+		case errors.As(err, &verr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filters", "fields": verr.Fields})
+		case errors.Is(err, service.ErrNoStreamingServices):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "None of your streaming services are available in this region"})
+		case errors.Is(err, service.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch movies"})
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": movies})
+}
+
+// parseDiscoverFilters reads the filter query parameters, reporting those
+// that are not well-formed numbers or choices by field.
+func parseDiscoverFilters(c *gin.Context) (service.DiscoverFilters, map[string]string) {
+	fields := map[string]string{}
+	filters := service.DiscoverFilters{
+		MediaType: c.DefaultQuery("media_type", "movie"),
+		Language:  c.Query("language"),
+		Sort:      c.Query("sort"),
+		Region:    c.Query("region"),
+	}
+
+	if genre := c.Query("genre"); genre != "" && genre != onMyServicesGenre {
+		filters.Genres = append(filters.Genres, genre)
+	}
+
+	for _, genre := range strings.Split(c.Query("genres"), ",") {
+		if genre = strings.TrimSpace(genre); genre != "" {
+			filters.Genres = append(filters.Genres, genre)
+		}
+	}
+
+	switch c.DefaultQuery("genre_match", "all") {
+	case "all":
+	case "any":
+		filters.MatchAnyGenre = true
+	default:
+		fields["genre_match"] = "must be all or any"
+	}
+
+	ints := map[string]*int{
+		"year_from":   &filters.YearFrom,
+		"year_to":     &filters.YearTo,
+		"min_votes":   &filters.MinVotes,
+		"runtime_min": &filters.MinRuntime,
+		"runtime_max": &filters.MaxRuntime,
+	}
+	for param, dst := range ints {
+		if raw, ok := c.GetQuery(param); ok {
+			n, err := strconv.Atoi(raw)
+			if err != nil {
+				fields[param] = "must be a whole number"
+
+				continue
+			}
+			*dst = n
+		}
+	}
+
+	if raw, ok := c.GetQuery("min_rating"); ok {
+		rating, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			fields["min_rating"] = "must be a number"
+		}
+		filters.MinRating = rating
+	}
+
+	return filters, fields
+}
+
+// discoverOnMyServices returns titles streaming in ?region= on the user's
+// services, as movies or, with ?media_type=tv, TV shows.
+func (h *MovieHandler) discoverOnMyServices(c *gin.Context, userID uuid.UUID, page int) {
+	mediaType := c.DefaultQuery("media_type", "movie")
+
+	movies, err := h.svc.DiscoverOnMyServices(userID, mediaType, c.Query("region"), page)
+	if err != nil {
+		var verr *service.ValidationError
+		switch {
+		case errors.As(err, &verr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filters", "fields": verr.Fields})
+		case errors.Is(err, service.ErrNoStreamingServices):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "None of your streaming services are available in this region"})
+		case errors.Is(err, service.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch movies"})
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": movies})
+}
+
 // GetDiscoverAll returns multiple categories concurrently in a single response.
 func (h *MovieHandler) GetDiscoverAll(c *gin.Context) {
 	uid, _ := uuid.Parse(c.GetString("user_id"))
@@ -59,6 +244,24 @@ func (h *MovieHandler) GetDiscoverAll(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"results": movies})
 }
 
+// GetGenres returns the genres that /discover accepts for ?media_type=.
+func (h *MovieHandler) GetGenres(c *gin.Context) {
+	genres, err := h.svc.GetGenres(c.DefaultQuery("media_type", "movie"))
+	if err != nil {
+		var verr *service.ValidationError
+		if errors.As(err, &verr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid media type", "fields": verr.Fields})
+
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch genres"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": genres})
+}
+
 // SearchMovies searches for movies and TV shows.
 func (h *MovieHandler) SearchMovies(c *gin.Context) {
 	query := c.Query("q")
@@ -146,8 +349,48 @@ func (h *MovieHandler) GetMovieCredits(c *gin.Context) {
 	c.JSON(http.StatusOK, credits)
 }
 
-// GetMovieProviders returns streaming providers for a title.
+// GetSimilarMovies returns titles similar to a movie or TV show.
+func (h *MovieHandler) GetSimilarMovies(c *gin.Context) {
+	h.relatedTitles(c, h.svc.GetSimilar, "Failed to fetch similar titles")
+}
+
+// GetMovieRecommendations returns titles recommended to viewers of a movie or
+// TV show.
+func (h *MovieHandler) GetMovieRecommendations(c *gin.Context) {
+	h.relatedTitles(c, h.svc.GetRecommendations, "Failed to fetch recommendations")
+}
+
+// relatedTitles serves a page of titles related to :id through fetch.
+func (h *MovieHandler) relatedTitles(c *gin.Context, fetch func(uuid.UUID, string, int, int) ([]models.Movie, error), failed string) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+
+		return
+	}
+
+	uid, _ := uuid.Parse(c.GetString("user_id"))
+	mediaType := c.DefaultQuery("media_type", "movie")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+
+	movies, err := fetch(uid, mediaType, id, page)
+	if err != nil {
+		writeLookupError(c, err, "Movie not found", failed)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": movies})
+}
+
+// GetMovieProviders returns where a title can be watched, optionally for a
+// single ?region=, flagging providers that are among the user's streaming services.
 func (h *MovieHandler) GetMovieProviders(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
@@ -157,8 +400,14 @@ func (h *MovieHandler) GetMovieProviders(c *gin.Context) {
 
 	mediaType := c.DefaultQuery("media_type", "movie")
 
-	providers, err := h.svc.GetProviders(mediaType, id)
+	providers, err := h.svc.GetProviders(userID, mediaType, id, c.Query("region"))
 	if err != nil {
+		var verr *service.ValidationError
+		if errors.As(err, &verr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid region", "fields": verr.Fields})
+
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch providers"})
 
 		return
@@ -167,21 +416,61 @@ func (h *MovieHandler) GetMovieProviders(c *gin.Context) {
 	c.JSON(http.StatusOK, providers)
 }
 
-// GetWatchlist returns the user's watchlist.
+// GetWatchlist returns a page of the user's watchlist. ?sort= orders it by
+// added, title, release_date or rating; ?media_type=, ?genre= and
+// ?services=mine filter it; ?cursor= is the next_cursor of the previous page.
+// Without ?limit= or ?cursor= the whole watchlist is returned, unpaged.
+// ?services=mine goes by the last availability check, which only covers the
+// configured AVAILABILITY_REGIONS and runs every few hours.
 func (h *MovieHandler) GetWatchlist(c *gin.Context) {
 	userID, ok := parseUserID(c)
 	if !ok {
 		return
 	}
 
-	items, err := h.svc.GetWatchlist(userID)
+	if services, ok := c.GetQuery("services"); ok && services != "mine" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filters", "fields": gin.H{"services": "must be mine"}})
+
+		return
+	}
+
+	filters := service.WatchlistFilters{
+		MediaType:    c.Query("media_type"),
+		Genre:        c.Query("genre"),
+		Sort:         c.Query("sort"),
+		OnMyServices: c.Query("services") == "mine",
+		Region:       c.Query("region"),
+		Cursor:       c.Query("cursor"),
+	}
+
+	if raw, ok := c.GetQuery("limit"); ok {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filters", "fields": gin.H{"limit": "must be a whole number"}})
+
+			return
+		}
+		filters.Limit = limit
+	}
+
+	page, err := h.svc.GetWatchlist(userID, filters)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch watchlist"})
+		var verr *service.ValidationError
+		switch {
+		case errors.As(err, &verr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filters", "fields": verr.Fields})
+		case errors.Is(err, service.ErrNoStreamingServices):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "None of your streaming services are available in this region"})
+		case errors.Is(err, service.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch watchlist"})
+		}
 
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"results": items})
+	c.JSON(http.StatusOK, page)
 }
 
 // AddToWatchlist adds a movie to the user's watchlist.
@@ -192,12 +481,15 @@ func (h *MovieHandler) AddToWatchlist(c *gin.Context) {
 	}
 
 	var req struct {
-		MovieID      int    `json:"movie_id" binding:"required"`
-		Title        string `json:"title"`
-		PosterPath   string `json:"poster_path"`
-		BackdropPath string `json:"backdrop_path"`
-		MediaType    string `json:"media_type" binding:"required"`
-		TrailerKey   string `json:"trailer_key"`
+		MovieID      int     `json:"movie_id" binding:"required"`
+		Title        string  `json:"title"`
+		PosterPath   string  `json:"poster_path"`
+		BackdropPath string  `json:"backdrop_path"`
+		MediaType    string  `json:"media_type" binding:"required"`
+		TrailerKey   string  `json:"trailer_key"`
+		ReleaseDate  string  `json:"release_date"`
+		VoteAverage  float64 `json:"vote_average"`
+		GenreIDs     []int   `json:"genre_ids"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -213,6 +505,9 @@ func (h *MovieHandler) AddToWatchlist(c *gin.Context) {
 		BackdropPath: req.BackdropPath,
 		MediaType:    req.MediaType,
 		TrailerKey:   req.TrailerKey,
+		ReleaseDate:  req.ReleaseDate,
+		VoteAverage:  req.VoteAverage,
+		GenreIDs:     req.GenreIDs,
 	})
 	if err != nil {
 		if errors.Is(err, service.ErrAlreadyExists) {
@@ -65,6 +65,57 @@ func TestGetDiscoverFeed(t *testing.T) {
 		"internal error": {"/discover", func(ts *TestServer) {
 			ts.Movies.DiscoverFails("trending", errors.New("tmdb down"))
 		}, http.StatusInternalServerError, nil},
+		"on my services": {"/discover?genre=on_my_services", func(ts *TestServer) {
+			ts.Movies.DiscoversOnMyServices("movie", "", []models.Movie{{ID: 550}}, nil)
+		}, http.StatusOK, nil},
+		"services=mine": {"/discover?services=mine&media_type=tv&region=GB", func(ts *TestServer) {
+			ts.Movies.DiscoversOnMyServices("tv", "GB", []models.Movie{{ID: 1399}}, nil)
+		}, http.StatusOK, nil},
+		"no services in region": {"/discover?services=mine", func(ts *TestServer) {
+			ts.Movies.DiscoversOnMyServices("movie", "", nil, service.ErrNoStreamingServices)
+		}, http.StatusBadRequest, nil},
+		"invalid services filter": {"/discover?services=all", func(_ *TestServer) {}, http.StatusBadRequest, nil},
+		"tv category": {"/discover?media_type=tv&genre=airing_today", func(ts *TestServer) {
+			ts.Movies.DiscoversTV("airing_today", []models.Movie{{ID: 1399, MediaType: "tv"}}, nil)
+		}, http.StatusOK, nil},
+		"tv trending by default": {"/discover?media_type=tv", func(ts *TestServer) {
+			ts.Movies.DiscoversTV("trending", []models.Movie{{ID: 1399, MediaType: "tv"}}, nil)
+		}, http.StatusOK, nil},
+		"unknown tv category": {"/discover?media_type=tv&genre=upcoming", func(ts *TestServer) {
+			ts.Movies.DiscoversTV("upcoming", nil, service.ErrUnknownGenre)
+		}, http.StatusBadRequest, nil},
+		"filters": {"/discover?genres=action,+sci_fi&genre_match=any&year_from=1990&year_to=1999&min_rating=7.5&min_votes=100&runtime_max=120&language=en&sort=rating", func(ts *TestServer) {
+			ts.Movies.DiscoversFiltered(service.DiscoverFilters{
+				MediaType: "movie", Genres: []string{"action", "sci_fi"}, MatchAnyGenre: true, YearFrom: 1990, YearTo: 1999,
+				MinRating: 7.5, MinVotes: 100, MaxRuntime: 120, Language: "en", Sort: "rating", Page: 1,
+			}, []models.Movie{{ID: 603}}, nil)
+		}, http.StatusOK, nil},
+		"genre combined with filters": {"/discover?genre=comedy&media_type=tv&sort=title", func(ts *TestServer) {
+			ts.Movies.DiscoversFiltered(service.DiscoverFilters{
+				MediaType: "tv", Genres: []string{"comedy"}, Sort: "title", Page: 1,
+			}, []models.Movie{{ID: 1399}}, nil)
+		}, http.StatusOK, nil},
+		"filters on my services": {"/discover?services=mine&region=GB&min_rating=8", func(ts *TestServer) {
+			ts.Movies.DiscoversFiltered(service.DiscoverFilters{
+				MediaType: "movie", MinRating: 8, OnMyServices: true, Region: "GB", Page: 1,
+			}, []models.Movie{{ID: 550}}, nil)
+		}, http.StatusOK, nil},
+		"malformed filters": {"/discover?year_from=nineties&min_rating=high&genre_match=some", func(_ *TestServer) {}, http.StatusBadRequest,
+			func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp struct {
+					Fields map[string]string `json:"fields"`
+				}
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Len(t, resp.Fields, 3)
+				assert.Contains(t, resp.Fields, "year_from")
+			}},
+		"invalid filter combination": {"/discover?year_from=2000&year_to=1990", func(ts *TestServer) {
+			ts.Movies.DiscoversFiltered(service.DiscoverFilters{MediaType: "movie", YearFrom: 2000, YearTo: 1990, Page: 1},
+				nil, &service.ValidationError{Fields: map[string]string{"year_from": "must not be after year_to"}})
+		}, http.StatusBadRequest, func(t *testing.T, w *httptest.ResponseRecorder) {
+			assert.Contains(t, w.Body.String(), `"year_from"`)
+		}},
 	}
 
 	for name, tt := range tests {
@@ -80,6 +131,40 @@ func TestGetDiscoverFeed(t *testing.T) {
 	}
 }
 
+// --- Genres ---
+
+func TestGetGenres(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"movie by default": {"/genres", func(ts *TestServer) {
+			ts.Movies.ReturnsGenres("movie", []models.Genre{{ID: 18, Name: "Drama", Slug: "drama", MediaType: "movie"}}, nil)
+		}, http.StatusOK},
+		"tv": {"/genres?media_type=tv", func(ts *TestServer) {
+			ts.Movies.ReturnsGenres("tv", []models.Genre{{ID: 10765, Name: "Sci-Fi & Fantasy", Slug: "sci_fi_and_fantasy"}}, nil)
+		}, http.StatusOK},
+		"invalid media type": {"/genres?media_type=anime", func(ts *TestServer) {
+			ts.Movies.ReturnsGenres("anime", nil, &service.ValidationError{Fields: map[string]string{"media_type": "must be movie or tv"}})
+		}, http.StatusBadRequest},
+		"upstream failure": {"/genres", func(ts *TestServer) {
+			ts.Movies.ReturnsGenres("movie", nil, errors.New("tmdb down"))
+		}, http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
 // --- Search ---
 
 func TestSearchMovies(t *testing.T) {
@@ -183,26 +268,144 @@ func TestGetMovieCredits(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+// --- Similar / Recommendations ---
+
+func TestRelatedTitles(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"similar": {"/movies/550/similar", func(ts *TestServer) {
+			ts.Movies.ReturnsSimilar("movie", 550, 1, []models.Movie{{ID: 807, IsWatchlisted: true}}, nil)
+		}, http.StatusOK},
+		"recommendations for tv": {"/movies/1399/recommendations?media_type=tv&page=2", func(ts *TestServer) {
+			ts.Movies.ReturnsRecommendations("tv", 1399, 2, []models.Movie{{ID: 1402}}, nil)
+		}, http.StatusOK},
+		"invalid id": {"/movies/abc/similar", func(_ *TestServer) {}, http.StatusBadRequest},
+		"not found": {"/movies/999/recommendations", func(ts *TestServer) {
+			ts.Movies.ReturnsRecommendations("movie", 999, 1, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"upstream failure": {"/movies/550/similar", func(ts *TestServer) {
+			ts.Movies.ReturnsSimilar("movie", 550, 1, nil, errors.New("tmdb down"))
+		}, http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
 // --- Providers ---
 
 func TestGetMovieProviders(t *testing.T) {
-	ts := newTestServer(t)
-	ts.Movies.ReturnsProviders("movie", 550, json.RawMessage(`{"US":{"flatrate":[]}}`))
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"all regions": {"/movies/550/providers", func(ts *TestServer) {
+			ts.Movies.ReturnsProviders("movie", 550, "", &models.WatchProviders{TMDBID: 550, Regions: map[string]models.RegionWatchProviders{
+				"US": {Stream: []models.WatchProvider{{ProviderID: 8, Name: "Netflix", OnMyServices: true}}},
+			}})
+		}, http.StatusOK},
+		"one region": {"/movies/1399/providers?media_type=tv&region=GB", func(ts *TestServer) {
+			ts.Movies.ReturnsProviders("tv", 1399, "GB", &models.WatchProviders{TMDBID: 1399})
+		}, http.StatusOK},
+		"invalid region": {"/movies/550/providers?region=USA", func(ts *TestServer) {
+			ts.Movies.ProvidersFail("USA", &service.ValidationError{Fields: map[string]string{"region": "must be an ISO 3166-1 alpha-2 country code"}})
+		}, http.StatusBadRequest},
+		"upstream failure": {"/movies/550/providers", func(ts *TestServer) {
+			ts.Movies.ProvidersFail("", errors.New("tmdb down"))
+		}, http.StatusInternalServerError},
+	}
 
-	w := ts.Do(httptest.NewRequest("GET", "/movies/550/providers", nil))
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
 
-	assert.Equal(t, http.StatusOK, w.Code)
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+
+	t.Run("flags the user's services", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.Movies.ReturnsProviders("movie", 550, "", &models.WatchProviders{TMDBID: 550, Regions: map[string]models.RegionWatchProviders{
+			"US": {Stream: []models.WatchProvider{{ProviderID: 8, Name: "Netflix", OnMyServices: true}}},
+		}})
+
+		w := ts.Do(httptest.NewRequest("GET", "/movies/550/providers", nil))
+
+		assert.Contains(t, w.Body.String(), `"on_my_services":true`)
+	})
 }
 
 // --- Watchlist ---
 
 func TestGetWatchlist(t *testing.T) {
-	ts := newTestServer(t)
-	ts.Movies.ReturnsWatchlist([]models.Watchlist{{TMDBId: 550, Title: "Fight Club"}})
-
-	w := ts.Do(httptest.NewRequest("GET", "/watchlist", nil))
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+		check  func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		"first page": {"/watchlist", func(ts *TestServer) {
+			ts.Movies.ReturnsWatchlist(service.WatchlistFilters{}, &service.WatchlistPage{
+				Results: []models.Watchlist{{TMDBId: 550, Title: "Fight Club"}}, NextCursor: "abc",
+			}, nil)
+		}, http.StatusOK, func(t *testing.T, w *httptest.ResponseRecorder) {
+			var resp struct {
+				Results    []models.Watchlist `json:"results"`
+				NextCursor string             `json:"next_cursor"`
+			}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			assert.Len(t, resp.Results, 1)
+			assert.Equal(t, "abc", resp.NextCursor)
+		}},
+		"filters": {"/watchlist?media_type=tv&genre=comedy&sort=rating.asc&services=mine&region=GB&cursor=abc&limit=20", func(ts *TestServer) {
+			ts.Movies.ReturnsWatchlist(service.WatchlistFilters{
+				MediaType: "tv", Genre: "comedy", Sort: "rating.asc", OnMyServices: true, Region: "GB", Cursor: "abc", Limit: 20,
+			}, &service.WatchlistPage{Results: []models.Watchlist{}}, nil)
+		}, http.StatusOK, func(t *testing.T, w *httptest.ResponseRecorder) {
+			assert.NotContains(t, w.Body.String(), "next_cursor")
+		}},
+		"malformed limit": {"/watchlist?limit=ten", func(_ *TestServer) {}, http.StatusBadRequest, func(t *testing.T, w *httptest.ResponseRecorder) {
+			assert.Contains(t, w.Body.String(), `"limit"`)
+		}},
+		"invalid services filter": {"/watchlist?services=all", func(_ *TestServer) {}, http.StatusBadRequest, nil},
+		"invalid filters": {"/watchlist?sort=popularity", func(ts *TestServer) {
+			ts.Movies.ReturnsWatchlist(service.WatchlistFilters{Sort: "popularity"},
+				nil, &service.ValidationError{Fields: map[string]string{"sort": "must be one of added, title, release_date or rating"}})
+		}, http.StatusBadRequest, func(t *testing.T, w *httptest.ResponseRecorder) {
+			assert.Contains(t, w.Body.String(), `"sort"`)
+		}},
+		"no services in region": {"/watchlist?services=mine", func(ts *TestServer) {
+			ts.Movies.ReturnsWatchlist(service.WatchlistFilters{OnMyServices: true}, nil, service.ErrNoStreamingServices)
+		}, http.StatusBadRequest, nil},
+	}
 
-	assert.Equal(t, http.StatusOK, w.Code)
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+			assert.Equal(t, tt.status, w.Code)
+			if tt.check != nil {
+				tt.check(t, w)
+			}
+		})
+	}
 }
 
 func TestAddToWatchlist(t *testing.T) {
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// PersonHandler handles actor, director and crew endpoints.
+type PersonHandler struct {
+	svc service.PersonServiceInterface
+}
+
+// NewPersonHandler creates a new PersonHandler.
+func NewPersonHandler(svc service.PersonServiceInterface) *PersonHandler {
+	return &PersonHandler{svc: svc}
+}
+
+// GetPerson returns a person's biography and details.
+func (h *PersonHandler) GetPerson(c *gin.Context) {
+	id, ok := parsePersonID(c)
+	if !ok {
+		return
+	}
+
+	person, err := h.svc.GetPerson(id)
+	if err != nil {
+		writeLookupError(c, err, "Person not found", "Failed to fetch person")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, person)
+}
+
+// GetPersonCredits returns a person's movie and TV credits, newest first.
+func (h *PersonHandler) GetPersonCredits(c *gin.Context) {
+	id, ok := parsePersonID(c)
+	if !ok {
+		return
+	}
+
+	credits, err := h.svc.GetCredits(id)
+	if err != nil {
+		writeLookupError(c, err, "Person not found", "Failed to fetch credits")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, credits)
+}
+
+// GetPersonImages returns a person's profile photos.
+func (h *PersonHandler) GetPersonImages(c *gin.Context) {
+	id, ok := parsePersonID(c)
+	if !ok {
+		return
+	}
+
+	images, err := h.svc.GetImages(id)
+	if err != nil {
+		writeLookupError(c, err, "Person not found", "Failed to fetch images")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": images})
+}
+
+func parsePersonID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid person ID"})
+
+		return 0, false
+	}
+
+	return id, true
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestGetPerson(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/people/287", func(ts *TestServer) {
+			ts.People.ReturnsPerson(287, &models.Person{ID: 287, Name: "Brad Pitt"}, nil)
+		}, http.StatusOK},
+		"invalid id": {"/people/abc", func(_ *TestServer) {}, http.StatusBadRequest},
+		"not found": {"/people/999", func(ts *TestServer) {
+			ts.People.ReturnsPerson(999, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"credits": {"/people/287/credits", func(ts *TestServer) {
+			ts.People.ReturnsCredits(287, &models.PersonCredits{Cast: []models.PersonCredit{{ID: 550}}}, nil)
+		}, http.StatusOK},
+		"credits error": {"/people/287/credits", func(ts *TestServer) {
+			ts.People.ReturnsCredits(287, nil, errors.New("tmdb down"))
+		}, http.StatusInternalServerError},
+		"images": {"/people/287/images", func(ts *TestServer) {
+			ts.People.ReturnsImages(287, []models.PersonImage{{FilePath: "/a.jpg"}}, nil)
+		}, http.StatusOK},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// ProgressHandler handles episode progress endpoints for TV shows on the
+// watchlist.
+type ProgressHandler struct {
+	svc service.ProgressServiceInterface
+}
+
+// NewProgressHandler creates a new ProgressHandler.
+func NewProgressHandler(svc service.ProgressServiceInterface) *ProgressHandler {
+	return &ProgressHandler{svc: svc}
+}
+
+// GetProgress returns the user's watched episodes per season of a show and
+// the next episode to watch.
+func (h *ProgressHandler) GetProgress(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	showID, err := strconv.Atoi(c.Param("movie_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid show ID"})
+
+		return
+	}
+
+	progress, err := h.svc.GetProgress(userID, showID)
+	if err != nil {
+		writeProgressError(c, err, "Show not found in watchlist", "Failed to fetch progress")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+// MarkEpisode marks an episode as watched.
+func (h *ProgressHandler) MarkEpisode(c *gin.Context) {
+	userID, showID, season, episode, ok := parseEpisodeProgressParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.MarkEpisode(userID, showID, season, episode); err != nil {
+		writeProgressError(c, err, "Episode not found", "Failed to mark episode")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Episode marked as watched"})
+}
+
+// UnmarkEpisode marks an episode as not watched.
+func (h *ProgressHandler) UnmarkEpisode(c *gin.Context) {
+	userID, showID, season, episode, ok := parseEpisodeProgressParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.UnmarkEpisode(userID, showID, season, episode); err != nil {
+		writeProgressError(c, err, "Episode not marked as watched", "Failed to unmark episode")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Episode marked as not watched"})
+}
+
+// MarkSeason marks every aired episode of a season as watched.
+func (h *ProgressHandler) MarkSeason(c *gin.Context) {
+	userID, showID, season, ok := parseSeasonProgressParams(c)
+	if !ok {
+		return
+	}
+
+	marked, err := h.svc.MarkSeason(userID, showID, season)
+	if err != nil {
+		writeProgressError(c, err, "Season not found", "Failed to mark season")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"marked": marked})
+}
+
+// UnmarkSeason marks every episode of a season as not watched.
+func (h *ProgressHandler) UnmarkSeason(c *gin.Context) {
+	userID, showID, season, ok := parseSeasonProgressParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.UnmarkSeason(userID, showID, season); err != nil {
+		writeProgressError(c, err, "Show not found in watchlist", "Failed to unmark season")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Season marked as not watched"})
+}
+
+// UpNext returns the next episode to watch of each show on the watchlist.
+func (h *ProgressHandler) UpNext(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	entries, err := h.svc.UpNext(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch up next"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": entries})
+}
+
+// parseSeasonProgressParams reads the user and the :movie_id and :season
+// path parameters, writing an error response if any is invalid.
+func parseSeasonProgressParams(c *gin.Context) (uuid.UUID, int, int, bool) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return uuid.Nil, 0, 0, false
+	}
+
+	showID, err := strconv.Atoi(c.Param("movie_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid show ID"})
+
+		return uuid.Nil, 0, 0, false
+	}
+
+	season, err := strconv.Atoi(c.Param("season"))
+	if err != nil || season < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season number"})
+
+		return uuid.Nil, 0, 0, false
+	}
+
+	return userID, showID, season, true
+}
+
+// parseEpisodeProgressParams also reads the :episode path parameter.
+func parseEpisodeProgressParams(c *gin.Context) (uuid.UUID, int, int, int, bool) {
+	userID, showID, season, ok := parseSeasonProgressParams(c)
+	if !ok {
+		return uuid.Nil, 0, 0, 0, false
+	}
+
+	episode, err := strconv.Atoi(c.Param("episode"))
+	if err != nil || episode < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid episode number"})
+
+		return uuid.Nil, 0, 0, 0, false
+	}
+
+	return userID, showID, season, episode, true
+}
+
+func writeProgressError(c *gin.Context, err error, notFound string, failed string) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": notFound})
+	case errors.Is(err, service.ErrNotTVShow):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Only TV shows track episode progress"})
+	case errors.Is(err, service.ErrNotAired):
+		c.JSON(http.StatusConflict, gin.H{"error": "Episode has not aired yet"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": failed})
+	}
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestGetProgress(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/watchlist/1399/progress", func(ts *TestServer) {
+			ts.Progress.ReturnsProgress(1399, &service.ShowProgress{TMDBId: 1399, WatchedEpisodes: 3}, nil)
+		}, http.StatusOK},
+		"invalid id": {"/watchlist/abc/progress", func(_ *TestServer) {}, http.StatusBadRequest},
+		"not on watchlist": {"/watchlist/1399/progress", func(ts *TestServer) {
+			ts.Progress.ReturnsProgress(1399, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"movie": {"/watchlist/550/progress", func(ts *TestServer) {
+			ts.Progress.ReturnsProgress(550, nil, service.ErrNotTVShow)
+		}, http.StatusBadRequest},
+		"internal error": {"/watchlist/1399/progress", func(ts *TestServer) {
+			ts.Progress.ReturnsProgress(1399, nil, errors.New("tmdb down"))
+		}, http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestMarkEpisode(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/watchlist/1399/progress/season/1/episode/2", func(ts *TestServer) {
+			ts.Progress.MarksEpisode(1399, 1, 2, nil)
+		}, http.StatusOK},
+		"invalid season":  {"/watchlist/1399/progress/season/-1/episode/2", func(_ *TestServer) {}, http.StatusBadRequest},
+		"invalid episode": {"/watchlist/1399/progress/season/1/episode/0", func(_ *TestServer) {}, http.StatusBadRequest},
+		"not aired": {"/watchlist/1399/progress/season/8/episode/6", func(ts *TestServer) {
+			ts.Progress.MarksEpisode(1399, 8, 6, service.ErrNotAired)
+		}, http.StatusConflict},
+		"unknown episode": {"/watchlist/1399/progress/season/1/episode/99", func(ts *TestServer) {
+			ts.Progress.MarksEpisode(1399, 1, 99, service.ErrNotFound)
+		}, http.StatusNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("PUT", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestMarkSeason(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Progress.MarksSeason(1399, 1, 10, nil)
+
+	w := ts.Do(httptest.NewRequest("PUT", "/watchlist/1399/progress/season/1", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"marked":10}`, w.Body.String())
+}
+
+func TestUnmarkEpisode_NotWatched(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Progress.UnmarksEpisode(1399, 1, 2, service.ErrNotFound)
+
+	w := ts.Do(httptest.NewRequest("DELETE", "/watchlist/1399/progress/season/1/episode/2", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestUpNext(t *testing.T) {
+	tests := map[string]struct {
+		err    error
+		status int
+	}{
+		"success":        {nil, http.StatusOK},
+		"internal error": {errors.New("db down"), http.StatusInternalServerError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			var entries []service.UpNextEntry
+			if tt.err == nil {
+				entries = []service.UpNextEntry{{
+					Watchlist:   models.Watchlist{TMDBId: 1399, MediaType: "tv"},
+					NextEpisode: models.Episode{SeasonNumber: 2, EpisodeNumber: 1},
+				}}
+			}
+			ts.Progress.ReturnsUpNext(entries, tt.err)
+
+			w := ts.Do(httptest.NewRequest("GET", "/watchlist/up-next", nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// RecommendationHandler handles the personal recommendations feed.
+type RecommendationHandler struct {
+	svc service.RecommendationServiceInterface
+}
+
+// NewRecommendationHandler creates a new RecommendationHandler.
+func NewRecommendationHandler(svc service.RecommendationServiceInterface) *RecommendationHandler {
+	return &RecommendationHandler{svc: svc}
+}
+
+// GetRecommendations returns titles picked from the user's watchlist and
+// posts, each with the reason it was picked.
+func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	results, err := h.svc.Recommend(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build recommendations"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/recommend"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestGetRecommendations(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.Recommend.Recommends([]service.Recommendation{{
+			Movie:   models.Movie{ID: 807, Title: "Se7en", MediaType: "movie"},
+			Score:   3.1,
+			Reason:  "Because you saved Fight Club",
+			Because: service.RecommendationSource{TMDBId: 550, MediaType: "movie", Title: "Fight Club", Source: recommend.SourceWatchlist},
+		}}, nil)
+
+		w := ts.Do(httptest.NewRequest("GET", "/recommendations", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var body struct {
+			Results []struct {
+				ID      int    `json:"id"`
+				Reason  string `json:"reason"`
+				Because struct {
+					TMDBId int    `json:"tmdb_id"`
+					Source string `json:"source"`
+				} `json:"because"`
+			} `json:"results"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		require.Len(t, body.Results, 1)
+		assert.Equal(t, 807, body.Results[0].ID)
+		assert.Equal(t, "Because you saved Fight Club", body.Results[0].Reason)
+		assert.Equal(t, 550, body.Results[0].Because.TMDBId)
+		assert.Equal(t, "watchlist", body.Results[0].Because.Source)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		ts := newTestServer(t)
+		ts.Recommend.Recommends(nil, errors.New("db down"))
+
+		w := ts.Do(httptest.NewRequest("GET", "/recommendations", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
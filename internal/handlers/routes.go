@@ -4,57 +4,177 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/milansax96/movie-terminal-api/internal/middleware"
+	"github.com/milansax96/movie-terminal-api/internal/models"
 	"github.com/milansax96/movie-terminal-api/internal/service"
+	"github.com/milansax96/movie-terminal-api/internal/signing"
 )
 
 // RegisterAuthRoutes registers public authentication routes.
-func RegisterAuthRoutes(r *gin.Engine, authSvc service.AuthServiceInterface) {
+func RegisterAuthRoutes(r *gin.Engine, authSvc service.AuthServiceInterface, googleClientID string) {
 	authH := NewAuthHandler(authSvc)
+	deviceH := NewDeviceHandler(authSvc, googleClientID)
 
 	auth := r.Group("/api/v1/auth")
 	{
+		auth.GET("/providers", authH.ListProviders)
 		auth.POST("/google", authH.GoogleLogin)
+		auth.POST("/login/:provider", authH.ProviderLogin)
+		auth.POST("/refresh", authH.Refresh)
+		auth.POST("/logout", authH.Logout)
+
+		// Device authorization flow for terminal clients
+		auth.POST("/device/code", deviceH.RequestCode)
+		auth.POST("/device/token", deviceH.PollToken)
+		auth.GET("/device", deviceH.VerificationPage)
+		auth.POST("/device/verify", deviceH.Verify)
 	}
 }
 
-// RegisterProtectedRoutes registers JWT-protected API routes.
-func RegisterProtectedRoutes(r *gin.Engine, jwtSecret string, userSvc service.UserServiceInterface, movieSvc service.MovieServiceInterface, socialSvc service.SocialServiceInterface) {
+// RegisterWellKnownRoutes registers public discovery documents.
+func RegisterWellKnownRoutes(r *gin.Engine, keys *signing.KeySet) {
+	keysH := NewKeysHandler(keys)
+
+	r.GET("/.well-known/jwks.json", keysH.JWKS)
+}
+
+// RegisterProtectedRoutes registers API routes that require a session JWT or personal access token.
+// Routes declare the token scope they need; session logins satisfy every scope.
+func RegisterProtectedRoutes(r *gin.Engine, keys *signing.KeySet, authSvc service.AuthServiceInterface, tokenSvc service.TokenServiceInterface, userSvc service.UserServiceInterface, accountSvc service.AccountServiceInterface, adminSvc service.AdminServiceInterface, catalogSvc service.CatalogServiceInterface, movieSvc service.MovieServiceInterface, tvSvc service.TVServiceInterface, personSvc service.PersonServiceInterface, recommendationSvc service.RecommendationServiceInterface, availabilitySvc service.AvailabilityServiceInterface, progressSvc service.ProgressServiceInterface, diarySvc service.DiaryServiceInterface, listSvc service.ListServiceInterface, socialSvc service.SocialServiceInterface) {
+	sessionH := NewSessionHandler(authSvc)
+	identityH := NewIdentityHandler(authSvc)
+	tokenH := NewTokenHandler(tokenSvc)
 	userH := NewUserHandler(userSvc)
+	accountH := NewAccountHandler(accountSvc)
+	adminH := NewAdminHandler(adminSvc)
+	catalogH := NewCatalogHandler(catalogSvc)
 	movieH := NewMovieHandler(movieSvc)
+	tvH := NewTVHandler(tvSvc)
+	personH := NewPersonHandler(personSvc)
+	recommendationH := NewRecommendationHandler(recommendationSvc)
+	availabilityH := NewAvailabilityHandler(availabilitySvc)
+	progressH := NewProgressHandler(progressSvc)
+	diaryH := NewDiaryHandler(diarySvc)
+	listH := NewListHandler(listSvc)
 	socialH := NewSocialHandler(socialSvc)
 
+	requireSession := middleware.RequireSession()
+	profileRead := middleware.RequireScope(models.ScopeProfileRead)
+	profileWrite := middleware.RequireScope(models.ScopeProfileWrite)
+	moviesRead := middleware.RequireScope(models.ScopeMoviesRead)
+	watchlistRead := middleware.RequireScope(models.ScopeWatchlistRead)
+	watchlistWrite := middleware.RequireScope(models.ScopeWatchlistWrite)
+	socialRead := middleware.RequireScope(models.ScopeSocialRead)
+	socialWrite := middleware.RequireScope(models.ScopeSocialWrite)
+
 	api := r.Group("/api/v1")
-	api.Use(middleware.AuthRequired(jwtSecret))
+	api.Use(middleware.AuthRequired(keys, authSvc, tokenSvc))
 	{
 		// User profile
-		api.GET("/user/profile", userH.GetProfile)
-		api.PUT("/user/streaming-services", userH.UpdateStreamingServices)
+		api.GET("/user/profile", profileRead, userH.GetProfile)
+		api.PUT("/user/profile", profileWrite, userH.UpdateProfile)
+		api.PUT("/user/streaming-services", profileWrite, userH.UpdateStreamingServices)
+
+		// Streaming service catalog
+		api.GET("/streaming-services", profileRead, catalogH.ListServices)
+
+		// Data export and account deletion
+		api.GET("/user/export", requireSession, accountH.Export)
+		api.DELETE("/user", requireSession, accountH.DeleteAccount)
+		api.POST("/user/restore", requireSession, accountH.RestoreAccount)
+
+		// Sessions
+		api.GET("/user/sessions", requireSession, sessionH.ListSessions)
+		api.DELETE("/user/sessions/:id", requireSession, sessionH.RevokeSession)
+
+		// Linked identity providers
+		api.GET("/user/identities", requireSession, identityH.ListIdentities)
+		api.POST("/user/identities/:provider", requireSession, identityH.LinkIdentity)
+		api.DELETE("/user/identities/:id", requireSession, identityH.UnlinkIdentity)
+
+		// Personal access tokens
+		api.POST("/user/tokens", requireSession, tokenH.CreateToken)
+		api.GET("/user/tokens", requireSession, tokenH.ListTokens)
+		api.DELETE("/user/tokens/:id", requireSession, tokenH.RevokeToken)
 
 		// Discovery & Search
-		api.GET("/discover", movieH.GetDiscoverFeed)
-		api.GET("/discover/all", movieH.GetDiscoverAll)
-		api.GET("/search", movieH.SearchMovies)
+		api.GET("/discover", moviesRead, movieH.GetDiscoverFeed)
+		api.GET("/discover/all", moviesRead, movieH.GetDiscoverAll)
+		api.GET("/genres", moviesRead, movieH.GetGenres)
+		api.GET("/search", moviesRead, movieH.SearchMovies)
 
 		// Movie Detail (TMDB proxy)
-		api.GET("/movies/:id", movieH.GetMovieDetail)
-		api.GET("/movies/:id/videos", movieH.GetMovieVideos)
-		api.GET("/movies/:id/credits", movieH.GetMovieCredits)
-		api.GET("/movies/:id/providers", movieH.GetMovieProviders)
+		api.GET("/movies/:id", moviesRead, movieH.GetMovieDetail)
+		api.GET("/movies/:id/videos", moviesRead, movieH.GetMovieVideos)
+		api.GET("/movies/:id/credits", moviesRead, movieH.GetMovieCredits)
+		api.GET("/movies/:id/similar", moviesRead, movieH.GetSimilarMovies)
+		api.GET("/movies/:id/recommendations", moviesRead, movieH.GetMovieRecommendations)
+		api.GET("/movies/:id/providers", moviesRead, movieH.GetMovieProviders)
+
+		// TV
+		api.GET("/tv/:id", moviesRead, tvH.GetShow)
+		api.GET("/tv/:id/season/:season", moviesRead, tvH.GetSeason)
+		api.GET("/tv/:id/season/:season/episode/:episode", moviesRead, tvH.GetEpisode)
+		api.GET("/people/:id", moviesRead, personH.GetPerson)
+		api.GET("/people/:id/credits", moviesRead, personH.GetPersonCredits)
+		api.GET("/people/:id/images", moviesRead, personH.GetPersonImages)
+		api.GET("/recommendations", moviesRead, watchlistRead, recommendationH.GetRecommendations)
 
 		// Watchlist
-		api.GET("/watchlist", movieH.GetWatchlist)
-		api.POST("/watchlist", movieH.AddToWatchlist)
-		api.DELETE("/watchlist/:movie_id", movieH.RemoveFromWatchlist)
-		api.GET("/watchlist/:movie_id/check", movieH.CheckWatchlist)
+		api.GET("/watchlist", watchlistRead, movieH.GetWatchlist)
+		api.GET("/watchlist/availability", watchlistRead, availabilityH.GetWatchlistAvailability)
+		api.POST("/watchlist", watchlistWrite, movieH.AddToWatchlist)
+		api.DELETE("/watchlist/:movie_id", watchlistWrite, movieH.RemoveFromWatchlist)
+		api.GET("/watchlist/:movie_id/check", watchlistRead, movieH.CheckWatchlist)
+		api.GET("/watchlist/up-next", watchlistRead, progressH.UpNext)
+		api.GET("/watchlist/:movie_id/progress", watchlistRead, progressH.GetProgress)
+		api.PUT("/watchlist/:movie_id/progress/season/:season", watchlistWrite, progressH.MarkSeason)
+		api.DELETE("/watchlist/:movie_id/progress/season/:season", watchlistWrite, progressH.UnmarkSeason)
+		api.PUT("/watchlist/:movie_id/progress/season/:season/episode/:episode", watchlistWrite, progressH.MarkEpisode)
+		api.DELETE("/watchlist/:movie_id/progress/season/:season/episode/:episode", watchlistWrite, progressH.UnmarkEpisode)
+
+		// Diary
+		api.GET("/diary", watchlistRead, diaryH.ListEntries)
+		api.POST("/diary", watchlistWrite, diaryH.LogEntry)
+		api.PATCH("/diary/:id", watchlistWrite, diaryH.UpdateEntry)
+		api.DELETE("/diary/:id", watchlistWrite, diaryH.DeleteEntry)
+
+		// Custom lists; /watchlist above is the default list
+		api.GET("/lists", watchlistRead, listH.GetLists)
+		api.POST("/lists", watchlistWrite, listH.CreateList)
+		api.GET("/lists/:id", watchlistRead, listH.GetList)
+		api.PATCH("/lists/:id", watchlistWrite, listH.UpdateList)
+		api.DELETE("/lists/:id", watchlistWrite, listH.DeleteList)
+		api.POST("/lists/:id/items", watchlistWrite, listH.AddItem)
+		api.DELETE("/lists/:id/items/:item_id", watchlistWrite, listH.RemoveItem)
+		api.PUT("/lists/:id/order", watchlistWrite, listH.ReorderItems)
+		api.PUT("/lists/:id/members/:user_id", watchlistWrite, socialRead, listH.SetMember)
+		api.DELETE("/lists/:id/members/:user_id", watchlistWrite, listH.RemoveMember)
+		api.GET("/lists/:id/history", watchlistRead, listH.GetHistory)
 
 		// Friends
-		api.GET("/friends", socialH.GetFriends)
-		api.POST("/friends/request", socialH.SendFriendRequest)
-		api.PUT("/friends/accept/:id", socialH.AcceptFriendRequest)
-		api.GET("/friends/search", socialH.SearchUsers)
+		api.GET("/friends", socialRead, socialH.GetFriends)
+		api.POST("/friends/request", socialWrite, socialH.SendFriendRequest)
+		api.PUT("/friends/accept/:id", socialWrite, socialH.AcceptFriendRequest)
+		api.GET("/friends/search", socialRead, socialH.SearchUsers)
 
 		// Feed
-		api.GET("/feed", socialH.GetFriendsFeed)
-		api.POST("/posts", socialH.CreatePost)
+		api.GET("/feed", socialRead, socialH.GetFriendsFeed)
+		api.POST("/posts", socialWrite, socialH.CreatePost)
+	}
+
+	// Administration is limited to session logins of admins; personal
+	// access tokens never carry a role.
+	admin := api.Group("/admin", requireSession, middleware.RequireRole(models.RoleAdmin))
+	{
+		admin.GET("/users", adminH.SearchUsers)
+		admin.GET("/users/:id", adminH.GetUser)
+		admin.POST("/users/:id/ban", adminH.BanUser)
+		admin.POST("/users/:id/unban", adminH.UnbanUser)
+		admin.POST("/users/:id/revoke-sessions", adminH.RevokeSessions)
+		admin.PUT("/users/:id/role", adminH.SetRole)
+
+		admin.POST("/streaming-services", catalogH.CreateService)
+		admin.PUT("/streaming-services/:id", catalogH.UpdateService)
+		admin.DELETE("/streaming-services/:id", catalogH.DeleteService)
 	}
 }
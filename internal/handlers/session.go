@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// SessionHandler handles endpoints for viewing and revoking login sessions.
+type SessionHandler struct {
+	svc service.AuthServiceInterface
+}
+
+// NewSessionHandler creates a new SessionHandler.
+func NewSessionHandler(svc service.AuthServiceInterface) *SessionHandler {
+	return &SessionHandler{svc: svc}
+}
+
+// ListSessions returns every device currently logged into the user's account.
+func (h *SessionHandler) ListSessions(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	sessions, err := h.svc.ListSessions(userID, c.GetString("session_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch sessions"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": sessions})
+}
+
+// RevokeSession logs one of the user's devices out.
+func (h *SessionHandler) RevokeSession(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid session ID"})
+
+		return
+	}
+
+	if err := h.svc.RevokeSession(userID, sessionID); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Session not found"})
+
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke session"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Session revoked"})
+}
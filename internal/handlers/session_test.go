@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestListSessions(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Auth.ListsSessions([]models.Session{
+		{ID: uuid.MustParse(testSessionID), UserAgent: "cli/1.0", Current: true},
+		{ID: uuid.New(), UserAgent: "cli/0.9"},
+	})
+
+	w := ts.Do(httptest.NewRequest("GET", "/user/sessions", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp struct {
+		Results []models.Session `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Results, 2)
+	assert.True(t, resp.Results[0].Current)
+}
+
+func TestRevokeSession(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/user/sessions/" + uuid.NewString(), func(ts *TestServer) {
+			ts.Auth.RevokesSession(nil)
+		}, http.StatusOK},
+		"not found": {"/user/sessions/" + uuid.NewString(), func(ts *TestServer) {
+			ts.Auth.RevokesSession(service.ErrNotFound)
+		}, http.StatusNotFound},
+		"invalid id": {"/user/sessions/abc", func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+			w := ts.Do(httptest.NewRequest("DELETE", tt.path, nil))
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -1,77 +1,192 @@
 package handlers
 
 import (
-	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/milansax96/movie-terminal-api/internal/models"
 	"github.com/milansax96/movie-terminal-api/internal/service"
 	svcMocks "github.com/milansax96/movie-terminal-api/internal/service/mocks"
+	"github.com/milansax96/movie-terminal-api/internal/signing"
 	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
 )
 
-const testUserID = "550e8400-e29b-41d4-a716-446655440000"
+const (
+	testUserID    = "550e8400-e29b-41d4-a716-446655440000"
+	testSessionID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+)
 
 // --- TestServer ---
 
 type TestServer struct {
-	Router *gin.Engine
-	Auth   *AuthSvcHelper
-	Users  *UserSvcHelper
-	Movies *MovieSvcHelper
-	Social *SocialSvcHelper
+	Router       *gin.Engine
+	Auth         *AuthSvcHelper
+	Tokens       *TokenSvcHelper
+	Users        *UserSvcHelper
+	Accounts     *AccountSvcHelper
+	Admin        *AdminSvcHelper
+	Catalog      *CatalogSvcHelper
+	Movies       *MovieSvcHelper
+	TV           *TVSvcHelper
+	People       *PersonSvcHelper
+	Recommend    *RecommendationSvcHelper
+	Availability *AvailabilitySvcHelper
+	Progress     *ProgressSvcHelper
+	Diary        *DiarySvcHelper
+	Lists        *ListSvcHelper
+	Social       *SocialSvcHelper
 }
 
 func newTestServer(t *testing.T) *TestServer {
 	gin.SetMode(gin.TestMode)
 
 	ts := &TestServer{
-		Auth:   &AuthSvcHelper{svcMocks.NewMockAuthServiceInterface(t)},
-		Users:  &UserSvcHelper{svcMocks.NewMockUserServiceInterface(t)},
-		Movies: &MovieSvcHelper{svcMocks.NewMockMovieServiceInterface(t)},
-		Social: &SocialSvcHelper{svcMocks.NewMockSocialServiceInterface(t)},
+		Auth:         &AuthSvcHelper{svcMocks.NewMockAuthServiceInterface(t)},
+		Tokens:       &TokenSvcHelper{svcMocks.NewMockTokenServiceInterface(t)},
+		Users:        &UserSvcHelper{svcMocks.NewMockUserServiceInterface(t)},
+		Accounts:     &AccountSvcHelper{svcMocks.NewMockAccountServiceInterface(t)},
+		Admin:        &AdminSvcHelper{svcMocks.NewMockAdminServiceInterface(t)},
+		Catalog:      &CatalogSvcHelper{svcMocks.NewMockCatalogServiceInterface(t)},
+		Movies:       &MovieSvcHelper{svcMocks.NewMockMovieServiceInterface(t)},
+		TV:           &TVSvcHelper{svcMocks.NewMockTVServiceInterface(t)},
+		People:       &PersonSvcHelper{svcMocks.NewMockPersonServiceInterface(t)},
+		Recommend:    &RecommendationSvcHelper{svcMocks.NewMockRecommendationServiceInterface(t)},
+		Availability: &AvailabilitySvcHelper{svcMocks.NewMockAvailabilityServiceInterface(t)},
+		Progress:     &ProgressSvcHelper{svcMocks.NewMockProgressServiceInterface(t)},
+		Diary:        &DiarySvcHelper{svcMocks.NewMockDiaryServiceInterface(t)},
+		Lists:        &ListSvcHelper{svcMocks.NewMockListServiceInterface(t)},
+		Social:       &SocialSvcHelper{svcMocks.NewMockSocialServiceInterface(t)},
 	}
 
 	authH := NewAuthHandler(ts.Auth.MockAuthServiceInterface)
+	sessionH := NewSessionHandler(ts.Auth.MockAuthServiceInterface)
+	identityH := NewIdentityHandler(ts.Auth.MockAuthServiceInterface)
+	tokenH := NewTokenHandler(ts.Tokens.MockTokenServiceInterface)
+	deviceH := NewDeviceHandler(ts.Auth.MockAuthServiceInterface, "test-client-id")
 	userH := NewUserHandler(ts.Users.MockUserServiceInterface)
+	accountH := NewAccountHandler(ts.Accounts.MockAccountServiceInterface)
+	adminH := NewAdminHandler(ts.Admin.MockAdminServiceInterface)
+	catalogH := NewCatalogHandler(ts.Catalog.MockCatalogServiceInterface)
 	movieH := NewMovieHandler(ts.Movies.MockMovieServiceInterface)
+	tvH := NewTVHandler(ts.TV.MockTVServiceInterface)
+	personH := NewPersonHandler(ts.People.MockPersonServiceInterface)
+	recommendationH := NewRecommendationHandler(ts.Recommend.MockRecommendationServiceInterface)
+	availabilityH := NewAvailabilityHandler(ts.Availability.MockAvailabilityServiceInterface)
+	progressH := NewProgressHandler(ts.Progress.MockProgressServiceInterface)
+	diaryH := NewDiaryHandler(ts.Diary.MockDiaryServiceInterface)
+	listH := NewListHandler(ts.Lists.MockListServiceInterface)
 	socialH := NewSocialHandler(ts.Social.MockSocialServiceInterface)
 
 	r := gin.New()
 
+	r.GET("/.well-known/jwks.json", NewKeysHandler(signing.NewHMACKeySet("test-secret")).JWKS)
+
 	// Auth routes (no user_id middleware)
+	r.GET("/auth/providers", authH.ListProviders)
 	r.POST("/auth/google", authH.GoogleLogin)
+	r.POST("/auth/login/:provider", authH.ProviderLogin)
+	r.POST("/auth/refresh", authH.Refresh)
+	r.POST("/auth/logout", authH.Logout)
+	r.POST("/auth/device/code", deviceH.RequestCode)
+	r.POST("/auth/device/token", deviceH.PollToken)
+	r.GET("/auth/device", deviceH.VerificationPage)
+	r.POST("/auth/device/verify", deviceH.Verify)
 
 	// Protected routes (inject test user_id)
 	protected := r.Group("/")
 	protected.Use(func(c *gin.Context) {
 		c.Set("user_id", testUserID)
+		c.Set("session_id", testSessionID)
 		c.Next()
 	})
 
 	// User
 	protected.GET("/user/profile", userH.GetProfile)
+	protected.PUT("/user/profile", userH.UpdateProfile)
 	protected.PUT("/user/streaming-services", userH.UpdateStreamingServices)
+	protected.GET("/streaming-services", catalogH.ListServices)
+
+	// Account
+	protected.GET("/user/export", accountH.Export)
+	protected.DELETE("/user", accountH.DeleteAccount)
+	protected.POST("/user/restore", accountH.RestoreAccount)
+
+	// Sessions
+	protected.GET("/user/sessions", sessionH.ListSessions)
+	protected.DELETE("/user/sessions/:id", sessionH.RevokeSession)
+
+	// Linked identities
+	protected.GET("/user/identities", identityH.ListIdentities)
+	protected.POST("/user/identities/:provider", identityH.LinkIdentity)
+	protected.DELETE("/user/identities/:id", identityH.UnlinkIdentity)
+
+	// Personal access tokens
+	protected.POST("/user/tokens", tokenH.CreateToken)
+	protected.GET("/user/tokens", tokenH.ListTokens)
+	protected.DELETE("/user/tokens/:id", tokenH.RevokeToken)
 
 	// Movies
 	protected.GET("/discover", movieH.GetDiscoverFeed)
 	protected.GET("/discover/all", movieH.GetDiscoverAll)
+	protected.GET("/genres", movieH.GetGenres)
 	protected.GET("/search", movieH.SearchMovies)
 	protected.GET("/movies/:id", movieH.GetMovieDetail)
 	protected.GET("/movies/:id/videos", movieH.GetMovieVideos)
 	protected.GET("/movies/:id/credits", movieH.GetMovieCredits)
+	protected.GET("/movies/:id/similar", movieH.GetSimilarMovies)
+	protected.GET("/movies/:id/recommendations", movieH.GetMovieRecommendations)
 	protected.GET("/movies/:id/providers", movieH.GetMovieProviders)
 
+	// TV
+	protected.GET("/tv/:id", tvH.GetShow)
+	protected.GET("/tv/:id/season/:season", tvH.GetSeason)
+	protected.GET("/tv/:id/season/:season/episode/:episode", tvH.GetEpisode)
+
+	// People
+	protected.GET("/people/:id", personH.GetPerson)
+	protected.GET("/people/:id/credits", personH.GetPersonCredits)
+	protected.GET("/people/:id/images", personH.GetPersonImages)
+
+	// Recommendations
+	protected.GET("/recommendations", recommendationH.GetRecommendations)
+
 	// Watchlist
 	protected.GET("/watchlist", movieH.GetWatchlist)
+	protected.GET("/watchlist/availability", availabilityH.GetWatchlistAvailability)
 	protected.POST("/watchlist", movieH.AddToWatchlist)
 	protected.DELETE("/watchlist/:movie_id", movieH.RemoveFromWatchlist)
 	protected.GET("/watchlist/:movie_id/check", movieH.CheckWatchlist)
+	protected.GET("/watchlist/up-next", progressH.UpNext)
+	protected.GET("/watchlist/:movie_id/progress", progressH.GetProgress)
+	protected.PUT("/watchlist/:movie_id/progress/season/:season", progressH.MarkSeason)
+	protected.DELETE("/watchlist/:movie_id/progress/season/:season", progressH.UnmarkSeason)
+	protected.PUT("/watchlist/:movie_id/progress/season/:season/episode/:episode", progressH.MarkEpisode)
+	protected.DELETE("/watchlist/:movie_id/progress/season/:season/episode/:episode", progressH.UnmarkEpisode)
+
+	// Diary
+	protected.GET("/diary", diaryH.ListEntries)
+	protected.POST("/diary", diaryH.LogEntry)
+	protected.PATCH("/diary/:id", diaryH.UpdateEntry)
+	protected.DELETE("/diary/:id", diaryH.DeleteEntry)
+
+	// Custom lists
+	protected.GET("/lists", listH.GetLists)
+	protected.POST("/lists", listH.CreateList)
+	protected.GET("/lists/:id", listH.GetList)
+	protected.PATCH("/lists/:id", listH.UpdateList)
+	protected.DELETE("/lists/:id", listH.DeleteList)
+	protected.POST("/lists/:id/items", listH.AddItem)
+	protected.DELETE("/lists/:id/items/:item_id", listH.RemoveItem)
+	protected.PUT("/lists/:id/order", listH.ReorderItems)
+	protected.PUT("/lists/:id/members/:user_id", listH.SetMember)
+	protected.DELETE("/lists/:id/members/:user_id", listH.RemoveMember)
+	protected.GET("/lists/:id/history", listH.GetHistory)
 
 	// Social
 	protected.GET("/friends", socialH.GetFriends)
@@ -81,6 +196,17 @@ func newTestServer(t *testing.T) *TestServer {
 	protected.GET("/feed", socialH.GetFriendsFeed)
 	protected.POST("/posts", socialH.CreatePost)
 
+	// Admin
+	protected.GET("/admin/users", adminH.SearchUsers)
+	protected.GET("/admin/users/:id", adminH.GetUser)
+	protected.POST("/admin/users/:id/ban", adminH.BanUser)
+	protected.POST("/admin/users/:id/unban", adminH.UnbanUser)
+	protected.POST("/admin/users/:id/revoke-sessions", adminH.RevokeSessions)
+	protected.PUT("/admin/users/:id/role", adminH.SetRole)
+	protected.POST("/admin/streaming-services", catalogH.CreateService)
+	protected.PUT("/admin/streaming-services/:id", catalogH.UpdateService)
+	protected.DELETE("/admin/streaming-services/:id", catalogH.DeleteService)
+
 	ts.Router = r
 
 	return ts
@@ -100,11 +226,84 @@ type AuthSvcHelper struct {
 }
 
 func (h *AuthSvcHelper) LogsIn(token string, result *service.AuthResult) {
-	h.On("GoogleLogin", mock.Anything, token).Return(result, nil)
+	h.LogsInWith(service.ProviderGoogle, token, result)
+}
+
+func (h *AuthSvcHelper) LogsInWith(provider string, token string, result *service.AuthResult) {
+	h.On("Login", mock.Anything, provider, token, mock.AnythingOfType("service.ClientInfo")).Return(result, nil)
 }
 
 func (h *AuthSvcHelper) LoginFails(token string, err error) {
-	h.On("GoogleLogin", mock.Anything, token).Return((*service.AuthResult)(nil), err)
+	h.On("Login", mock.Anything, mock.AnythingOfType("string"), token, mock.AnythingOfType("service.ClientInfo")).
+		Return((*service.AuthResult)(nil), err)
+}
+
+func (h *AuthSvcHelper) Refreshes(token string, result *service.AuthResult) {
+	h.On("Refresh", mock.Anything, token, mock.AnythingOfType("service.ClientInfo")).Return(result, nil)
+}
+
+func (h *AuthSvcHelper) RefreshFails(token string, err error) {
+	h.On("Refresh", mock.Anything, token, mock.AnythingOfType("service.ClientInfo")).
+		Return((*service.AuthResult)(nil), err)
+}
+
+func (h *AuthSvcHelper) LogsOut(token string, err error) {
+	h.On("Logout", mock.Anything, token).Return(err)
+}
+
+func (h *AuthSvcHelper) IssuesDeviceCode(code *service.DeviceCode) {
+	h.On("RequestDeviceCode", mock.Anything).Return(code, nil)
+}
+
+func (h *AuthSvcHelper) PollsDevice(deviceCode string, result *service.AuthResult, err error) {
+	h.On("PollDeviceToken", mock.Anything, deviceCode, mock.AnythingOfType("service.ClientInfo")).Return(result, err)
+}
+
+func (h *AuthSvcHelper) ApprovesDevice(userCode string, accessToken string, err error) {
+	h.On("ApproveDevice", mock.Anything, userCode, accessToken).Return(err)
+}
+
+func (h *AuthSvcHelper) ListsSessions(sessions []models.Session) {
+	h.On("ListSessions", mock.AnythingOfType("uuid.UUID"), testSessionID).Return(sessions, nil)
+}
+
+func (h *AuthSvcHelper) RevokesSession(err error) {
+	h.On("RevokeSession", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("uuid.UUID")).Return(err)
+}
+
+func (h *AuthSvcHelper) ListsIdentities(identities []models.UserIdentity) {
+	h.On("ListIdentities", mock.AnythingOfType("uuid.UUID")).Return(identities, nil)
+}
+
+func (h *AuthSvcHelper) LinksIdentity(provider string, token string, identity *models.UserIdentity, err error) {
+	h.On("LinkIdentity", mock.Anything, mock.AnythingOfType("uuid.UUID"), provider, token).Return(identity, err)
+}
+
+func (h *AuthSvcHelper) UnlinksIdentity(err error) {
+	h.On("UnlinkIdentity", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("uuid.UUID")).Return(err)
+}
+
+// --- TokenSvcHelper ---
+
+type TokenSvcHelper struct {
+	*svcMocks.MockTokenServiceInterface
+}
+
+func (h *TokenSvcHelper) CreatesToken(name string, scopes []string, days int, token *models.PersonalAccessToken) {
+	h.On("CreateToken", mock.AnythingOfType("uuid.UUID"), name, scopes, days).Return(token, nil)
+}
+
+func (h *TokenSvcHelper) CreateFails(err error) {
+	h.On("CreateToken", mock.AnythingOfType("uuid.UUID"), mock.Anything, mock.Anything, mock.Anything).
+		Return((*models.PersonalAccessToken)(nil), err)
+}
+
+func (h *TokenSvcHelper) ListsTokens(tokens []models.PersonalAccessToken) {
+	h.On("ListTokens", mock.AnythingOfType("uuid.UUID")).Return(tokens, nil)
+}
+
+func (h *TokenSvcHelper) RevokesToken(err error) {
+	h.On("RevokeToken", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("uuid.UUID")).Return(err)
 }
 
 // --- UserSvcHelper ---
@@ -121,6 +320,10 @@ func (h *UserSvcHelper) ProfileNotFound() {
 	h.On("GetProfile", mock.AnythingOfType("uuid.UUID")).Return((*models.User)(nil), service.ErrNotFound)
 }
 
+func (h *UserSvcHelper) UpdatesProfile(user *models.User, err error) {
+	h.On("UpdateProfile", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("service.ProfileUpdate")).Return(user, err)
+}
+
 func (h *UserSvcHelper) UpdatesStreamingServices(serviceIDs []int) {
 	h.On("UpdateStreamingServices", mock.AnythingOfType("uuid.UUID"), serviceIDs).Return(nil)
 }
@@ -129,6 +332,206 @@ func (h *UserSvcHelper) UpdateFails(err error) {
 	h.On("UpdateStreamingServices", mock.AnythingOfType("uuid.UUID"), mock.Anything).Return(err)
 }
 
+// --- AccountSvcHelper ---
+
+type AccountSvcHelper struct {
+	*svcMocks.MockAccountServiceInterface
+}
+
+func (h *AccountSvcHelper) Exports(export *service.AccountExport) {
+	h.On("Export", mock.AnythingOfType("uuid.UUID")).Return(export, nil)
+}
+
+func (h *AccountSvcHelper) SchedulesDeletion(at time.Time) {
+	h.On("RequestDeletion", mock.AnythingOfType("uuid.UUID")).Return(at, nil)
+}
+
+func (h *AccountSvcHelper) CancelsDeletion() {
+	h.On("CancelDeletion", mock.AnythingOfType("uuid.UUID")).Return(nil)
+}
+
+// --- AdminSvcHelper ---
+
+type AdminSvcHelper struct {
+	*svcMocks.MockAdminServiceInterface
+}
+
+func (h *AdminSvcHelper) SearchReturns(query string, users []models.User) {
+	h.On("SearchUsers", query).Return(users, nil)
+}
+
+func (h *AdminSvcHelper) GetsUser(view *service.AdminUserView, err error) {
+	h.On("GetUser", mock.AnythingOfType("uuid.UUID")).Return(view, err)
+}
+
+func (h *AdminSvcHelper) Bans(reason string, err error) {
+	h.On("Ban", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("uuid.UUID"), reason).Return(err)
+}
+
+func (h *AdminSvcHelper) Unbans(err error) {
+	h.On("Unban", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("uuid.UUID")).Return(err)
+}
+
+func (h *AdminSvcHelper) RevokesSessions(revoked int64) {
+	h.On("RevokeSessions", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("uuid.UUID")).Return(revoked, nil)
+}
+
+func (h *AdminSvcHelper) SetsRole(role string, user *models.User, err error) {
+	h.On("SetRole", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("uuid.UUID"), role).Return(user, err)
+}
+
+// --- CatalogSvcHelper ---
+
+type CatalogSvcHelper struct {
+	*svcMocks.MockCatalogServiceInterface
+}
+
+func (h *CatalogSvcHelper) ListsServices(services []models.StreamingService) {
+	h.On("ListServices").Return(services, nil)
+}
+
+func (h *CatalogSvcHelper) CreatesService(service *models.StreamingService, err error) {
+	h.On("CreateService", mock.AnythingOfType("service.StreamingServiceInput")).Return(service, err)
+}
+
+func (h *CatalogSvcHelper) UpdatesService(id int, service *models.StreamingService, err error) {
+	h.On("UpdateService", id, mock.AnythingOfType("service.StreamingServiceInput")).Return(service, err)
+}
+
+func (h *CatalogSvcHelper) DeletesService(id int, err error) {
+	h.On("DeleteService", id).Return(err)
+}
+
+// --- TVSvcHelper ---
+
+type TVSvcHelper struct {
+	*svcMocks.MockTVServiceInterface
+}
+
+func (h *TVSvcHelper) ReturnsShow(id int, show *models.TVShow, err error) {
+	h.On("GetShow", mock.AnythingOfType("uuid.UUID"), id).Return(show, err)
+}
+
+func (h *TVSvcHelper) ReturnsSeason(id int, seasonNumber int, season *models.Season, err error) {
+	h.On("GetSeason", id, seasonNumber).Return(season, err)
+}
+
+func (h *TVSvcHelper) ReturnsEpisode(id int, seasonNumber int, episodeNumber int, episode *models.Episode, err error) {
+	h.On("GetEpisode", id, seasonNumber, episodeNumber).Return(episode, err)
+}
+
+// --- PersonSvcHelper ---
+
+type PersonSvcHelper struct {
+	*svcMocks.MockPersonServiceInterface
+}
+
+func (h *PersonSvcHelper) ReturnsPerson(id int, person *models.Person, err error) {
+	h.On("GetPerson", id).Return(person, err)
+}
+
+func (h *PersonSvcHelper) ReturnsCredits(id int, credits *models.PersonCredits, err error) {
+	h.On("GetCredits", id).Return(credits, err)
+}
+
+func (h *PersonSvcHelper) ReturnsImages(id int, images []models.PersonImage, err error) {
+	h.On("GetImages", id).Return(images, err)
+}
+
+// --- RecommendationSvcHelper ---
+
+type RecommendationSvcHelper struct {
+	*svcMocks.MockRecommendationServiceInterface
+}
+
+func (h *RecommendationSvcHelper) Recommends(results []service.Recommendation, err error) {
+	h.On("Recommend", mock.AnythingOfType("uuid.UUID")).Return(results, err)
+}
+
+// --- ProgressSvcHelper ---
+
+type ProgressSvcHelper struct {
+	*svcMocks.MockProgressServiceInterface
+}
+
+func (h *ProgressSvcHelper) ReturnsProgress(showID int, progress *service.ShowProgress, err error) {
+	h.On("GetProgress", mock.AnythingOfType("uuid.UUID"), showID).Return(progress, err)
+}
+
+func (h *ProgressSvcHelper) MarksEpisode(showID int, seasonNumber int, episodeNumber int, err error) {
+	h.On("MarkEpisode", mock.AnythingOfType("uuid.UUID"), showID, seasonNumber, episodeNumber).Return(err)
+}
+
+func (h *ProgressSvcHelper) UnmarksEpisode(showID int, seasonNumber int, episodeNumber int, err error) {
+	h.On("UnmarkEpisode", mock.AnythingOfType("uuid.UUID"), showID, seasonNumber, episodeNumber).Return(err)
+}
+
+func (h *ProgressSvcHelper) MarksSeason(showID int, seasonNumber int, marked int, err error) {
+	h.On("MarkSeason", mock.AnythingOfType("uuid.UUID"), showID, seasonNumber).Return(marked, err)
+}
+
+func (h *ProgressSvcHelper) ReturnsUpNext(entries []service.UpNextEntry, err error) {
+	h.On("UpNext", mock.AnythingOfType("uuid.UUID")).Return(entries, err)
+}
+
+// --- DiarySvcHelper ---
+
+type DiarySvcHelper struct {
+	*svcMocks.MockDiaryServiceInterface
+}
+
+func (h *DiarySvcHelper) ReturnsEntries(entries []models.DiaryEntry, err error) {
+	h.On("ListEntries", mock.AnythingOfType("uuid.UUID")).Return(entries, err)
+}
+
+func (h *DiarySvcHelper) LogsEntry(entry *models.DiaryEntry, removed bool, err error) {
+	h.On("LogEntry", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("service.DiaryEntryInput")).Return(entry, removed, err)
+}
+
+func (h *DiarySvcHelper) UpdatesEntry(id uuid.UUID, entry *models.DiaryEntry, err error) {
+	h.On("UpdateEntry", mock.AnythingOfType("uuid.UUID"), id, mock.AnythingOfType("service.DiaryEntryUpdate")).Return(entry, err)
+}
+
+func (h *DiarySvcHelper) DeletesEntry(id uuid.UUID, err error) {
+	h.On("DeleteEntry", mock.AnythingOfType("uuid.UUID"), id).Return(err)
+}
+
+// --- ListSvcHelper ---
+
+type ListSvcHelper struct {
+	*svcMocks.MockListServiceInterface
+}
+
+func (h *ListSvcHelper) CreatesList(list *models.List, err error) {
+	h.On("CreateList", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("service.ListInput")).Return(list, err)
+}
+
+func (h *ListSvcHelper) ReturnsList(id uuid.UUID, list *models.List, err error) {
+	h.On("GetList", mock.AnythingOfType("uuid.UUID"), id).Return(list, err)
+}
+
+func (h *ListSvcHelper) AddsItem(id uuid.UUID, item *models.ListItem, err error) {
+	h.On("AddItem", mock.AnythingOfType("uuid.UUID"), id, mock.AnythingOfType("service.ListItemInput")).Return(item, err)
+}
+
+func (h *ListSvcHelper) Reorders(id uuid.UUID, version int, itemIDs []uuid.UUID, list *models.List, err error) {
+	h.On("ReorderItems", mock.AnythingOfType("uuid.UUID"), id, version, itemIDs).Return(list, err)
+}
+
+func (h *ListSvcHelper) SetsMember(id uuid.UUID, memberID uuid.UUID, role string, member *models.ListMember, err error) {
+	h.On("SetMember", mock.AnythingOfType("uuid.UUID"), id, memberID, role).Return(member, err)
+}
+
+// --- AvailabilitySvcHelper ---
+
+type AvailabilitySvcHelper struct {
+	*svcMocks.MockAvailabilityServiceInterface
+}
+
+func (h *AvailabilitySvcHelper) ReturnsAvailability(region string, items []service.WatchlistAvailability, err error) {
+	h.On("GetAvailability", mock.AnythingOfType("uuid.UUID"), region).Return(items, err)
+}
+
 // --- MovieSvcHelper ---
 
 type MovieSvcHelper struct {
@@ -143,6 +546,22 @@ func (h *MovieSvcHelper) DiscoverFails(genre string, err error) {
 	h.On("Discover", mock.AnythingOfType("uuid.UUID"), genre, 1).Return([]models.Movie(nil), err)
 }
 
+func (h *MovieSvcHelper) ReturnsGenres(mediaType string, genres []models.Genre, err error) {
+	h.On("GetGenres", mediaType).Return(genres, err)
+}
+
+func (h *MovieSvcHelper) DiscoversTV(category string, shows []models.Movie, err error) {
+	h.On("DiscoverTV", mock.AnythingOfType("uuid.UUID"), category, 1).Return(shows, err)
+}
+
+func (h *MovieSvcHelper) DiscoversFiltered(filters service.DiscoverFilters, movies []models.Movie, err error) {
+	h.On("DiscoverFiltered", mock.AnythingOfType("uuid.UUID"), filters).Return(movies, err)
+}
+
+func (h *MovieSvcHelper) DiscoversOnMyServices(mediaType string, region string, movies []models.Movie, err error) {
+	h.On("DiscoverOnMyServices", mock.AnythingOfType("uuid.UUID"), mediaType, region, 1).Return(movies, err)
+}
+
 func (h *MovieSvcHelper) Searches(query string, page int, movies []models.Movie) {
 	h.On("Search", mock.AnythingOfType("uuid.UUID"), query, page).Return(movies, nil)
 }
@@ -163,12 +582,25 @@ func (h *MovieSvcHelper) ReturnsCredits(mediaType string, id int, credits *tmdb.
 	h.On("GetCredits", mediaType, id).Return(credits, nil)
 }
 
-func (h *MovieSvcHelper) ReturnsProviders(mediaType string, id int, providers json.RawMessage) {
-	h.On("GetProviders", mediaType, id).Return(providers, nil)
+func (h *MovieSvcHelper) ReturnsSimilar(mediaType string, id int, page int, movies []models.Movie, err error) {
+	h.On("GetSimilar", mock.AnythingOfType("uuid.UUID"), mediaType, id, page).Return(movies, err)
+}
+
+func (h *MovieSvcHelper) ReturnsRecommendations(mediaType string, id int, page int, movies []models.Movie, err error) {
+	h.On("GetRecommendations", mock.AnythingOfType("uuid.UUID"), mediaType, id, page).Return(movies, err)
+}
+
+func (h *MovieSvcHelper) ReturnsProviders(mediaType string, id int, region string, providers *models.WatchProviders) {
+	h.On("GetProviders", mock.AnythingOfType("uuid.UUID"), mediaType, id, region).Return(providers, nil)
+}
+
+func (h *MovieSvcHelper) ProvidersFail(region string, err error) {
+	h.On("GetProviders", mock.AnythingOfType("uuid.UUID"), mock.Anything, mock.Anything, region).
+		Return((*models.WatchProviders)(nil), err)
 }
 
-func (h *MovieSvcHelper) ReturnsWatchlist(items []models.Watchlist) {
-	h.On("GetWatchlist", mock.AnythingOfType("uuid.UUID")).Return(items, nil)
+func (h *MovieSvcHelper) ReturnsWatchlist(filters service.WatchlistFilters, page *service.WatchlistPage, err error) {
+	h.On("GetWatchlist", mock.AnythingOfType("uuid.UUID"), filters).Return(page, err)
 }
 
 func (h *MovieSvcHelper) AddsToWatchlist(item *models.Watchlist) {
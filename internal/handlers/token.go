@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// TokenHandler handles personal access token endpoints.
+type TokenHandler struct {
+	svc service.TokenServiceInterface
+}
+
+// NewTokenHandler creates a new TokenHandler.
+func NewTokenHandler(svc service.TokenServiceInterface) *TokenHandler {
+	return &TokenHandler{svc: svc}
+}
+
+// CreateToken mints a personal access token. The token is only ever shown in this response.
+func (h *TokenHandler) CreateToken(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name          string   `json:"name" binding:"required"`
+		Scopes        []string `json:"scopes" binding:"required"`
+		ExpiresInDays int      `json:"expires_in_days"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	token, err := h.svc.CreateToken(userID, req.Name, req.Scopes, req.ExpiresInDays)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidScope):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid scopes", "valid_scopes": models.TokenScopes})
+		case errors.Is(err, service.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid token name or expiry"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create token"})
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, token)
+}
+
+// ListTokens returns the user's personal access tokens without their secrets.
+func (h *TokenHandler) ListTokens(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	tokens, err := h.svc.ListTokens(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tokens"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": tokens})
+}
+
+// RevokeToken permanently disables a personal access token.
+func (h *TokenHandler) RevokeToken(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	tokenID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid token ID"})
+
+		return
+	}
+
+	if err := h.svc.RevokeToken(userID, tokenID); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Token not found"})
+
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke token"})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Token revoked"})
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestCreateToken(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {`{"name": "cron", "scopes": ["watchlist:write"], "expires_in_days": 30}`, func(ts *TestServer) {
+			ts.Tokens.CreatesToken("cron", []string{"watchlist:write"}, 30, &models.PersonalAccessToken{
+				ID: uuid.New(), Name: "cron", Token: "mtp_secret",
+			})
+		}, http.StatusCreated},
+		"invalid scope": {`{"name": "cron", "scopes": ["admin"]}`, func(ts *TestServer) {
+			ts.Tokens.CreateFails(service.ErrInvalidScope)
+		}, http.StatusBadRequest},
+		"missing name": {`{"scopes": ["movies:read"]}`, func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("POST", "/user/tokens", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestCreateToken_ShowsSecretOnce(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Tokens.CreatesToken("cron", []string{"movies:read"}, 0, &models.PersonalAccessToken{
+		Name: "cron", Token: "mtp_secret", TokenHash: "hash",
+	})
+
+	req := httptest.NewRequest("POST", "/user/tokens", strings.NewReader(`{"name": "cron", "scopes": ["movies:read"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := ts.Do(req)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "mtp_secret", resp["token"])
+	assert.NotContains(t, resp, "token_hash")
+}
+
+func TestListTokens(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Tokens.ListsTokens([]models.PersonalAccessToken{{Name: "cron", Prefix: "mtp_abcdefgh"}})
+
+	w := ts.Do(httptest.NewRequest("GET", "/user/tokens", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), `"token":`)
+}
+
+func TestRevokeToken(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/user/tokens/" + uuid.NewString(), func(ts *TestServer) {
+			ts.Tokens.RevokesToken(nil)
+		}, http.StatusOK},
+		"not found": {"/user/tokens/" + uuid.NewString(), func(ts *TestServer) {
+			ts.Tokens.RevokesToken(service.ErrNotFound)
+		}, http.StatusNotFound},
+		"invalid id": {"/user/tokens/abc", func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+			w := ts.Do(httptest.NewRequest("DELETE", tt.path, nil))
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
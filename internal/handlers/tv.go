@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+// TVHandler handles TV show, season and episode endpoints.
+type TVHandler struct {
+	svc service.TVServiceInterface
+}
+
+// NewTVHandler creates a new TVHandler.
+func NewTVHandler(svc service.TVServiceInterface) *TVHandler {
+	return &TVHandler{svc: svc}
+}
+
+// GetShow returns a TV show with its seasons, networks and airing status.
+func (h *TVHandler) GetShow(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid show ID"})
+
+		return
+	}
+
+	uid, _ := uuid.Parse(c.GetString("user_id"))
+
+	show, err := h.svc.GetShow(uid, id)
+	if err != nil {
+		writeLookupError(c, err, "Show not found", "Failed to fetch show")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, show)
+}
+
+// GetSeason returns a season of a TV show with its episodes.
+func (h *TVHandler) GetSeason(c *gin.Context) {
+	id, season, ok := parseSeasonParams(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.svc.GetSeason(id, season)
+	if err != nil {
+		writeLookupError(c, err, "Season not found", "Failed to fetch season")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetEpisode returns a single episode of a TV show.
+func (h *TVHandler) GetEpisode(c *gin.Context) {
+	id, season, ok := parseSeasonParams(c)
+	if !ok {
+		return
+	}
+
+	episode, err := strconv.Atoi(c.Param("episode"))
+	if err != nil || episode < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid episode number"})
+
+		return
+	}
+
+	result, err := h.svc.GetEpisode(id, season, episode)
+	if err != nil {
+		writeLookupError(c, err, "Episode not found", "Failed to fetch episode")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// parseSeasonParams reads the :id and :season path parameters, writing a 400
+// if either is invalid. Season 0 holds specials.
+func parseSeasonParams(c *gin.Context) (int, int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid show ID"})
+
+		return 0, 0, false
+	}
+
+	season, err := strconv.Atoi(c.Param("season"))
+	if err != nil || season < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season number"})
+
+		return 0, 0, false
+	}
+
+	return id, season, true
+}
+
+// writeLookupError writes a 404 for ErrNotFound and a 500 otherwise.
+func writeLookupError(c *gin.Context, err error, notFound string, failed string) {
+	if errors.Is(err, service.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": notFound})
+
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{"error": failed})
+}
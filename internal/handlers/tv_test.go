@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
+)
+
+func TestGetShow(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/tv/1399", func(ts *TestServer) {
+			ts.TV.ReturnsShow(1399, &models.TVShow{ID: 1399, Name: "Game of Thrones", NextAirDate: "2026-11-01"}, nil)
+		}, http.StatusOK},
+		"not found": {"/tv/1", func(ts *TestServer) {
+			ts.TV.ReturnsShow(1, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"upstream failure": {"/tv/1399", func(ts *TestServer) {
+			ts.TV.ReturnsShow(1399, nil, errors.New("tmdb down"))
+		}, http.StatusInternalServerError},
+		"invalid id": {"/tv/abc", func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestGetSeason(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/tv/1399/season/1", func(ts *TestServer) {
+			ts.TV.ReturnsSeason(1399, 1, &models.Season{ShowID: 1399, SeasonNumber: 1}, nil)
+		}, http.StatusOK},
+		"specials": {"/tv/1399/season/0", func(ts *TestServer) {
+			ts.TV.ReturnsSeason(1399, 0, &models.Season{ShowID: 1399}, nil)
+		}, http.StatusOK},
+		"not found": {"/tv/1399/season/9", func(ts *TestServer) {
+			ts.TV.ReturnsSeason(1399, 9, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"invalid season": {"/tv/1399/season/-1", func(_ *TestServer) {}, http.StatusBadRequest},
+		"invalid id":     {"/tv/abc/season/1", func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestGetEpisode(t *testing.T) {
+	tests := map[string]struct {
+		path   string
+		setup  func(*TestServer)
+		status int
+	}{
+		"success": {"/tv/1399/season/1/episode/1", func(ts *TestServer) {
+			ts.TV.ReturnsEpisode(1399, 1, 1, &models.Episode{ShowID: 1399, Name: "Winter Is Coming"}, nil)
+		}, http.StatusOK},
+		"not found": {"/tv/1399/season/1/episode/99", func(ts *TestServer) {
+			ts.TV.ReturnsEpisode(1399, 1, 99, nil, service.ErrNotFound)
+		}, http.StatusNotFound},
+		"invalid episode": {"/tv/1399/season/1/episode/0", func(_ *TestServer) {}, http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			w := ts.Do(httptest.NewRequest("GET", tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -41,6 +41,40 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 	c.JSON(http.StatusOK, user)
 }
 
+// UpdateProfile changes the user's handle, display name or bio.
+func (h *UserHandler) UpdateProfile(c *gin.Context) {
+	userID, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.ProfileUpdate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	user, err := h.svc.UpdateProfile(userID, req)
+	if err != nil {
+		var verr *service.ValidationError
+		switch {
+		case errors.As(err, &verr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid profile", "fields": verr.Fields})
+		case errors.Is(err, service.ErrHandleTaken):
+			c.JSON(http.StatusConflict, gin.H{"error": "Username is already taken", "fields": gin.H{"username": "is already taken"}})
+		case errors.Is(err, service.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update profile"})
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
 // UpdateStreamingServices updates the user's streaming service preferences.
 func (h *UserHandler) UpdateStreamingServices(c *gin.Context) {
 	userID, ok := parseUserID(c)
@@ -1,14 +1,17 @@
 package handlers
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/service"
 )
 
 func TestGetProfile(t *testing.T) {
@@ -59,3 +62,43 @@ func TestUpdateStreamingServices(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateProfile(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		setup  func(*TestServer)
+		status int
+		fields string
+	}{
+		"success": {`{"username": "film_fan", "bio": "hi"}`, func(ts *TestServer) {
+			ts.Users.UpdatesProfile(&models.User{Username: "film_fan", Bio: "hi"}, nil)
+		}, http.StatusOK, ""},
+		"invalid fields": {`{"username": "x"}`, func(ts *TestServer) {
+			ts.Users.UpdatesProfile(nil, &service.ValidationError{Fields: map[string]string{"username": "too short"}})
+		}, http.StatusBadRequest, `{"username": "too short"}`},
+		"handle taken": {`{"username": "popular"}`, func(ts *TestServer) {
+			ts.Users.UpdatesProfile(nil, service.ErrHandleTaken)
+		}, http.StatusConflict, `{"username": "is already taken"}`},
+		"malformed body": {`{"username": 5}`, func(_ *TestServer) {}, http.StatusBadRequest, ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := newTestServer(t)
+			tt.setup(ts)
+
+			req := httptest.NewRequest("PUT", "/user/profile", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := ts.Do(req)
+
+			assert.Equal(t, tt.status, w.Code)
+			if tt.fields != "" {
+				var resp struct {
+					Fields json.RawMessage `json:"fields"`
+				}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.JSONEq(t, tt.fields, string(resp.Fields))
+			}
+		})
+	}
+}
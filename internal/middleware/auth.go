@@ -7,16 +7,49 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/milansax96/movie-terminal-api/internal/signing"
 )
 
-// Claims holds JWT token claims including the user ID.
+// Claims holds JWT token claims including the user and session IDs and the user's role.
 type Claims struct {
-	UserID string `json:"user_id"`
+	UserID    string `json:"user_id"`
+	SessionID string `json:"sid,omitempty"`
+	Role      string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// AuthRequired returns middleware that validates JWT tokens.
-func AuthRequired(jwtSecret string) gin.HandlerFunc {
+// PersonalTokenPrefix marks bearer credentials that are personal access tokens rather than JWTs.
+const PersonalTokenPrefix = "mtp_"
+
+// Values stored under the "auth_method" context key.
+const (
+	AuthMethodSession = "session"
+	AuthMethodToken   = "token"
+)
+
+// SessionValidator reports whether the session an access token belongs to is
+// still active. Sessions of banned users are never active.
+type SessionValidator interface {
+	IsSessionActive(sessionID string) (bool, error)
+}
+
+// TokenPrincipal is the identity behind a personal access token.
+type TokenPrincipal struct {
+	UserID string
+	Scopes []string
+}
+
+// PersonalTokenValidator resolves personal access tokens. It returns a nil
+// principal for unknown, expired or revoked tokens.
+type PersonalTokenValidator interface {
+	ValidatePersonalToken(token string) (*TokenPrincipal, error)
+}
+
+// AuthRequired returns middleware that accepts either a JWT from a login
+// session or a personal access token. JWTs are verified with the key named by
+// their kid header, and those whose session has been revoked are rejected.
+func AuthRequired(keys *signing.KeySet, sessions SessionValidator, tokens PersonalTokenValidator) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -35,20 +68,64 @@ func AuthRequired(jwtSecret string) gin.HandlerFunc {
 		}
 
 		tokenString := parts[1]
+		if strings.HasPrefix(tokenString, PersonalTokenPrefix) {
+			authenticatePersonalToken(c, tokens, tokenString)
+
+			return
+		}
+
 		claims := &Claims{}
 
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(_ *jwt.Token) (interface{}, error) {
-			return []byte(jwtSecret), nil
-		})
+		token, err := jwt.ParseWithClaims(tokenString, claims, keys.Keyfunc, jwt.WithValidMethods(keys.Methods()))
 
-		if err != nil || !token.Valid {
+		if err != nil || !token.Valid || claims.SessionID == "" {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
 			c.Abort()
 
 			return
 		}
 
+		active, err := sessions.IsSessionActive(claims.SessionID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate session"})
+			c.Abort()
+
+			return
+		}
+
+		if !active {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Session has been revoked"})
+			c.Abort()
+
+			return
+		}
+
 		c.Set("user_id", claims.UserID)
+		c.Set("session_id", claims.SessionID)
+		c.Set("role", claims.Role)
+		c.Set("auth_method", AuthMethodSession)
 		c.Next()
 	}
 }
+
+func authenticatePersonalToken(c *gin.Context, tokens PersonalTokenValidator, tokenString string) {
+	principal, err := tokens.ValidatePersonalToken(tokenString)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate token"})
+		c.Abort()
+
+		return
+	}
+
+	if principal == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		c.Abort()
+
+		return
+	}
+
+	c.Set("user_id", principal.UserID)
+	c.Set("token_scopes", principal.Scopes)
+	c.Set("auth_method", AuthMethodToken)
+	c.Next()
+}
@@ -1,31 +1,86 @@
 package middleware
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/milansax96/movie-terminal-api/internal/signing"
+)
+
+const (
+	testSecret    = "test-secret-key"
+	testSessionID = "session-123"
 )
 
-const testSecret = "test-secret-key"
+// fakeSessions treats every session as active except those listed as revoked.
+type fakeSessions struct {
+	revoked map[string]bool
+}
+
+func (f fakeSessions) IsSessionActive(sessionID string) (bool, error) {
+	return !f.revoked[sessionID], nil
+}
+
+// fakeTokens resolves personal access tokens from a fixed table.
+type fakeTokens map[string]*TokenPrincipal
+
+func (f fakeTokens) ValidatePersonalToken(token string) (*TokenPrincipal, error) {
+	return f[token], nil
+}
 
 func setupRouter() *gin.Engine {
+	return setupRouterWithSessions(fakeSessions{})
+}
+
+func setupRouterWithSessions(sessions SessionValidator) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
-	r.Use(AuthRequired(testSecret))
+	r.Use(AuthRequired(signing.NewHMACKeySet(testSecret), sessions, fakeTokens{
+		"mtp_valid": {UserID: "user-456", Scopes: []string{"watchlist:read"}},
+	}))
 	r.GET("/test", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"user_id": c.GetString("user_id")})
 	})
+	r.GET("/watchlist", RequireScope("watchlist:read"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	r.POST("/watchlist", RequireScope("watchlist:write"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	r.GET("/account", RequireSession(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	r.GET("/admin", RequireRole("admin"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
 
 	return r
 }
 
 func generateTestToken(userID string, secret string, expiry time.Duration) string {
+	return generateSessionToken(userID, testSessionID, secret, expiry)
+}
+
+func generateSessionToken(userID string, sessionID string, secret string, expiry time.Duration) string {
+	return generateRoleToken(userID, sessionID, "", secret, expiry)
+}
+
+func generateRoleToken(userID string, sessionID string, role string, secret string, expiry time.Duration) string {
 	claims := &Claims{
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
+		Role:      role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -116,3 +171,178 @@ func TestAuthRequired_InvalidFormat(t *testing.T) {
 		t.Fatalf("expected 401, got %d", w.Code)
 	}
 }
+
+func TestAuthRequired_MissingSessionID(t *testing.T) {
+	r := setupRouter()
+	token := generateSessionToken("user-123", "", testSecret, time.Hour)
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestAuthRequired_RevokedSession(t *testing.T) {
+	r := setupRouterWithSessions(fakeSessions{revoked: map[string]bool{testSessionID: true}})
+	token := generateTestToken("user-123", testSecret, time.Hour)
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", w.Code)
+	}
+}
+
+func TestAuthRequired_PersonalToken(t *testing.T) {
+	tests := map[string]struct {
+		token  string
+		status int
+	}{
+		"valid":   {"mtp_valid", http.StatusOK},
+		"unknown": {"mtp_unknown", http.StatusUnauthorized},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := setupRouter()
+
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	sessionToken := "Bearer " + generateTestToken("user-123", testSecret, time.Hour)
+
+	tests := map[string]struct {
+		method string
+		path   string
+		auth   string
+		status int
+	}{
+		"token with scope":         {"GET", "/watchlist", "Bearer mtp_valid", http.StatusOK},
+		"token missing scope":      {"POST", "/watchlist", "Bearer mtp_valid", http.StatusForbidden},
+		"session has all scopes":   {"POST", "/watchlist", sessionToken, http.StatusOK},
+		"token on session route":   {"GET", "/account", "Bearer mtp_valid", http.StatusForbidden},
+		"session on session route": {"GET", "/account", sessionToken, http.StatusOK},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := setupRouter()
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", tt.auth)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	tests := map[string]struct {
+		auth   string
+		status int
+	}{
+		"admin session":  {"Bearer " + generateRoleToken("user-123", testSessionID, "admin", testSecret, time.Hour), http.StatusOK},
+		"user session":   {"Bearer " + generateRoleToken("user-123", testSessionID, "user", testSecret, time.Hour), http.StatusForbidden},
+		"no role claim":  {"Bearer " + generateTestToken("user-123", testSecret, time.Hour), http.StatusForbidden},
+		"personal token": {"Bearer mtp_valid", http.StatusForbidden},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := setupRouter()
+
+			req := httptest.NewRequest("GET", "/admin", nil)
+			req.Header.Set("Authorization", tt.auth)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+}
+
+func TestAuthRequired_KeyID(t *testing.T) {
+	dir := t.TempDir()
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKCS8PrivateKey(edKey)
+	if err := os.WriteFile(filepath.Join(dir, "2026-10.pem"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := signing.LoadKeySet(dir, "2026-10", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(AuthRequired(keys, fakeSessions{}, fakeTokens{}))
+	r.GET("/test", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	claims := &Claims{
+		UserID:    "user-123",
+		SessionID: testSessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	signed, err := keys.Sign(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	forged := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	forged.Header["kid"] = "2026-10"
+	forgedStr, _ := forged.SignedString(otherKey)
+
+	tests := map[string]struct {
+		token  string
+		status int
+	}{
+		"signed by active key":  {signed, http.StatusOK},
+		"signed by another key": {forgedStr, http.StatusUnauthorized},
+		"hs256 without kid":     {generateTestToken("user-123", testSecret, time.Hour), http.StatusUnauthorized},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, w.Code)
+			}
+		})
+	}
+}
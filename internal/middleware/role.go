@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireRole returns middleware that only admits session logins whose role
+// is one of roles. The role comes from the access token, so a demotion takes
+// effect once the user's sessions are revoked or their token is refreshed.
+// Personal access tokens never carry a role.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !slices.Contains(roles, c.GetString("role")) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
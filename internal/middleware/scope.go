@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireScope returns middleware that only admits personal access tokens
+// granted the given scope. Session logins hold every scope.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("auth_method") == AuthMethodSession {
+			c.Next()
+
+			return
+		}
+
+		if !slices.Contains(c.GetStringSlice("token_scopes"), scope) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Token lacks required scope: " + scope})
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireSession returns middleware that rejects personal access tokens, for
+// account-management endpoints that scripts must never reach.
+func RequireSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("auth_method") != AuthMethodSession {
+			c.JSON(http.StatusForbidden, gin.H{"error": "This endpoint requires an interactive login"})
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrackedTitle is a watchlisted title whose watch providers are checked
+// periodically. CheckedAt is nil until the first check.
+type TrackedTitle struct {
+	TMDBId    int    `gorm:"primaryKey;autoIncrement:false"`
+	MediaType string `gorm:"primaryKey"`
+	Title     string
+	CheckedAt *time.Time
+}
+
+// TitleAvailability is a provider currently streaming a title in a region,
+// as of the last check.
+type TitleAvailability struct {
+	TMDBId         int       `gorm:"primaryKey;autoIncrement:false" json:"tmdb_id"`
+	MediaType      string    `gorm:"primaryKey" json:"media_type"`
+	Region         string    `gorm:"primaryKey" json:"region"`
+	ProviderID     int       `gorm:"primaryKey;autoIncrement:false" json:"provider_id"`
+	ProviderName   string    `json:"provider_name"`
+	AvailableSince time.Time `gorm:"not null" json:"available_since"`
+}
+
+// AvailabilityChange records a provider starting or stopping streaming a
+// title in a region.
+type AvailabilityChange struct {
+	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	TMDBId       int       `gorm:"not null;index:idx_availability_change_title" json:"tmdb_id"`
+	MediaType    string    `gorm:"not null;index:idx_availability_change_title" json:"media_type"`
+	Region       string    `gorm:"not null" json:"region"`
+	ProviderID   int       `gorm:"not null" json:"provider_id"`
+	ProviderName string    `json:"provider_name"`
+	Available    bool      `json:"available"`
+	DetectedAt   time.Time `gorm:"not null;index" json:"detected_at"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Device authorization states.
+const (
+	DeviceStatusPending  = "pending"
+	DeviceStatusApproved = "approved"
+	DeviceStatusConsumed = "consumed"
+)
+
+// DeviceAuthorization tracks one OAuth 2.0 device authorization grant
+// (RFC 8628): a terminal polls with the device code while the user approves
+// the matching user code in a browser.
+type DeviceAuthorization struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	DeviceCodeHash string     `gorm:"uniqueIndex;not null"`
+	UserCode       string     `gorm:"uniqueIndex;not null"`
+	Status         string     `gorm:"not null;default:'pending'"`
+	UserID         *uuid.UUID `gorm:"type:uuid"`
+	IsNewUser      bool
+	ExpiresAt      time.Time `gorm:"not null"`
+	LastPolledAt   *time.Time
+	CreatedAt      time.Time
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DiaryEntry records a user watching a title. A title can be logged more
+// than once; a viewing is flagged as a rewatch when the user says so or has
+// logged the title on or before its date.
+type DiaryEntry struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null;index:idx_diary_user_watched,priority:1" json:"user_id"`
+	TMDBId     int       `gorm:"not null" json:"tmdb_id"`
+	MediaType  string    `gorm:"not null" json:"media_type"`
+	Title      string    `json:"title"`
+	PosterPath string    `json:"poster_path"`
+	WatchedOn  time.Time `gorm:"type:date;not null;index:idx_diary_user_watched,priority:2,sort:desc" json:"watched_on"`
+	// Rating is out of 5 in half stars; nil when the user didn't rate it.
+	Rating  *float64 `gorm:"type:numeric(2,1)" json:"rating"`
+	Rewatch bool     `gorm:"not null;default:false" json:"rewatch"`
+	// Notes are private to the user.
+	Notes     string    `json:"notes"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -15,15 +15,32 @@ type Friendship struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
-// Watchlist represents a movie saved to a user's watchlist.
+// Watchlist represents a movie saved to a user's watchlist. The
+// idx_watchlist_* indexes back each sort order of the paginated watchlist.
 type Watchlist struct {
-	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	UserID       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_user_movie" json:"user_id"`
+	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid();index:idx_watchlist_added,priority:3;index:idx_watchlist_title,priority:3;index:idx_watchlist_release,priority:3;index:idx_watchlist_rating,priority:3" json:"id"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_user_movie;index:idx_watchlist_added,priority:1;index:idx_watchlist_title,priority:1;index:idx_watchlist_release,priority:1;index:idx_watchlist_rating,priority:1" json:"user_id"`
 	TMDBId       int       `gorm:"not null;uniqueIndex:idx_user_movie" json:"tmdb_id"`
-	Title        string    `json:"title"`
+	Title        string    `gorm:"index:idx_watchlist_title,priority:2" json:"title"`
 	PosterPath   string    `json:"poster_path"`
 	BackdropPath string    `json:"backdrop_path"`
 	MediaType    string    `gorm:"not null" json:"media_type"`
 	TrailerKey   string    `json:"trailer_key"`
-	AddedAt      time.Time `json:"added_at"`
+	// ReleaseDate is YYYY-MM-DD, or empty when unknown.
+	ReleaseDate string    `gorm:"not null;default:'';index:idx_watchlist_release,priority:2" json:"release_date"`
+	VoteAverage float64   `gorm:"not null;default:0;index:idx_watchlist_rating,priority:2" json:"vote_average"`
+	AddedAt     time.Time `gorm:"index:idx_watchlist_added,priority:2" json:"added_at"`
+	// DetailsSynced is false until the release date, rating and genres are
+	// stored; items saved without them are filled in from TMDB by
+	// service.WatchlistDetailsService.
+	DetailsSynced bool `gorm:"not null;default:false" json:"-"`
+
+	Genres []WatchlistGenre `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// WatchlistGenre tags a watchlist item with a TMDB genre so the watchlist
+// can be filtered by genre.
+type WatchlistGenre struct {
+	WatchlistID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	GenreID     int       `gorm:"primaryKey;autoIncrement:false"`
 }
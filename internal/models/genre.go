@@ -0,0 +1,11 @@
+package models
+
+// Genre is a TMDB genre of movies or TV shows. Slug is derived from the
+// name; Aliases are older slugs that still resolve to it.
+type Genre struct {
+	ID        int      `json:"id"`
+	Name      string   `json:"name"`
+	Slug      string   `json:"slug,omitempty"`
+	MediaType string   `json:"media_type"`
+	Aliases   []string `json:"aliases,omitempty"`
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Who can see a list besides its owner.
+const (
+	ListPrivate = "private"
+	ListFriends = "friends"
+	ListPublic  = "public"
+)
+
+// ListVisibilities lists every valid list visibility.
+var ListVisibilities = []string{ListPrivate, ListFriends, ListPublic}
+
+// Roles a friend can be given on someone else's list. Editors can add,
+// remove and reorder items; viewers can only see the list.
+const (
+	ListRoleEditor = "editor"
+	ListRoleViewer = "viewer"
+)
+
+// ListRoles lists every valid list member role.
+var ListRoles = []string{ListRoleEditor, ListRoleViewer}
+
+// Actions recorded in a list's change history.
+const (
+	ListChangeUpdated       = "updated"
+	ListChangeItemAdded     = "item_added"
+	ListChangeItemRemoved   = "item_removed"
+	ListChangeReordered     = "reordered"
+	ListChangeMemberSet     = "member_set"
+	ListChangeMemberRemoved = "member_removed"
+)
+
+// List is a named, manually ordered list of titles created by a user and
+// optionally shared with friends. The watchlist is the user's default list
+// and is stored separately.
+type List struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+	Title       string    `gorm:"not null" json:"title"`
+	Description string    `json:"description"`
+	Visibility  string    `gorm:"not null;default:'private'" json:"visibility"`
+	// Version goes up whenever the items change so reorders can detect
+	// concurrent edits.
+	Version   int       `gorm:"not null;default:1" json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// ItemCount is only filled in when listing a user's lists.
+	ItemCount int `gorm:"->;-:migration" json:"item_count"`
+
+	Items   []ListItem   `gorm:"constraint:OnDelete:CASCADE" json:"items,omitempty"`
+	Members []ListMember `gorm:"constraint:OnDelete:CASCADE" json:"members,omitempty"`
+	Changes []ListChange `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// ListItem is a title on a custom list. Position orders the list, lowest
+// first. TMDB movie and TV IDs overlap, so a title is its ID and media type,
+// and items are removed and reordered by their own ID.
+type ListItem struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ListID     uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_list_item_title" json:"list_id"`
+	TMDBId     int       `gorm:"not null;uniqueIndex:idx_list_item_title" json:"tmdb_id"`
+	MediaType  string    `gorm:"not null;uniqueIndex:idx_list_item_title" json:"media_type"`
+	Title      string    `json:"title"`
+	PosterPath string    `json:"poster_path"`
+	Position   int       `gorm:"not null" json:"position"`
+	// AddedBy is the owner or editor who added the item; nil once their
+	// account is deleted.
+	AddedBy *uuid.UUID `gorm:"type:uuid" json:"added_by,omitempty"`
+	AddedAt time.Time  `json:"added_at"`
+}
+
+// ListMember gives a friend of the owner access to a list.
+type ListMember struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ListID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_list_member" json:"list_id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_list_member;index" json:"user_id"`
+	Role      string    `gorm:"not null" json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListChange is an entry in a list's change history. TMDBId and MediaType
+// are set for item changes and MemberID and Role for member changes.
+type ListChange struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ListID    uuid.UUID  `gorm:"type:uuid;not null;index:idx_list_change,priority:1" json:"list_id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	Action    string     `gorm:"not null" json:"action"`
+	TMDBId    int        `json:"tmdb_id,omitempty"`
+	MediaType string     `json:"media_type,omitempty"`
+	MemberID  *uuid.UUID `gorm:"type:uuid" json:"member_id,omitempty"`
+	Role      string     `json:"role,omitempty"`
+	CreatedAt time.Time  `gorm:"index:idx_list_change,priority:2,sort:desc" json:"created_at"`
+}
@@ -11,6 +11,7 @@ type Movie struct {
 	ReleaseDate       string  `json:"release_date"`
 	VoteAverage       float64 `json:"vote_average"`
 	MediaType         string  `json:"media_type"`
+	GenreIDs          []int   `json:"genre_ids,omitempty"`
 	IsWatchlisted     bool    `json:"is_watchlisted"`
 	TrailerKey        string  `json:"trailer_key"`
 	ProcessedVideoURL string  `json:"processed_video_url"`
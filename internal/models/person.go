@@ -0,0 +1,47 @@
+package models
+
+// Person is an actor, director or other cast or crew member.
+type Person struct {
+	ID                 int      `json:"id"`
+	Name               string   `json:"name"`
+	AlsoKnownAs        []string `json:"also_known_as"`
+	Biography          string   `json:"biography"`
+	Birthday           string   `json:"birthday"`
+	Deathday           string   `json:"deathday,omitempty"`
+	PlaceOfBirth       string   `json:"place_of_birth"`
+	KnownForDepartment string   `json:"known_for_department"`
+	ProfilePath        string   `json:"profile_path"`
+	Homepage           string   `json:"homepage,omitempty"`
+	IMDbID             string   `json:"imdb_id,omitempty"`
+	Popularity         float64  `json:"popularity"`
+}
+
+// PersonCredit is a movie or TV show a person worked on. Cast credits have a
+// Character; crew credits have a Job and Department.
+type PersonCredit struct {
+	ID           int     `json:"id"`
+	MediaType    string  `json:"media_type"`
+	Title        string  `json:"title"`
+	ReleaseDate  string  `json:"release_date"`
+	PosterPath   string  `json:"poster_path"`
+	VoteAverage  float64 `json:"vote_average"`
+	Character    string  `json:"character,omitempty"`
+	Job          string  `json:"job,omitempty"`
+	Department   string  `json:"department,omitempty"`
+	EpisodeCount int     `json:"episode_count,omitempty"`
+}
+
+// PersonCredits is a person's filmography across movies and TV.
+type PersonCredits struct {
+	Cast []PersonCredit `json:"cast"`
+	Crew []PersonCredit `json:"crew"`
+}
+
+// PersonImage is a profile photo of a person.
+type PersonImage struct {
+	FilePath    string  `json:"file_path"`
+	AspectRatio float64 `json:"aspect_ratio"`
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	VoteAverage float64 `json:"vote_average"`
+}
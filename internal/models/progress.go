@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EpisodeProgress marks an episode of a TV show on the user's watchlist as
+// watched. It is deleted along with the watchlist entry.
+type EpisodeProgress struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	WatchlistID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_progress_episode" json:"watchlist_id"`
+	Watchlist     Watchlist `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+	TMDBId        int       `gorm:"not null" json:"tmdb_id"`
+	SeasonNumber  int       `gorm:"not null;uniqueIndex:idx_progress_episode" json:"season_number"`
+	EpisodeNumber int       `gorm:"not null;uniqueIndex:idx_progress_episode" json:"episode_number"`
+	WatchedAt     time.Time `gorm:"not null" json:"watched_at"`
+}
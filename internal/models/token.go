@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scopes that can be granted to personal access tokens. Session (JWT)
+// logins implicitly hold every scope.
+const (
+	ScopeProfileRead    = "profile:read"
+	ScopeProfileWrite   = "profile:write"
+	ScopeMoviesRead     = "movies:read"
+	ScopeWatchlistRead  = "watchlist:read"
+	ScopeWatchlistWrite = "watchlist:write"
+	ScopeSocialRead     = "social:read"
+	ScopeSocialWrite    = "social:write"
+)
+
+// TokenScopes lists every scope a personal access token may request.
+var TokenScopes = []string{
+	ScopeProfileRead,
+	ScopeProfileWrite,
+	ScopeMoviesRead,
+	ScopeWatchlistRead,
+	ScopeWatchlistWrite,
+	ScopeSocialRead,
+	ScopeSocialWrite,
+}
+
+// PersonalAccessToken is a named, scoped, long-lived credential for scripts.
+// Only the SHA-256 hash is stored; the token itself is shown once on creation.
+type PersonalAccessToken struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	Name       string     `gorm:"not null" json:"name"`
+	Prefix     string     `gorm:"not null" json:"prefix"`
+	TokenHash  string     `gorm:"uniqueIndex;not null" json:"-"`
+	Scopes     []string   `gorm:"serializer:json" json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"-"`
+	CreatedAt  time.Time  `json:"created_at"`
+
+	// Token holds the plaintext token in the creation response only.
+	Token string `gorm:"-" json:"token,omitempty"`
+}
+
+// IsActive reports whether the token is neither revoked nor expired at t.
+func (p *PersonalAccessToken) IsActive(t time.Time) bool {
+	return p.RevokedAt == nil && (p.ExpiresAt == nil || t.Before(*p.ExpiresAt))
+}
@@ -0,0 +1,70 @@
+package models
+
+// TVShow is a TV series with its seasons and airing status.
+type TVShow struct {
+	ID               int             `json:"id"`
+	Name             string          `json:"name"`
+	OriginalName     string          `json:"original_name"`
+	Overview         string          `json:"overview"`
+	PosterPath       string          `json:"poster_path"`
+	BackdropPath     string          `json:"backdrop_path"`
+	FirstAirDate     string          `json:"first_air_date"`
+	LastAirDate      string          `json:"last_air_date"`
+	NextAirDate      string          `json:"next_air_date,omitempty"`
+	Status           string          `json:"status"`
+	InProduction     bool            `json:"in_production"`
+	NumberOfSeasons  int             `json:"number_of_seasons"`
+	NumberOfEpisodes int             `json:"number_of_episodes"`
+	EpisodeRunTime   []int           `json:"episode_run_time"`
+	VoteAverage      float64         `json:"vote_average"`
+	Genres           []Genre         `json:"genres"`
+	Networks         []Network       `json:"networks"`
+	Seasons          []SeasonSummary `json:"seasons"`
+	LastEpisode      *Episode        `json:"last_episode_to_air,omitempty"`
+	NextEpisode      *Episode        `json:"next_episode_to_air,omitempty"`
+	IsWatchlisted    bool            `json:"is_watchlisted"`
+}
+
+// Network is a channel or streaming service that airs a show.
+type Network struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	LogoPath      string `json:"logo_path"`
+	OriginCountry string `json:"origin_country"`
+}
+
+// SeasonSummary describes a season without its episodes. Season 0 holds
+// specials.
+type SeasonSummary struct {
+	SeasonNumber int    `json:"season_number"`
+	Name         string `json:"name"`
+	Overview     string `json:"overview"`
+	EpisodeCount int    `json:"episode_count"`
+	AirDate      string `json:"air_date"`
+	PosterPath   string `json:"poster_path"`
+}
+
+// Season is a season of a show with its episodes.
+type Season struct {
+	ShowID       int       `json:"show_id"`
+	SeasonNumber int       `json:"season_number"`
+	Name         string    `json:"name"`
+	Overview     string    `json:"overview"`
+	AirDate      string    `json:"air_date"`
+	PosterPath   string    `json:"poster_path"`
+	Episodes     []Episode `json:"episodes"`
+}
+
+// Episode is a single episode of a show.
+type Episode struct {
+	ID            int     `json:"id"`
+	ShowID        int     `json:"show_id"`
+	SeasonNumber  int     `json:"season_number"`
+	EpisodeNumber int     `json:"episode_number"`
+	Name          string  `json:"name"`
+	Overview      string  `json:"overview"`
+	AirDate       string  `json:"air_date"`
+	Runtime       int     `json:"runtime"`
+	StillPath     string  `json:"still_path"`
+	VoteAverage   float64 `json:"vote_average"`
+}
@@ -2,29 +2,73 @@
 package models
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-// User represents a registered user.
+// User represents a registered user. Username is the user's unique,
+// lowercase @handle; DisplayName is free-form and need not be unique.
 type User struct {
 	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
 	Username       string    `gorm:"uniqueIndex;not null" json:"username"`
+	DisplayName    string    `gorm:"not null;default:''" json:"display_name"`
+	Bio            string    `gorm:"not null;default:''" json:"bio"`
 	Email          string    `gorm:"uniqueIndex;not null" json:"email"`
-	GoogleID       string    `gorm:"uniqueIndex;not null" json:"-"`
+	Role           string    `gorm:"not null;default:user" json:"role"`
 	ProfilePicture string    `json:"profile_picture,omitempty"`
 	CreatedAt      time.Time `json:"created_at"`
 	UpdatedAt      time.Time `json:"updated_at"`
 
+	// BannedAt is set while the user is banned; banned users cannot log in
+	// or use existing sessions and tokens.
+	BannedAt  *time.Time `json:"-"`
+	BanReason string     `json:"-"`
+
+	// DeleteAfter is set while an account deletion is pending; the account
+	// is purged once it passes unless the user restores it first.
+	DeleteAfter *time.Time `gorm:"index" json:"delete_after,omitempty"`
+
+	// GoogleID predates user_identities and is only read to backfill it.
+	GoogleID *string `gorm:"uniqueIndex" json:"-"`
+
 	StreamingServices []StreamingService `gorm:"many2many:user_streaming_services" json:"streaming_services,omitempty"`
 }
 
-// StreamingService represents a streaming platform.
+// Roles a user can hold.
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// Roles lists every valid role.
+var Roles = []string{RoleUser, RoleAdmin}
+
+// IsBanned reports whether the user is currently banned.
+func (u *User) IsBanned() bool {
+	return u.BannedAt != nil
+}
+
+// StreamingService represents a streaming platform. TMDBProviderID links it
+// to TMDB's watch-provider data; services without one are never matched
+// against provider listings.
 type StreamingService struct {
-	ID   int    `gorm:"primaryKey" json:"id"`
-	Name string `json:"name"`
-	Slug string `gorm:"uniqueIndex" json:"slug"`
+	ID             int    `gorm:"primaryKey" json:"id"`
+	Name           string `gorm:"not null" json:"name"`
+	Slug           string `gorm:"uniqueIndex" json:"slug"`
+	TMDBProviderID *int   `gorm:"uniqueIndex" json:"tmdb_provider_id"`
+	LogoPath       string `gorm:"not null;default:''" json:"logo_path,omitempty"`
+
+	// Regions lists the ISO 3166-1 countries the service is offered in.
+	// An empty list means it is not limited to particular regions.
+	Regions []string `gorm:"serializer:json" json:"regions"`
+}
+
+// OffersRegion reports whether the service is offered in the region. A
+// service without regions is assumed to be offered everywhere.
+func (s *StreamingService) OffersRegion(region string) bool {
+	return len(s.Regions) == 0 || slices.Contains(s.Regions, region)
 }
 
 // UserStreamingService is the join table for users and streaming services.
@@ -0,0 +1,38 @@
+package models
+
+// WatchProviders lists where a title can be watched, keyed by ISO 3166-1
+// region code.
+type WatchProviders struct {
+	TMDBID    int                             `json:"tmdb_id"`
+	MediaType string                          `json:"media_type"`
+	Regions   map[string]RegionWatchProviders `json:"regions"`
+}
+
+// RegionWatchProviders groups a region's providers by how they offer the
+// title. Stream is subscription streaming.
+type RegionWatchProviders struct {
+	Link   string          `json:"link,omitempty"`
+	Stream []WatchProvider `json:"stream,omitempty"`
+	Free   []WatchProvider `json:"free,omitempty"`
+	Ads    []WatchProvider `json:"ads,omitempty"`
+	Rent   []WatchProvider `json:"rent,omitempty"`
+	Buy    []WatchProvider `json:"buy,omitempty"`
+}
+
+// WatchProvider is a service offering a title. ProviderID is TMDB's watch
+// provider ID, which StreamingService.TMDBProviderID maps to.
+type WatchProvider struct {
+	ProviderID      int    `json:"provider_id"`
+	Name            string `json:"name"`
+	LogoPath        string `json:"logo_path,omitempty"`
+	DisplayPriority int    `json:"display_priority"`
+
+	// OnMyServices is set when the provider is one of the caller's
+	// streaming services and that service is offered in the region.
+	OnMyServices bool `json:"on_my_services"`
+}
+
+// Offers returns every provider in the region, whatever the offer type.
+func (r *RegionWatchProviders) Offers() [][]WatchProvider {
+	return [][]WatchProvider{r.Stream, r.Free, r.Ads, r.Rent, r.Buy}
+}
@@ -0,0 +1,228 @@
+// Package recommend ranks titles for a user from a taste profile built out of
+// the titles they saved or posted about. It has no I/O: callers gather the
+// features of seeds and candidates and the scorer only does arithmetic.
+package recommend
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Source is how a seed title entered the user's taste profile.
+type Source string
+
+// Seed sources.
+const (
+	SourceWatchlist Source = "watchlist"
+	SourcePost      Source = "post"
+)
+
+// Weights of the signals a candidate can share with a seed.
+const (
+	sourceWeightWatchlist = 1.0
+	sourceWeightPost      = 1.5
+
+	genreWeight       = 1.0
+	castWeight        = 1.0
+	keywordWeight     = 0.4
+	recommendedWeight = 2.0
+	ratingWeight      = 0.5
+
+	maxSharedCast     = 3
+	maxSharedKeywords = 5
+)
+
+// Title identifies a movie or TV show.
+type Title struct {
+	ID        int
+	MediaType string
+}
+
+// Features are the traits of a title the scorer compares. Cast holds the IDs
+// of both leading actors and directors.
+type Features struct {
+	Genres   []int
+	Cast     []int
+	Keywords []int
+}
+
+// Seed is a title the user saved or posted about.
+type Seed struct {
+	Title
+	Name   string
+	Source Source
+	Features
+}
+
+// Candidate is a title that could be recommended.
+type Candidate struct {
+	Title
+	Features
+	VoteAverage float64
+
+	// RecommendedBy lists the seeds whose TMDB recommendations included the
+	// candidate.
+	RecommendedBy []Title
+}
+
+// Scored is a candidate with its score and the seed that contributed most to
+// it, which explains the pick.
+type Scored struct {
+	Candidate
+	Score   float64
+	Because Seed
+}
+
+// Profile is a user's taste: the titles they saved or posted about, with
+// their genres, people and keywords.
+type Profile struct {
+	Seeds []Seed
+}
+
+// BuildProfile collects seeds into a profile. A title seeded twice, for
+// example saved and posted about, counts once with its strongest source.
+func BuildProfile(seeds []Seed) Profile {
+	var profile Profile
+
+	for _, seed := range seeds {
+		i := slices.IndexFunc(profile.Seeds, func(s Seed) bool { return s.Title == seed.Title })
+		if i < 0 {
+			profile.Seeds = append(profile.Seeds, seed)
+
+			continue
+		}
+
+		if sourceWeight(seed.Source) > sourceWeight(profile.Seeds[i].Source) {
+			profile.Seeds[i].Source = seed.Source
+		}
+	}
+
+	return profile
+}
+
+// TopGenres returns the n heaviest genres of the profile among the seeds of
+// mediaType, heaviest first.
+func (p Profile) TopGenres(mediaType string, n int) []int {
+	weights := map[int]float64{}
+	for _, seed := range p.Seeds {
+		if seed.MediaType != mediaType {
+			continue
+		}
+		for _, id := range seed.Genres {
+			weights[id] += sourceWeight(seed.Source)
+		}
+	}
+
+	genres := make([]int, 0, len(weights))
+	for id := range weights {
+		genres = append(genres, id)
+	}
+
+	slices.SortFunc(genres, func(a, b int) int {
+		return cmp.Or(cmp.Compare(weights[b], weights[a]), cmp.Compare(a, b))
+	})
+
+	return genres[:min(n, len(genres))]
+}
+
+// Score rates how well a candidate matches the profile: the sum of its
+// similarity to each seed, weighted by the seed's source, plus a small bonus
+// for well-rated titles.
+func (p Profile) Score(c Candidate) Scored {
+	scored := Scored{Candidate: c}
+
+	best := 0.0
+	for _, seed := range p.Seeds {
+		s := sourceWeight(seed.Source) * similarity(seed, c)
+		scored.Score += s
+
+		if s > best {
+			best = s
+			scored.Because = seed
+		}
+	}
+
+	if scored.Score > 0 {
+		scored.Score += ratingWeight * c.VoteAverage / 10
+	}
+
+	return scored
+}
+
+// Rank scores the candidates and returns up to limit of the best, leaving out
+// the excluded titles, the seeds themselves and candidates that share nothing
+// with the profile.
+func (p Profile) Rank(candidates []Candidate, exclude map[Title]struct{}, limit int) []Scored {
+	seen := make(map[Title]struct{}, len(candidates))
+	for _, seed := range p.Seeds {
+		seen[seed.Title] = struct{}{}
+	}
+	for title := range exclude {
+		seen[title] = struct{}{}
+	}
+
+	var ranked []Scored
+	for _, c := range candidates {
+		if _, ok := seen[c.Title]; ok {
+			continue
+		}
+		seen[c.Title] = struct{}{}
+
+		if scored := p.Score(c); scored.Because.ID != 0 {
+			ranked = append(ranked, scored)
+		}
+	}
+
+	slices.SortFunc(ranked, func(a, b Scored) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(a.MediaType, b.MediaType),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	return ranked[:min(limit, len(ranked))]
+}
+
+// similarity is what a candidate shares with a seed of the same media type.
+// Genre overlap is relative to the candidate's genres, so a title is not
+// favoured just for listing many; shared people and keywords are capped.
+func similarity(seed Seed, c Candidate) float64 {
+	var s float64
+
+	if slices.Contains(c.RecommendedBy, seed.Title) {
+		s += recommendedWeight
+	}
+
+	if seed.MediaType != c.MediaType {
+		return s
+	}
+
+	if len(c.Genres) > 0 {
+		s += genreWeight * float64(shared(seed.Genres, c.Genres)) / float64(len(c.Genres))
+	}
+
+	s += castWeight * float64(min(shared(seed.Cast, c.Cast), maxSharedCast))
+	s += keywordWeight * float64(min(shared(seed.Keywords, c.Keywords), maxSharedKeywords))
+
+	return s
+}
+
+func shared(a, b []int) int {
+	n := 0
+	for _, id := range b {
+		if slices.Contains(a, id) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func sourceWeight(source Source) float64 {
+	if source == SourcePost {
+		return sourceWeightPost
+	}
+
+	return sourceWeightWatchlist
+}
@@ -0,0 +1,168 @@
+package recommend_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/recommend"
+)
+
+// Fixture IDs from TMDB.
+const (
+	drama    = 18
+	thriller = 53
+	crime    = 80
+	romance  = 10749
+	scifi    = 878
+
+	bradPitt      = 287
+	edwardNorton  = 819
+	davidFincher  = 7467
+	ryanGosling   = 30614
+	rachelMcAdams = 53714
+
+	dualIdentity = 1523
+	serialKiller = 10714
+)
+
+var (
+	fightClub = recommend.Seed{
+		Title:  recommend.Title{ID: 550, MediaType: "movie"},
+		Name:   "Fight Club",
+		Source: recommend.SourceWatchlist,
+		Features: recommend.Features{
+			Genres:   []int{drama, thriller},
+			Cast:     []int{bradPitt, edwardNorton, davidFincher},
+			Keywords: []int{dualIdentity},
+		},
+	}
+	arrival = recommend.Seed{
+		Title:  recommend.Title{ID: 329865, MediaType: "movie"},
+		Name:   "Arrival",
+		Source: recommend.SourcePost,
+		Features: recommend.Features{
+			Genres: []int{drama, scifi},
+		},
+	}
+
+	se7en = recommend.Candidate{
+		Title: recommend.Title{ID: 807, MediaType: "movie"},
+		Features: recommend.Features{
+			Genres:   []int{crime, thriller},
+			Cast:     []int{bradPitt, davidFincher},
+			Keywords: []int{serialKiller},
+		},
+		VoteAverage: 8.4,
+	}
+	theNotebook = recommend.Candidate{
+		Title:       recommend.Title{ID: 11036, MediaType: "movie"},
+		Features:    recommend.Features{Genres: []int{romance}, Cast: []int{ryanGosling, rachelMcAdams}},
+		VoteAverage: 7.9,
+	}
+	interstellar = recommend.Candidate{
+		Title:       recommend.Title{ID: 157336, MediaType: "movie"},
+		Features:    recommend.Features{Genres: []int{drama, scifi}},
+		VoteAverage: 8.4,
+	}
+)
+
+func TestRank(t *testing.T) {
+	profile := recommend.BuildProfile([]recommend.Seed{fightClub, arrival})
+
+	ranked := profile.Rank([]recommend.Candidate{theNotebook, interstellar, se7en}, nil, 10)
+
+	require.Len(t, ranked, 2, "titles sharing nothing with the profile are left out")
+	assert.Equal(t, 807, ranked[0].ID, "shared director and lead outweigh shared genres")
+	assert.Equal(t, "Fight Club", ranked[0].Because.Name)
+	assert.Equal(t, 157336, ranked[1].ID)
+	assert.Equal(t, "Arrival", ranked[1].Because.Name, "the closest seed explains the pick")
+}
+
+func TestRank_ExcludesSavedTitlesAndSeeds(t *testing.T) {
+	profile := recommend.BuildProfile([]recommend.Seed{fightClub})
+	seed := recommend.Candidate{Title: fightClub.Title, Features: fightClub.Features}
+	saved := map[recommend.Title]struct{}{se7en.Title: {}}
+
+	ranked := profile.Rank([]recommend.Candidate{seed, se7en, interstellar}, saved, 10)
+
+	require.Len(t, ranked, 1)
+	assert.Equal(t, 157336, ranked[0].ID)
+}
+
+func TestRank_Limit(t *testing.T) {
+	profile := recommend.BuildProfile([]recommend.Seed{fightClub, arrival})
+
+	ranked := profile.Rank([]recommend.Candidate{interstellar, se7en}, nil, 1)
+
+	require.Len(t, ranked, 1)
+	assert.Equal(t, 807, ranked[0].ID)
+}
+
+func TestScore(t *testing.T) {
+	tests := map[string]struct {
+		seed   recommend.Seed
+		other  recommend.Candidate
+		better recommend.Candidate
+	}{
+		"posts outweigh saves": {
+			seed:   recommend.Seed{Title: arrival.Title, Name: "Arrival", Source: recommend.SourcePost, Features: arrival.Features},
+			other:  recommend.Candidate{Title: recommend.Title{ID: 1, MediaType: "movie"}, Features: recommend.Features{Genres: []int{crime}}},
+			better: recommend.Candidate{Title: recommend.Title{ID: 2, MediaType: "movie"}, Features: recommend.Features{Genres: []int{scifi}}},
+		},
+		"recommended by a seed": {
+			seed:  fightClub,
+			other: recommend.Candidate{Title: recommend.Title{ID: 1, MediaType: "movie"}, Features: recommend.Features{Genres: []int{drama}}},
+			better: recommend.Candidate{
+				Title:         recommend.Title{ID: 2, MediaType: "movie"},
+				Features:      recommend.Features{Genres: []int{drama}},
+				RecommendedBy: []recommend.Title{fightClub.Title},
+			},
+		},
+		"well rated": {
+			seed:   fightClub,
+			other:  recommend.Candidate{Title: recommend.Title{ID: 1, MediaType: "movie"}, Features: recommend.Features{Genres: []int{drama}}, VoteAverage: 5},
+			better: recommend.Candidate{Title: recommend.Title{ID: 2, MediaType: "movie"}, Features: recommend.Features{Genres: []int{drama}}, VoteAverage: 9},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// A saved crime title to weigh the seed against.
+			saved := tt.seed
+			saved.Title = recommend.Title{ID: 999, MediaType: "movie"}
+			saved.Source = recommend.SourceWatchlist
+			saved.Features = recommend.Features{Genres: []int{crime}}
+			profile := recommend.BuildProfile([]recommend.Seed{tt.seed, saved})
+
+			assert.Greater(t, profile.Score(tt.better).Score, profile.Score(tt.other).Score)
+		})
+	}
+}
+
+func TestScore_GenresOnlyMatchSameMediaType(t *testing.T) {
+	profile := recommend.BuildProfile([]recommend.Seed{fightClub})
+	show := recommend.Candidate{Title: recommend.Title{ID: 1396, MediaType: "tv"}, Features: recommend.Features{Genres: []int{drama}}}
+
+	assert.Zero(t, profile.Score(show).Score)
+}
+
+func TestBuildProfile_MergesRepeatedSeeds(t *testing.T) {
+	posted := fightClub
+	posted.Source = recommend.SourcePost
+
+	profile := recommend.BuildProfile([]recommend.Seed{fightClub, posted, arrival})
+
+	require.Len(t, profile.Seeds, 2)
+	assert.Equal(t, recommend.SourcePost, profile.Seeds[0].Source, "the strongest source wins")
+}
+
+func TestTopGenres(t *testing.T) {
+	show := recommend.Seed{Title: recommend.Title{ID: 1396, MediaType: "tv"}, Features: recommend.Features{Genres: []int{crime}}}
+	profile := recommend.BuildProfile([]recommend.Seed{fightClub, arrival, show})
+
+	assert.Equal(t, []int{drama, scifi}, profile.TopGenres("movie", 2))
+	assert.Equal(t, []int{crime}, profile.TopGenres("tv", 3))
+	assert.Empty(t, profile.TopGenres("movie", 0))
+}
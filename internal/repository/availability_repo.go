@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// AvailabilityRepository defines database operations for tracking where watchlisted titles stream.
+type AvailabilityRepository interface {
+	ListTrackedTitles() ([]models.TrackedTitle, error)
+	ListByTitle(tmdbID int, mediaType string) ([]models.TitleAvailability, error)
+	ListForUser(userID uuid.UUID, region string) ([]models.TitleAvailability, error)
+	Record(title *models.TrackedTitle, added []models.TitleAvailability, removed []models.TitleAvailability, changes []models.AvailabilityChange) error
+	FindWatchers(tmdbID int, mediaType string) ([]models.User, error)
+}
+
+type gormAvailabilityRepository struct {
+	db *gorm.DB
+}
+
+// NewAvailabilityRepository creates a new AvailabilityRepository backed by GORM.
+func NewAvailabilityRepository(db *gorm.DB) AvailabilityRepository {
+	return &gormAvailabilityRepository{db: db}
+}
+
+// ListTrackedTitles returns every title on someone's watchlist, least
+// recently checked first.
+func (r *gormAvailabilityRepository) ListTrackedTitles() ([]models.TrackedTitle, error) {
+	var titles []models.TrackedTitle
+	err := r.db.Table("watchlists AS w").
+		Select("w.tmdb_id, w.media_type, MAX(w.title) AS title, t.checked_at").
+		Joins("LEFT JOIN tracked_titles t ON t.tmdb_id = w.tmdb_id AND t.media_type = w.media_type").
+		Group("w.tmdb_id, w.media_type, t.checked_at").
+		Order("t.checked_at ASC NULLS FIRST").
+		Scan(&titles).Error
+
+	return titles, err
+}
+
+func (r *gormAvailabilityRepository) ListByTitle(tmdbID int, mediaType string) ([]models.TitleAvailability, error) {
+	var rows []models.TitleAvailability
+	err := r.db.Where("tmdb_id = ? AND media_type = ?", tmdbID, mediaType).Find(&rows).Error
+
+	return rows, err
+}
+
+// ListForUser returns the current availability in region of every title on
+// the user's watchlist.
+func (r *gormAvailabilityRepository) ListForUser(userID uuid.UUID, region string) ([]models.TitleAvailability, error) {
+	var rows []models.TitleAvailability
+	err := r.db.Joins("JOIN watchlists ON watchlists.tmdb_id = title_availabilities.tmdb_id AND watchlists.media_type = title_availabilities.media_type").
+		Where("watchlists.user_id = ? AND title_availabilities.region = ?", userID, region).
+		Order("title_availabilities.available_since DESC").
+		Find(&rows).Error
+
+	return rows, err
+}
+
+// Record stores the outcome of checking a title in one transaction.
+func (r *gormAvailabilityRepository) Record(title *models.TrackedTitle, added []models.TitleAvailability, removed []models.TitleAvailability, changes []models.AvailabilityChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tmdb_id"}, {Name: "media_type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"title", "checked_at"}),
+		}).Create(title).Error
+		if err != nil {
+			return err
+		}
+
+		for _, row := range removed {
+			err := tx.Where("tmdb_id = ? AND media_type = ? AND region = ? AND provider_id = ?",
+				row.TMDBId, row.MediaType, row.Region, row.ProviderID).
+				Delete(&models.TitleAvailability{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(added) > 0 {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&added).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(changes) > 0 {
+			return tx.Create(&changes).Error
+		}
+
+		return nil
+	})
+}
+
+// FindWatchers returns the active users with the title on their watchlist,
+// with their streaming services.
+func (r *gormAvailabilityRepository) FindWatchers(tmdbID int, mediaType string) ([]models.User, error) {
+	var users []models.User
+	err := r.db.Preload("StreamingServices").
+		Where("id IN (?)", r.db.Model(&models.Watchlist{}).Select("user_id").
+			Where("tmdb_id = ? AND media_type = ?", tmdbID, mediaType)).
+		Where("banned_at IS NULL AND delete_after IS NULL").
+		Find(&users).Error
+
+	return users, err
+}
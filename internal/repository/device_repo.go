@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// DeviceAuthRepository defines database operations for device authorization grants.
+type DeviceAuthRepository interface {
+	Create(auth *models.DeviceAuthorization) error
+	FindByDeviceCodeHash(hash string) (*models.DeviceAuthorization, error)
+	FindPendingByUserCode(userCode string, now time.Time) (*models.DeviceAuthorization, error)
+	Approve(id uuid.UUID, userID uuid.UUID, isNewUser bool) (bool, error)
+	MarkPolled(id uuid.UUID, at time.Time) error
+	Consume(id uuid.UUID) (bool, error)
+}
+
+type gormDeviceAuthRepository struct {
+	db *gorm.DB
+}
+
+// NewDeviceAuthRepository creates a new DeviceAuthRepository backed by GORM.
+func NewDeviceAuthRepository(db *gorm.DB) DeviceAuthRepository {
+	return &gormDeviceAuthRepository{db: db}
+}
+
+func (r *gormDeviceAuthRepository) Create(auth *models.DeviceAuthorization) error {
+	return r.db.Create(auth).Error
+}
+
+func (r *gormDeviceAuthRepository) FindByDeviceCodeHash(hash string) (*models.DeviceAuthorization, error) {
+	var auth models.DeviceAuthorization
+	err := r.db.Where("device_code_hash = ?", hash).First(&auth).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth, nil
+}
+
+func (r *gormDeviceAuthRepository) FindPendingByUserCode(userCode string, now time.Time) (*models.DeviceAuthorization, error) {
+	var auth models.DeviceAuthorization
+	err := r.db.Where("user_code = ? AND status = ? AND expires_at > ?", userCode, models.DeviceStatusPending, now).
+		First(&auth).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth, nil
+}
+
+func (r *gormDeviceAuthRepository) Approve(id uuid.UUID, userID uuid.UUID, isNewUser bool) (bool, error) {
+	result := r.db.Model(&models.DeviceAuthorization{}).
+		Where("id = ? AND status = ?", id, models.DeviceStatusPending).
+		Updates(map[string]interface{}{"status": models.DeviceStatusApproved, "user_id": userID, "is_new_user": isNewUser})
+
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *gormDeviceAuthRepository) MarkPolled(id uuid.UUID, at time.Time) error {
+	return r.db.Model(&models.DeviceAuthorization{}).Where("id = ?", id).Update("last_polled_at", at).Error
+}
+
+// Consume moves an approved grant to consumed so its device code can be
+// exchanged for tokens exactly once.
+func (r *gormDeviceAuthRepository) Consume(id uuid.UUID) (bool, error) {
+	result := r.db.Model(&models.DeviceAuthorization{}).
+		Where("id = ? AND status = ?", id, models.DeviceStatusApproved).
+		Update("status", models.DeviceStatusConsumed)
+
+	return result.RowsAffected == 1, result.Error
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// DiaryRepository defines database operations for the viewing diary.
+type DiaryRepository interface {
+	Create(entry *models.DiaryEntry, removeFromWatchlist bool) (bool, error)
+	HasWatched(userID uuid.UUID, tmdbID int, mediaType string, on time.Time) (bool, error)
+	ListByUserID(userID uuid.UUID) ([]models.DiaryEntry, error)
+	Find(userID uuid.UUID, id uuid.UUID) (*models.DiaryEntry, error)
+	Update(entry *models.DiaryEntry) error
+	Delete(userID uuid.UUID, id uuid.UUID) (int64, error)
+}
+
+type gormDiaryRepository struct {
+	db                *gorm.DB
+	onWatchlistChange WatchlistHook
+}
+
+// NewDiaryRepository creates a new DiaryRepository backed by GORM.
+// onWatchlistChange is called when logging an entry takes the title off the
+// watchlist.
+func NewDiaryRepository(db *gorm.DB, onWatchlistChange WatchlistHook) DiaryRepository {
+	return &gormDiaryRepository{db: db, onWatchlistChange: onWatchlistChange}
+}
+
+// Create logs the entry and, if asked, takes the title off the user's
+// watchlist in the same transaction. It reports whether a watchlist item was
+// removed.
+func (r *gormDiaryRepository) Create(entry *models.DiaryEntry, removeFromWatchlist bool) (bool, error) {
+	var removed bool
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(entry).Error; err != nil {
+			return err
+		}
+
+		if !removeFromWatchlist {
+			return nil
+		}
+
+		result := tx.Where("user_id = ? AND tmdb_id = ?", entry.UserID, entry.TMDBId).Delete(&models.Watchlist{})
+		removed = result.RowsAffected > 0
+
+		return result.Error
+	})
+
+	if err == nil && removed {
+		r.onWatchlistChange(entry.UserID)
+	}
+
+	return removed, err
+}
+
+// HasWatched reports whether the user logged the title on or before the
+// given date.
+func (r *gormDiaryRepository) HasWatched(userID uuid.UUID, tmdbID int, mediaType string, on time.Time) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.DiaryEntry{}).
+		Where("user_id = ? AND tmdb_id = ? AND media_type = ? AND watched_on <= ?", userID, tmdbID, mediaType, on).
+		Limit(1).
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *gormDiaryRepository) ListByUserID(userID uuid.UUID) ([]models.DiaryEntry, error) {
+	var entries []models.DiaryEntry
+	err := r.db.Where("user_id = ?", userID).
+		Order("watched_on DESC, created_at DESC").
+		Find(&entries).Error
+
+	return entries, err
+}
+
+func (r *gormDiaryRepository) Find(userID uuid.UUID, id uuid.UUID) (*models.DiaryEntry, error) {
+	var entry models.DiaryEntry
+	err := r.db.Where("user_id = ? AND id = ?", userID, id).First(&entry).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// Update saves every field, so clearing the rating or notes sticks.
+func (r *gormDiaryRepository) Update(entry *models.DiaryEntry) error {
+	return r.db.Select("*").Omit("created_at").Updates(entry).Error
+}
+
+func (r *gormDiaryRepository) Delete(userID uuid.UUID, id uuid.UUID) (int64, error) {
+	result := r.db.Where("user_id = ? AND id = ?", userID, id).Delete(&models.DiaryEntry{})
+
+	return result.RowsAffected, result.Error
+}
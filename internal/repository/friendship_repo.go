@@ -10,6 +10,7 @@ import (
 // FriendshipRepository defines database operations for friendships.
 type FriendshipRepository interface {
 	GetAcceptedFriendships(userID uuid.UUID) ([]models.Friendship, error)
+	GetAllByUserID(userID uuid.UUID) ([]models.Friendship, error)
 	Create(friendship *models.Friendship) error
 	AcceptRequest(requestID uuid.UUID, friendID uuid.UUID) (*models.Friendship, error)
 }
@@ -30,6 +31,14 @@ func (r *gormFriendshipRepository) GetAcceptedFriendships(userID uuid.UUID) ([]m
 	return friendships, err
 }
 
+// GetAllByUserID returns every friendship the user is part of, pending ones included.
+func (r *gormFriendshipRepository) GetAllByUserID(userID uuid.UUID) ([]models.Friendship, error) {
+	var friendships []models.Friendship
+	err := r.db.Where("user_id = ? OR friend_id = ?", userID, userID).Order("created_at ASC").Find(&friendships).Error
+
+	return friendships, err
+}
+
 func (r *gormFriendshipRepository) Create(friendship *models.Friendship) error {
 	return r.db.Create(friendship).Error
 }
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// IdentityRepository defines database operations for linked external identities.
+type IdentityRepository interface {
+	FindByProviderSubject(provider string, subject string) (*models.UserIdentity, error)
+	ListByUserID(userID uuid.UUID) ([]models.UserIdentity, error)
+	Create(identity *models.UserIdentity) error
+	CreateUserWithIdentity(user *models.User, identity *models.UserIdentity) error
+	DeleteUnlessLast(userID uuid.UUID, identityID uuid.UUID) (int64, error)
+}
+
+type gormIdentityRepository struct {
+	db *gorm.DB
+}
+
+// NewIdentityRepository creates a new IdentityRepository backed by GORM.
+func NewIdentityRepository(db *gorm.DB) IdentityRepository {
+	return &gormIdentityRepository{db: db}
+}
+
+func (r *gormIdentityRepository) FindByProviderSubject(provider string, subject string) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+	err := r.db.Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity, nil
+}
+
+func (r *gormIdentityRepository) ListByUserID(userID uuid.UUID) ([]models.UserIdentity, error) {
+	var identities []models.UserIdentity
+	err := r.db.Where("user_id = ?", userID).Order("created_at ASC").Find(&identities).Error
+
+	return identities, err
+}
+
+func (r *gormIdentityRepository) Create(identity *models.UserIdentity) error {
+	return r.db.Create(identity).Error
+}
+
+// CreateUserWithIdentity signs up a new user and links their first identity atomically.
+func (r *gormIdentityRepository) CreateUserWithIdentity(user *models.User, identity *models.UserIdentity) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+
+		identity.UserID = user.ID
+
+		return tx.Create(identity).Error
+	})
+}
+
+// DeleteUnlessLast unlinks an identity but never the user's only one, so the
+// account always keeps a way to sign in. The user row is locked so concurrent
+// unlinks cannot both pass the check.
+func (r *gormIdentityRepository) DeleteUnlessLast(userID uuid.UUID, identityID uuid.UUID) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&user, "id = ?", userID).Error; err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&models.UserIdentity{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count < 2 {
+			return nil
+		}
+
+		result := tx.Where("id = ? AND user_id = ?", identityID, userID).Delete(&models.UserIdentity{})
+		deleted = result.RowsAffected
+
+		return result.Error
+	})
+
+	return deleted, err
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// ListRepository defines database operations for custom lists. Edits record
+// a change in the list's history in the same transaction.
+type ListRepository interface {
+	Create(list *models.List) error
+	ListByUserID(userID uuid.UUID) ([]models.List, error)
+	ListWithItems(userID uuid.UUID) ([]models.List, error)
+	Find(id uuid.UUID) (*models.List, error)
+	Update(list *models.List, change *models.ListChange) error
+	Delete(userID uuid.UUID, id uuid.UUID) (int64, error)
+	AddItem(item *models.ListItem, change *models.ListChange) error
+	RemoveItem(listID uuid.UUID, itemID uuid.UUID, change *models.ListChange) (int64, error)
+	Reorder(listID uuid.UUID, version int, itemIDs []uuid.UUID, change *models.ListChange) (bool, error)
+	SetMember(member *models.ListMember, change *models.ListChange) error
+	RemoveMember(listID uuid.UUID, userID uuid.UUID, change *models.ListChange) (int64, error)
+	History(listID uuid.UUID, limit int) ([]models.ListChange, error)
+}
+
+type gormListRepository struct {
+	db *gorm.DB
+}
+
+// NewListRepository creates a new ListRepository backed by GORM.
+func NewListRepository(db *gorm.DB) ListRepository {
+	return &gormListRepository{db: db}
+}
+
+func (r *gormListRepository) Create(list *models.List) error {
+	return r.db.Omit(clause.Associations).Create(list).Error
+}
+
+// ListByUserID returns the lists the user owns or was invited to, with their
+// item counts but without their items, newest first.
+func (r *gormListRepository) ListByUserID(userID uuid.UUID) ([]models.List, error) {
+	var lists []models.List
+	err := r.db.
+		Select("lists.*, (SELECT COUNT(*) FROM list_items WHERE list_items.list_id = lists.id) AS item_count").
+		Where("user_id = ? OR id IN (?)", userID, r.db.Model(&models.ListMember{}).Select("list_id").Where("user_id = ?", userID)).
+		Order("created_at DESC").
+		Find(&lists).Error
+
+	return lists, err
+}
+
+// ListWithItems returns the lists the user owns with all their items.
+func (r *gormListRepository) ListWithItems(userID uuid.UUID) ([]models.List, error) {
+	var lists []models.List
+	err := r.db.
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("position ASC") }).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&lists).Error
+
+	return lists, err
+}
+
+// Find returns the list with its items in order and its members.
+func (r *gormListRepository) Find(id uuid.UUID) (*models.List, error) {
+	var list models.List
+	err := r.db.
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("position ASC") }).
+		Preload("Members", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		First(&list, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+func (r *gormListRepository) Update(list *models.List, change *models.ListChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(list).Select("title", "description", "visibility", "updated_at").Updates(list).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(change).Error
+	})
+}
+
+// Delete removes the list; its items, members and history go with it.
+func (r *gormListRepository) Delete(userID uuid.UUID, id uuid.UUID) (int64, error) {
+	result := r.db.Where("user_id = ? AND id = ?", userID, id).Delete(&models.List{})
+
+	return result.RowsAffected, result.Error
+}
+
+// AddItem appends the item to the end of its list. The version is bumped
+// first: updating the list row locks it, so concurrent adds to the same list
+// read the last position one after another.
+func (r *gormListRepository) AddItem(item *models.ListItem, change *models.ListChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, item.ListID, change); err != nil {
+			return err
+		}
+
+		var last int
+		err := tx.Model(&models.ListItem{}).
+			Where("list_id = ?", item.ListID).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&last).Error
+		if err != nil {
+			return err
+		}
+
+		item.Position = last + 1
+
+		return tx.Create(item).Error
+	})
+}
+
+func (r *gormListRepository) RemoveItem(listID uuid.UUID, itemID uuid.UUID, change *models.ListChange) (int64, error) {
+	var removed int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("list_id = ? AND id = ?", listID, itemID).Delete(&models.ListItem{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		removed = result.RowsAffected
+
+		return bumpVersion(tx, listID, change)
+	})
+
+	return removed, err
+}
+
+// Reorder numbers the list's items in the order of itemIDs, starting at 1,
+// if the list is still at version. It reports false without changing
+// anything when someone else changed the items first.
+func (r *gormListRepository) Reorder(listID uuid.UUID, version int, itemIDs []uuid.UUID, change *models.ListChange) (bool, error) {
+	var reordered bool
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.List{}).
+			Where("id = ? AND version = ?", listID, version).
+			Updates(map[string]any{"version": gorm.Expr("version + 1"), "updated_at": time.Now()})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		for i, itemID := range itemIDs {
+			err := tx.Model(&models.ListItem{}).
+				Where("list_id = ? AND id = ?", listID, itemID).
+				Update("position", i+1).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		reordered = true
+
+		return tx.Create(change).Error
+	})
+
+	return reordered, err
+}
+
+// SetMember adds the member to the list or changes their role.
+func (r *gormListRepository) SetMember(member *models.ListMember, change *models.ListChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "list_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role"}),
+		}).Create(member).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(change).Error
+	})
+}
+
+func (r *gormListRepository) RemoveMember(listID uuid.UUID, userID uuid.UUID, change *models.ListChange) (int64, error) {
+	var removed int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("list_id = ? AND user_id = ?", listID, userID).Delete(&models.ListMember{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		removed = result.RowsAffected
+
+		return tx.Create(change).Error
+	})
+
+	return removed, err
+}
+
+// History returns the list's most recent changes first.
+func (r *gormListRepository) History(listID uuid.UUID, limit int) ([]models.ListChange, error) {
+	var changes []models.ListChange
+	err := r.db.Where("list_id = ?", listID).Order("created_at DESC").Limit(limit).Find(&changes).Error
+
+	return changes, err
+}
+
+// bumpVersion marks the list's items as changed and records the change.
+func bumpVersion(tx *gorm.DB, listID uuid.UUID, change *models.ListChange) error {
+	err := tx.Model(&models.List{}).
+		Where("id = ?", listID).
+		Updates(map[string]any{"version": gorm.Expr("version + 1"), "updated_at": time.Now()}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(change).Error
+}
@@ -0,0 +1,320 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockAvailabilityRepository is an autogenerated mock type for the AvailabilityRepository type
+type MockAvailabilityRepository struct {
+	mock.Mock
+}
+
+type MockAvailabilityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAvailabilityRepository) EXPECT() *MockAvailabilityRepository_Expecter {
+	return &MockAvailabilityRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindWatchers provides a mock function with given fields: tmdbID, mediaType
+func (_m *MockAvailabilityRepository) FindWatchers(tmdbID int, mediaType string) ([]models.User, error) {
+	ret := _m.Called(tmdbID, mediaType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWatchers")
+	}
+
+	var r0 []models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]models.User, error)); ok {
+		return rf(tmdbID, mediaType)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []models.User); ok {
+		r0 = rf(tmdbID, mediaType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(tmdbID, mediaType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAvailabilityRepository_FindWatchers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWatchers'
+type MockAvailabilityRepository_FindWatchers_Call struct {
+	*mock.Call
+}
+
+// FindWatchers is a helper method to define mock.On call
+//   - tmdbID int
+//   - mediaType string
+func (_e *MockAvailabilityRepository_Expecter) FindWatchers(tmdbID interface{}, mediaType interface{}) *MockAvailabilityRepository_FindWatchers_Call {
+	return &MockAvailabilityRepository_FindWatchers_Call{Call: _e.mock.On("FindWatchers", tmdbID, mediaType)}
+}
+
+func (_c *MockAvailabilityRepository_FindWatchers_Call) Run(run func(tmdbID int, mediaType string)) *MockAvailabilityRepository_FindWatchers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_FindWatchers_Call) Return(_a0 []models.User, _a1 error) *MockAvailabilityRepository_FindWatchers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_FindWatchers_Call) RunAndReturn(run func(int, string) ([]models.User, error)) *MockAvailabilityRepository_FindWatchers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByTitle provides a mock function with given fields: tmdbID, mediaType
+func (_m *MockAvailabilityRepository) ListByTitle(tmdbID int, mediaType string) ([]models.TitleAvailability, error) {
+	ret := _m.Called(tmdbID, mediaType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByTitle")
+	}
+
+	var r0 []models.TitleAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]models.TitleAvailability, error)); ok {
+		return rf(tmdbID, mediaType)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []models.TitleAvailability); ok {
+		r0 = rf(tmdbID, mediaType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TitleAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(tmdbID, mediaType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAvailabilityRepository_ListByTitle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByTitle'
+type MockAvailabilityRepository_ListByTitle_Call struct {
+	*mock.Call
+}
+
+// ListByTitle is a helper method to define mock.On call
+//   - tmdbID int
+//   - mediaType string
+func (_e *MockAvailabilityRepository_Expecter) ListByTitle(tmdbID interface{}, mediaType interface{}) *MockAvailabilityRepository_ListByTitle_Call {
+	return &MockAvailabilityRepository_ListByTitle_Call{Call: _e.mock.On("ListByTitle", tmdbID, mediaType)}
+}
+
+func (_c *MockAvailabilityRepository_ListByTitle_Call) Run(run func(tmdbID int, mediaType string)) *MockAvailabilityRepository_ListByTitle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_ListByTitle_Call) Return(_a0 []models.TitleAvailability, _a1 error) *MockAvailabilityRepository_ListByTitle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_ListByTitle_Call) RunAndReturn(run func(int, string) ([]models.TitleAvailability, error)) *MockAvailabilityRepository_ListByTitle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListForUser provides a mock function with given fields: userID, region
+func (_m *MockAvailabilityRepository) ListForUser(userID uuid.UUID, region string) ([]models.TitleAvailability, error) {
+	ret := _m.Called(userID, region)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListForUser")
+	}
+
+	var r0 []models.TitleAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) ([]models.TitleAvailability, error)); ok {
+		return rf(userID, region)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) []models.TitleAvailability); ok {
+		r0 = rf(userID, region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TitleAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(userID, region)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAvailabilityRepository_ListForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListForUser'
+type MockAvailabilityRepository_ListForUser_Call struct {
+	*mock.Call
+}
+
+// ListForUser is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - region string
+func (_e *MockAvailabilityRepository_Expecter) ListForUser(userID interface{}, region interface{}) *MockAvailabilityRepository_ListForUser_Call {
+	return &MockAvailabilityRepository_ListForUser_Call{Call: _e.mock.On("ListForUser", userID, region)}
+}
+
+func (_c *MockAvailabilityRepository_ListForUser_Call) Run(run func(userID uuid.UUID, region string)) *MockAvailabilityRepository_ListForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_ListForUser_Call) Return(_a0 []models.TitleAvailability, _a1 error) *MockAvailabilityRepository_ListForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_ListForUser_Call) RunAndReturn(run func(uuid.UUID, string) ([]models.TitleAvailability, error)) *MockAvailabilityRepository_ListForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrackedTitles provides a mock function with no fields
+func (_m *MockAvailabilityRepository) ListTrackedTitles() ([]models.TrackedTitle, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrackedTitles")
+	}
+
+	var r0 []models.TrackedTitle
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.TrackedTitle, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.TrackedTitle); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TrackedTitle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAvailabilityRepository_ListTrackedTitles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrackedTitles'
+type MockAvailabilityRepository_ListTrackedTitles_Call struct {
+	*mock.Call
+}
+
+// ListTrackedTitles is a helper method to define mock.On call
+func (_e *MockAvailabilityRepository_Expecter) ListTrackedTitles() *MockAvailabilityRepository_ListTrackedTitles_Call {
+	return &MockAvailabilityRepository_ListTrackedTitles_Call{Call: _e.mock.On("ListTrackedTitles")}
+}
+
+func (_c *MockAvailabilityRepository_ListTrackedTitles_Call) Run(run func()) *MockAvailabilityRepository_ListTrackedTitles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_ListTrackedTitles_Call) Return(_a0 []models.TrackedTitle, _a1 error) *MockAvailabilityRepository_ListTrackedTitles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_ListTrackedTitles_Call) RunAndReturn(run func() ([]models.TrackedTitle, error)) *MockAvailabilityRepository_ListTrackedTitles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: title, added, removed, changes
+func (_m *MockAvailabilityRepository) Record(title *models.TrackedTitle, added []models.TitleAvailability, removed []models.TitleAvailability, changes []models.AvailabilityChange) error {
+	ret := _m.Called(title, added, removed, changes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.TrackedTitle, []models.TitleAvailability, []models.TitleAvailability, []models.AvailabilityChange) error); ok {
+		r0 = rf(title, added, removed, changes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAvailabilityRepository_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockAvailabilityRepository_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - title *models.TrackedTitle
+//   - added []models.TitleAvailability
+//   - removed []models.TitleAvailability
+//   - changes []models.AvailabilityChange
+func (_e *MockAvailabilityRepository_Expecter) Record(title interface{}, added interface{}, removed interface{}, changes interface{}) *MockAvailabilityRepository_Record_Call {
+	return &MockAvailabilityRepository_Record_Call{Call: _e.mock.On("Record", title, added, removed, changes)}
+}
+
+func (_c *MockAvailabilityRepository_Record_Call) Run(run func(title *models.TrackedTitle, added []models.TitleAvailability, removed []models.TitleAvailability, changes []models.AvailabilityChange)) *MockAvailabilityRepository_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.TrackedTitle), args[1].([]models.TitleAvailability), args[2].([]models.TitleAvailability), args[3].([]models.AvailabilityChange))
+	})
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_Record_Call) Return(_a0 error) *MockAvailabilityRepository_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAvailabilityRepository_Record_Call) RunAndReturn(run func(*models.TrackedTitle, []models.TitleAvailability, []models.TitleAvailability, []models.AvailabilityChange) error) *MockAvailabilityRepository_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAvailabilityRepository creates a new instance of MockAvailabilityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAvailabilityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAvailabilityRepository {
+	mock := &MockAvailabilityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
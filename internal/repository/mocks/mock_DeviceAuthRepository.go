@@ -0,0 +1,363 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDeviceAuthRepository is an autogenerated mock type for the DeviceAuthRepository type
+type MockDeviceAuthRepository struct {
+	mock.Mock
+}
+
+type MockDeviceAuthRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeviceAuthRepository) EXPECT() *MockDeviceAuthRepository_Expecter {
+	return &MockDeviceAuthRepository_Expecter{mock: &_m.Mock}
+}
+
+// Approve provides a mock function with given fields: id, userID, isNewUser
+func (_m *MockDeviceAuthRepository) Approve(id uuid.UUID, userID uuid.UUID, isNewUser bool) (bool, error) {
+	ret := _m.Called(id, userID, isNewUser)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Approve")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, bool) (bool, error)); ok {
+		return rf(id, userID, isNewUser)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, bool) bool); ok {
+		r0 = rf(id, userID, isNewUser)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, bool) error); ok {
+		r1 = rf(id, userID, isNewUser)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeviceAuthRepository_Approve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Approve'
+type MockDeviceAuthRepository_Approve_Call struct {
+	*mock.Call
+}
+
+// Approve is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - userID uuid.UUID
+//   - isNewUser bool
+func (_e *MockDeviceAuthRepository_Expecter) Approve(id interface{}, userID interface{}, isNewUser interface{}) *MockDeviceAuthRepository_Approve_Call {
+	return &MockDeviceAuthRepository_Approve_Call{Call: _e.mock.On("Approve", id, userID, isNewUser)}
+}
+
+func (_c *MockDeviceAuthRepository_Approve_Call) Run(run func(id uuid.UUID, userID uuid.UUID, isNewUser bool)) *MockDeviceAuthRepository_Approve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_Approve_Call) Return(_a0 bool, _a1 error) *MockDeviceAuthRepository_Approve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_Approve_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, bool) (bool, error)) *MockDeviceAuthRepository_Approve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Consume provides a mock function with given fields: id
+func (_m *MockDeviceAuthRepository) Consume(id uuid.UUID) (bool, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consume")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (bool, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeviceAuthRepository_Consume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consume'
+type MockDeviceAuthRepository_Consume_Call struct {
+	*mock.Call
+}
+
+// Consume is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *MockDeviceAuthRepository_Expecter) Consume(id interface{}) *MockDeviceAuthRepository_Consume_Call {
+	return &MockDeviceAuthRepository_Consume_Call{Call: _e.mock.On("Consume", id)}
+}
+
+func (_c *MockDeviceAuthRepository_Consume_Call) Run(run func(id uuid.UUID)) *MockDeviceAuthRepository_Consume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_Consume_Call) Return(_a0 bool, _a1 error) *MockDeviceAuthRepository_Consume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_Consume_Call) RunAndReturn(run func(uuid.UUID) (bool, error)) *MockDeviceAuthRepository_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: auth
+func (_m *MockDeviceAuthRepository) Create(auth *models.DeviceAuthorization) error {
+	ret := _m.Called(auth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.DeviceAuthorization) error); ok {
+		r0 = rf(auth)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeviceAuthRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockDeviceAuthRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - auth *models.DeviceAuthorization
+func (_e *MockDeviceAuthRepository_Expecter) Create(auth interface{}) *MockDeviceAuthRepository_Create_Call {
+	return &MockDeviceAuthRepository_Create_Call{Call: _e.mock.On("Create", auth)}
+}
+
+func (_c *MockDeviceAuthRepository_Create_Call) Run(run func(auth *models.DeviceAuthorization)) *MockDeviceAuthRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.DeviceAuthorization))
+	})
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_Create_Call) Return(_a0 error) *MockDeviceAuthRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_Create_Call) RunAndReturn(run func(*models.DeviceAuthorization) error) *MockDeviceAuthRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByDeviceCodeHash provides a mock function with given fields: hash
+func (_m *MockDeviceAuthRepository) FindByDeviceCodeHash(hash string) (*models.DeviceAuthorization, error) {
+	ret := _m.Called(hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByDeviceCodeHash")
+	}
+
+	var r0 *models.DeviceAuthorization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.DeviceAuthorization, error)); ok {
+		return rf(hash)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.DeviceAuthorization); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DeviceAuthorization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeviceAuthRepository_FindByDeviceCodeHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByDeviceCodeHash'
+type MockDeviceAuthRepository_FindByDeviceCodeHash_Call struct {
+	*mock.Call
+}
+
+// FindByDeviceCodeHash is a helper method to define mock.On call
+//   - hash string
+func (_e *MockDeviceAuthRepository_Expecter) FindByDeviceCodeHash(hash interface{}) *MockDeviceAuthRepository_FindByDeviceCodeHash_Call {
+	return &MockDeviceAuthRepository_FindByDeviceCodeHash_Call{Call: _e.mock.On("FindByDeviceCodeHash", hash)}
+}
+
+func (_c *MockDeviceAuthRepository_FindByDeviceCodeHash_Call) Run(run func(hash string)) *MockDeviceAuthRepository_FindByDeviceCodeHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_FindByDeviceCodeHash_Call) Return(_a0 *models.DeviceAuthorization, _a1 error) *MockDeviceAuthRepository_FindByDeviceCodeHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_FindByDeviceCodeHash_Call) RunAndReturn(run func(string) (*models.DeviceAuthorization, error)) *MockDeviceAuthRepository_FindByDeviceCodeHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPendingByUserCode provides a mock function with given fields: userCode, now
+func (_m *MockDeviceAuthRepository) FindPendingByUserCode(userCode string, now time.Time) (*models.DeviceAuthorization, error) {
+	ret := _m.Called(userCode, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPendingByUserCode")
+	}
+
+	var r0 *models.DeviceAuthorization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*models.DeviceAuthorization, error)); ok {
+		return rf(userCode, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *models.DeviceAuthorization); ok {
+		r0 = rf(userCode, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DeviceAuthorization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userCode, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeviceAuthRepository_FindPendingByUserCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPendingByUserCode'
+type MockDeviceAuthRepository_FindPendingByUserCode_Call struct {
+	*mock.Call
+}
+
+// FindPendingByUserCode is a helper method to define mock.On call
+//   - userCode string
+//   - now time.Time
+func (_e *MockDeviceAuthRepository_Expecter) FindPendingByUserCode(userCode interface{}, now interface{}) *MockDeviceAuthRepository_FindPendingByUserCode_Call {
+	return &MockDeviceAuthRepository_FindPendingByUserCode_Call{Call: _e.mock.On("FindPendingByUserCode", userCode, now)}
+}
+
+func (_c *MockDeviceAuthRepository_FindPendingByUserCode_Call) Run(run func(userCode string, now time.Time)) *MockDeviceAuthRepository_FindPendingByUserCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_FindPendingByUserCode_Call) Return(_a0 *models.DeviceAuthorization, _a1 error) *MockDeviceAuthRepository_FindPendingByUserCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_FindPendingByUserCode_Call) RunAndReturn(run func(string, time.Time) (*models.DeviceAuthorization, error)) *MockDeviceAuthRepository_FindPendingByUserCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPolled provides a mock function with given fields: id, at
+func (_m *MockDeviceAuthRepository) MarkPolled(id uuid.UUID, at time.Time) error {
+	ret := _m.Called(id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPolled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = rf(id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeviceAuthRepository_MarkPolled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPolled'
+type MockDeviceAuthRepository_MarkPolled_Call struct {
+	*mock.Call
+}
+
+// MarkPolled is a helper method to define mock.On call
+//   - id uuid.UUID
+//   - at time.Time
+func (_e *MockDeviceAuthRepository_Expecter) MarkPolled(id interface{}, at interface{}) *MockDeviceAuthRepository_MarkPolled_Call {
+	return &MockDeviceAuthRepository_MarkPolled_Call{Call: _e.mock.On("MarkPolled", id, at)}
+}
+
+func (_c *MockDeviceAuthRepository_MarkPolled_Call) Run(run func(id uuid.UUID, at time.Time)) *MockDeviceAuthRepository_MarkPolled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_MarkPolled_Call) Return(_a0 error) *MockDeviceAuthRepository_MarkPolled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeviceAuthRepository_MarkPolled_Call) RunAndReturn(run func(uuid.UUID, time.Time) error) *MockDeviceAuthRepository_MarkPolled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeviceAuthRepository creates a new instance of MockDeviceAuthRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeviceAuthRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeviceAuthRepository {
+	mock := &MockDeviceAuthRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
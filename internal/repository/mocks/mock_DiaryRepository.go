@@ -0,0 +1,375 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDiaryRepository is an autogenerated mock type for the DiaryRepository type
+type MockDiaryRepository struct {
+	mock.Mock
+}
+
+type MockDiaryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDiaryRepository) EXPECT() *MockDiaryRepository_Expecter {
+	return &MockDiaryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: entry, removeFromWatchlist
+func (_m *MockDiaryRepository) Create(entry *models.DiaryEntry, removeFromWatchlist bool) (bool, error) {
+	ret := _m.Called(entry, removeFromWatchlist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.DiaryEntry, bool) (bool, error)); ok {
+		return rf(entry, removeFromWatchlist)
+	}
+	if rf, ok := ret.Get(0).(func(*models.DiaryEntry, bool) bool); ok {
+		r0 = rf(entry, removeFromWatchlist)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.DiaryEntry, bool) error); ok {
+		r1 = rf(entry, removeFromWatchlist)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDiaryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockDiaryRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - entry *models.DiaryEntry
+//   - removeFromWatchlist bool
+func (_e *MockDiaryRepository_Expecter) Create(entry interface{}, removeFromWatchlist interface{}) *MockDiaryRepository_Create_Call {
+	return &MockDiaryRepository_Create_Call{Call: _e.mock.On("Create", entry, removeFromWatchlist)}
+}
+
+func (_c *MockDiaryRepository_Create_Call) Run(run func(entry *models.DiaryEntry, removeFromWatchlist bool)) *MockDiaryRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.DiaryEntry), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockDiaryRepository_Create_Call) Return(_a0 bool, _a1 error) *MockDiaryRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDiaryRepository_Create_Call) RunAndReturn(run func(*models.DiaryEntry, bool) (bool, error)) *MockDiaryRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: userID, id
+func (_m *MockDiaryRepository) Delete(userID uuid.UUID, id uuid.UUID) (int64, error) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) (int64, error)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) int64); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDiaryRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockDiaryRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockDiaryRepository_Expecter) Delete(userID interface{}, id interface{}) *MockDiaryRepository_Delete_Call {
+	return &MockDiaryRepository_Delete_Call{Call: _e.mock.On("Delete", userID, id)}
+}
+
+func (_c *MockDiaryRepository_Delete_Call) Run(run func(userID uuid.UUID, id uuid.UUID)) *MockDiaryRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDiaryRepository_Delete_Call) Return(_a0 int64, _a1 error) *MockDiaryRepository_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDiaryRepository_Delete_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) (int64, error)) *MockDiaryRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: userID, id
+func (_m *MockDiaryRepository) Find(userID uuid.UUID, id uuid.UUID) (*models.DiaryEntry, error) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *models.DiaryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) (*models.DiaryEntry, error)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) *models.DiaryEntry); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DiaryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDiaryRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockDiaryRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockDiaryRepository_Expecter) Find(userID interface{}, id interface{}) *MockDiaryRepository_Find_Call {
+	return &MockDiaryRepository_Find_Call{Call: _e.mock.On("Find", userID, id)}
+}
+
+func (_c *MockDiaryRepository_Find_Call) Run(run func(userID uuid.UUID, id uuid.UUID)) *MockDiaryRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDiaryRepository_Find_Call) Return(_a0 *models.DiaryEntry, _a1 error) *MockDiaryRepository_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDiaryRepository_Find_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) (*models.DiaryEntry, error)) *MockDiaryRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasWatched provides a mock function with given fields: userID, tmdbID, mediaType, on
+func (_m *MockDiaryRepository) HasWatched(userID uuid.UUID, tmdbID int, mediaType string, on time.Time) (bool, error) {
+	ret := _m.Called(userID, tmdbID, mediaType, on)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasWatched")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, string, time.Time) (bool, error)); ok {
+		return rf(userID, tmdbID, mediaType, on)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, string, time.Time) bool); ok {
+		r0 = rf(userID, tmdbID, mediaType, on)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int, string, time.Time) error); ok {
+		r1 = rf(userID, tmdbID, mediaType, on)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDiaryRepository_HasWatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasWatched'
+type MockDiaryRepository_HasWatched_Call struct {
+	*mock.Call
+}
+
+// HasWatched is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - tmdbID int
+//   - mediaType string
+//   - on time.Time
+func (_e *MockDiaryRepository_Expecter) HasWatched(userID interface{}, tmdbID interface{}, mediaType interface{}, on interface{}) *MockDiaryRepository_HasWatched_Call {
+	return &MockDiaryRepository_HasWatched_Call{Call: _e.mock.On("HasWatched", userID, tmdbID, mediaType, on)}
+}
+
+func (_c *MockDiaryRepository_HasWatched_Call) Run(run func(userID uuid.UUID, tmdbID int, mediaType string, on time.Time)) *MockDiaryRepository_HasWatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDiaryRepository_HasWatched_Call) Return(_a0 bool, _a1 error) *MockDiaryRepository_HasWatched_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDiaryRepository_HasWatched_Call) RunAndReturn(run func(uuid.UUID, int, string, time.Time) (bool, error)) *MockDiaryRepository_HasWatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: userID
+func (_m *MockDiaryRepository) ListByUserID(userID uuid.UUID) ([]models.DiaryEntry, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []models.DiaryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.DiaryEntry, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.DiaryEntry); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DiaryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDiaryRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockDiaryRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockDiaryRepository_Expecter) ListByUserID(userID interface{}) *MockDiaryRepository_ListByUserID_Call {
+	return &MockDiaryRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", userID)}
+}
+
+func (_c *MockDiaryRepository_ListByUserID_Call) Run(run func(userID uuid.UUID)) *MockDiaryRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDiaryRepository_ListByUserID_Call) Return(_a0 []models.DiaryEntry, _a1 error) *MockDiaryRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDiaryRepository_ListByUserID_Call) RunAndReturn(run func(uuid.UUID) ([]models.DiaryEntry, error)) *MockDiaryRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: entry
+func (_m *MockDiaryRepository) Update(entry *models.DiaryEntry) error {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.DiaryEntry) error); ok {
+		r0 = rf(entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDiaryRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockDiaryRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - entry *models.DiaryEntry
+func (_e *MockDiaryRepository_Expecter) Update(entry interface{}) *MockDiaryRepository_Update_Call {
+	return &MockDiaryRepository_Update_Call{Call: _e.mock.On("Update", entry)}
+}
+
+func (_c *MockDiaryRepository_Update_Call) Run(run func(entry *models.DiaryEntry)) *MockDiaryRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.DiaryEntry))
+	})
+	return _c
+}
+
+func (_c *MockDiaryRepository_Update_Call) Return(_a0 error) *MockDiaryRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDiaryRepository_Update_Call) RunAndReturn(run func(*models.DiaryEntry) error) *MockDiaryRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDiaryRepository creates a new instance of MockDiaryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDiaryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDiaryRepository {
+	mock := &MockDiaryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
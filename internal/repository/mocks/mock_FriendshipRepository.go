@@ -185,6 +185,64 @@ func (_c *MockFriendshipRepository_GetAcceptedFriendships_Call) RunAndReturn(run
 	return _c
 }
 
+// GetAllByUserID provides a mock function with given fields: userID
+func (_m *MockFriendshipRepository) GetAllByUserID(userID uuid.UUID) ([]models.Friendship, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllByUserID")
+	}
+
+	var r0 []models.Friendship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.Friendship, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.Friendship); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Friendship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFriendshipRepository_GetAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllByUserID'
+type MockFriendshipRepository_GetAllByUserID_Call struct {
+	*mock.Call
+}
+
+// GetAllByUserID is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockFriendshipRepository_Expecter) GetAllByUserID(userID interface{}) *MockFriendshipRepository_GetAllByUserID_Call {
+	return &MockFriendshipRepository_GetAllByUserID_Call{Call: _e.mock.On("GetAllByUserID", userID)}
+}
+
+func (_c *MockFriendshipRepository_GetAllByUserID_Call) Run(run func(userID uuid.UUID)) *MockFriendshipRepository_GetAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_GetAllByUserID_Call) Return(_a0 []models.Friendship, _a1 error) *MockFriendshipRepository_GetAllByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_GetAllByUserID_Call) RunAndReturn(run func(uuid.UUID) ([]models.Friendship, error)) *MockFriendshipRepository_GetAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockFriendshipRepository creates a new instance of MockFriendshipRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockFriendshipRepository(t interface {
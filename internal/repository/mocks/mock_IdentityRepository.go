@@ -0,0 +1,304 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockIdentityRepository is an autogenerated mock type for the IdentityRepository type
+type MockIdentityRepository struct {
+	mock.Mock
+}
+
+type MockIdentityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdentityRepository) EXPECT() *MockIdentityRepository_Expecter {
+	return &MockIdentityRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: identity
+func (_m *MockIdentityRepository) Create(identity *models.UserIdentity) error {
+	ret := _m.Called(identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.UserIdentity) error); ok {
+		r0 = rf(identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIdentityRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIdentityRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - identity *models.UserIdentity
+func (_e *MockIdentityRepository_Expecter) Create(identity interface{}) *MockIdentityRepository_Create_Call {
+	return &MockIdentityRepository_Create_Call{Call: _e.mock.On("Create", identity)}
+}
+
+func (_c *MockIdentityRepository_Create_Call) Run(run func(identity *models.UserIdentity)) *MockIdentityRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.UserIdentity))
+	})
+	return _c
+}
+
+func (_c *MockIdentityRepository_Create_Call) Return(_a0 error) *MockIdentityRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIdentityRepository_Create_Call) RunAndReturn(run func(*models.UserIdentity) error) *MockIdentityRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUserWithIdentity provides a mock function with given fields: user, identity
+func (_m *MockIdentityRepository) CreateUserWithIdentity(user *models.User, identity *models.UserIdentity) error {
+	ret := _m.Called(user, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserWithIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.User, *models.UserIdentity) error); ok {
+		r0 = rf(user, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIdentityRepository_CreateUserWithIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserWithIdentity'
+type MockIdentityRepository_CreateUserWithIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateUserWithIdentity is a helper method to define mock.On call
+//   - user *models.User
+//   - identity *models.UserIdentity
+func (_e *MockIdentityRepository_Expecter) CreateUserWithIdentity(user interface{}, identity interface{}) *MockIdentityRepository_CreateUserWithIdentity_Call {
+	return &MockIdentityRepository_CreateUserWithIdentity_Call{Call: _e.mock.On("CreateUserWithIdentity", user, identity)}
+}
+
+func (_c *MockIdentityRepository_CreateUserWithIdentity_Call) Run(run func(user *models.User, identity *models.UserIdentity)) *MockIdentityRepository_CreateUserWithIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.User), args[1].(*models.UserIdentity))
+	})
+	return _c
+}
+
+func (_c *MockIdentityRepository_CreateUserWithIdentity_Call) Return(_a0 error) *MockIdentityRepository_CreateUserWithIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIdentityRepository_CreateUserWithIdentity_Call) RunAndReturn(run func(*models.User, *models.UserIdentity) error) *MockIdentityRepository_CreateUserWithIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUnlessLast provides a mock function with given fields: userID, identityID
+func (_m *MockIdentityRepository) DeleteUnlessLast(userID uuid.UUID, identityID uuid.UUID) (int64, error) {
+	ret := _m.Called(userID, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUnlessLast")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) (int64, error)); ok {
+		return rf(userID, identityID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) int64); ok {
+		r0 = rf(userID, identityID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(userID, identityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIdentityRepository_DeleteUnlessLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUnlessLast'
+type MockIdentityRepository_DeleteUnlessLast_Call struct {
+	*mock.Call
+}
+
+// DeleteUnlessLast is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - identityID uuid.UUID
+func (_e *MockIdentityRepository_Expecter) DeleteUnlessLast(userID interface{}, identityID interface{}) *MockIdentityRepository_DeleteUnlessLast_Call {
+	return &MockIdentityRepository_DeleteUnlessLast_Call{Call: _e.mock.On("DeleteUnlessLast", userID, identityID)}
+}
+
+func (_c *MockIdentityRepository_DeleteUnlessLast_Call) Run(run func(userID uuid.UUID, identityID uuid.UUID)) *MockIdentityRepository_DeleteUnlessLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIdentityRepository_DeleteUnlessLast_Call) Return(_a0 int64, _a1 error) *MockIdentityRepository_DeleteUnlessLast_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIdentityRepository_DeleteUnlessLast_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) (int64, error)) *MockIdentityRepository_DeleteUnlessLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByProviderSubject provides a mock function with given fields: provider, subject
+func (_m *MockIdentityRepository) FindByProviderSubject(provider string, subject string) (*models.UserIdentity, error) {
+	ret := _m.Called(provider, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProviderSubject")
+	}
+
+	var r0 *models.UserIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.UserIdentity, error)); ok {
+		return rf(provider, subject)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.UserIdentity); ok {
+		r0 = rf(provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIdentityRepository_FindByProviderSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProviderSubject'
+type MockIdentityRepository_FindByProviderSubject_Call struct {
+	*mock.Call
+}
+
+// FindByProviderSubject is a helper method to define mock.On call
+//   - provider string
+//   - subject string
+func (_e *MockIdentityRepository_Expecter) FindByProviderSubject(provider interface{}, subject interface{}) *MockIdentityRepository_FindByProviderSubject_Call {
+	return &MockIdentityRepository_FindByProviderSubject_Call{Call: _e.mock.On("FindByProviderSubject", provider, subject)}
+}
+
+func (_c *MockIdentityRepository_FindByProviderSubject_Call) Run(run func(provider string, subject string)) *MockIdentityRepository_FindByProviderSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIdentityRepository_FindByProviderSubject_Call) Return(_a0 *models.UserIdentity, _a1 error) *MockIdentityRepository_FindByProviderSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIdentityRepository_FindByProviderSubject_Call) RunAndReturn(run func(string, string) (*models.UserIdentity, error)) *MockIdentityRepository_FindByProviderSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: userID
+func (_m *MockIdentityRepository) ListByUserID(userID uuid.UUID) ([]models.UserIdentity, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []models.UserIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.UserIdentity, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.UserIdentity); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.UserIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIdentityRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockIdentityRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockIdentityRepository_Expecter) ListByUserID(userID interface{}) *MockIdentityRepository_ListByUserID_Call {
+	return &MockIdentityRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", userID)}
+}
+
+func (_c *MockIdentityRepository_ListByUserID_Call) Run(run func(userID uuid.UUID)) *MockIdentityRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockIdentityRepository_ListByUserID_Call) Return(_a0 []models.UserIdentity, _a1 error) *MockIdentityRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIdentityRepository_ListByUserID_Call) RunAndReturn(run func(uuid.UUID) ([]models.UserIdentity, error)) *MockIdentityRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdentityRepository creates a new instance of MockIdentityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdentityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdentityRepository {
+	mock := &MockIdentityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
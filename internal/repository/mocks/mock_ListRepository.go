@@ -0,0 +1,689 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockListRepository is an autogenerated mock type for the ListRepository type
+type MockListRepository struct {
+	mock.Mock
+}
+
+type MockListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListRepository) EXPECT() *MockListRepository_Expecter {
+	return &MockListRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddItem provides a mock function with given fields: item, change
+func (_m *MockListRepository) AddItem(item *models.ListItem, change *models.ListChange) error {
+	ret := _m.Called(item, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ListItem, *models.ListChange) error); ok {
+		r0 = rf(item, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListRepository_AddItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddItem'
+type MockListRepository_AddItem_Call struct {
+	*mock.Call
+}
+
+// AddItem is a helper method to define mock.On call
+//   - item *models.ListItem
+//   - change *models.ListChange
+func (_e *MockListRepository_Expecter) AddItem(item interface{}, change interface{}) *MockListRepository_AddItem_Call {
+	return &MockListRepository_AddItem_Call{Call: _e.mock.On("AddItem", item, change)}
+}
+
+func (_c *MockListRepository_AddItem_Call) Run(run func(item *models.ListItem, change *models.ListChange)) *MockListRepository_AddItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ListItem), args[1].(*models.ListChange))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_AddItem_Call) Return(_a0 error) *MockListRepository_AddItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListRepository_AddItem_Call) RunAndReturn(run func(*models.ListItem, *models.ListChange) error) *MockListRepository_AddItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: list
+func (_m *MockListRepository) Create(list *models.List) error {
+	ret := _m.Called(list)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.List) error); ok {
+		r0 = rf(list)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockListRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - list *models.List
+func (_e *MockListRepository_Expecter) Create(list interface{}) *MockListRepository_Create_Call {
+	return &MockListRepository_Create_Call{Call: _e.mock.On("Create", list)}
+}
+
+func (_c *MockListRepository_Create_Call) Run(run func(list *models.List)) *MockListRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.List))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_Create_Call) Return(_a0 error) *MockListRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListRepository_Create_Call) RunAndReturn(run func(*models.List) error) *MockListRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: userID, id
+func (_m *MockListRepository) Delete(userID uuid.UUID, id uuid.UUID) (int64, error) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) (int64, error)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) int64); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockListRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockListRepository_Expecter) Delete(userID interface{}, id interface{}) *MockListRepository_Delete_Call {
+	return &MockListRepository_Delete_Call{Call: _e.mock.On("Delete", userID, id)}
+}
+
+func (_c *MockListRepository_Delete_Call) Run(run func(userID uuid.UUID, id uuid.UUID)) *MockListRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_Delete_Call) Return(_a0 int64, _a1 error) *MockListRepository_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListRepository_Delete_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) (int64, error)) *MockListRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: id
+func (_m *MockListRepository) Find(id uuid.UUID) (*models.List, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*models.List, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *models.List); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockListRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *MockListRepository_Expecter) Find(id interface{}) *MockListRepository_Find_Call {
+	return &MockListRepository_Find_Call{Call: _e.mock.On("Find", id)}
+}
+
+func (_c *MockListRepository_Find_Call) Run(run func(id uuid.UUID)) *MockListRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_Find_Call) Return(_a0 *models.List, _a1 error) *MockListRepository_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListRepository_Find_Call) RunAndReturn(run func(uuid.UUID) (*models.List, error)) *MockListRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// History provides a mock function with given fields: listID, limit
+func (_m *MockListRepository) History(listID uuid.UUID, limit int) ([]models.ListChange, error) {
+	ret := _m.Called(listID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []models.ListChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) ([]models.ListChange, error)); ok {
+		return rf(listID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) []models.ListChange); ok {
+		r0 = rf(listID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ListChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int) error); ok {
+		r1 = rf(listID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListRepository_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type MockListRepository_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - listID uuid.UUID
+//   - limit int
+func (_e *MockListRepository_Expecter) History(listID interface{}, limit interface{}) *MockListRepository_History_Call {
+	return &MockListRepository_History_Call{Call: _e.mock.On("History", listID, limit)}
+}
+
+func (_c *MockListRepository_History_Call) Run(run func(listID uuid.UUID, limit int)) *MockListRepository_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_History_Call) Return(_a0 []models.ListChange, _a1 error) *MockListRepository_History_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListRepository_History_Call) RunAndReturn(run func(uuid.UUID, int) ([]models.ListChange, error)) *MockListRepository_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: userID
+func (_m *MockListRepository) ListByUserID(userID uuid.UUID) ([]models.List, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.List, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.List); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockListRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockListRepository_Expecter) ListByUserID(userID interface{}) *MockListRepository_ListByUserID_Call {
+	return &MockListRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", userID)}
+}
+
+func (_c *MockListRepository_ListByUserID_Call) Run(run func(userID uuid.UUID)) *MockListRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_ListByUserID_Call) Return(_a0 []models.List, _a1 error) *MockListRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListRepository_ListByUserID_Call) RunAndReturn(run func(uuid.UUID) ([]models.List, error)) *MockListRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithItems provides a mock function with given fields: userID
+func (_m *MockListRepository) ListWithItems(userID uuid.UUID) ([]models.List, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithItems")
+	}
+
+	var r0 []models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.List, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.List); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListRepository_ListWithItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithItems'
+type MockListRepository_ListWithItems_Call struct {
+	*mock.Call
+}
+
+// ListWithItems is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockListRepository_Expecter) ListWithItems(userID interface{}) *MockListRepository_ListWithItems_Call {
+	return &MockListRepository_ListWithItems_Call{Call: _e.mock.On("ListWithItems", userID)}
+}
+
+func (_c *MockListRepository_ListWithItems_Call) Run(run func(userID uuid.UUID)) *MockListRepository_ListWithItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_ListWithItems_Call) Return(_a0 []models.List, _a1 error) *MockListRepository_ListWithItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListRepository_ListWithItems_Call) RunAndReturn(run func(uuid.UUID) ([]models.List, error)) *MockListRepository_ListWithItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveItem provides a mock function with given fields: listID, itemID, change
+func (_m *MockListRepository) RemoveItem(listID uuid.UUID, itemID uuid.UUID, change *models.ListChange) (int64, error) {
+	ret := _m.Called(listID, itemID, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveItem")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, *models.ListChange) (int64, error)); ok {
+		return rf(listID, itemID, change)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, *models.ListChange) int64); ok {
+		r0 = rf(listID, itemID, change)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, *models.ListChange) error); ok {
+		r1 = rf(listID, itemID, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListRepository_RemoveItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveItem'
+type MockListRepository_RemoveItem_Call struct {
+	*mock.Call
+}
+
+// RemoveItem is a helper method to define mock.On call
+//   - listID uuid.UUID
+//   - itemID uuid.UUID
+//   - change *models.ListChange
+func (_e *MockListRepository_Expecter) RemoveItem(listID interface{}, itemID interface{}, change interface{}) *MockListRepository_RemoveItem_Call {
+	return &MockListRepository_RemoveItem_Call{Call: _e.mock.On("RemoveItem", listID, itemID, change)}
+}
+
+func (_c *MockListRepository_RemoveItem_Call) Run(run func(listID uuid.UUID, itemID uuid.UUID, change *models.ListChange)) *MockListRepository_RemoveItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(*models.ListChange))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_RemoveItem_Call) Return(_a0 int64, _a1 error) *MockListRepository_RemoveItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListRepository_RemoveItem_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, *models.ListChange) (int64, error)) *MockListRepository_RemoveItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: listID, userID, change
+func (_m *MockListRepository) RemoveMember(listID uuid.UUID, userID uuid.UUID, change *models.ListChange) (int64, error) {
+	ret := _m.Called(listID, userID, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, *models.ListChange) (int64, error)); ok {
+		return rf(listID, userID, change)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, *models.ListChange) int64); ok {
+		r0 = rf(listID, userID, change)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, *models.ListChange) error); ok {
+		r1 = rf(listID, userID, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListRepository_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockListRepository_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - listID uuid.UUID
+//   - userID uuid.UUID
+//   - change *models.ListChange
+func (_e *MockListRepository_Expecter) RemoveMember(listID interface{}, userID interface{}, change interface{}) *MockListRepository_RemoveMember_Call {
+	return &MockListRepository_RemoveMember_Call{Call: _e.mock.On("RemoveMember", listID, userID, change)}
+}
+
+func (_c *MockListRepository_RemoveMember_Call) Run(run func(listID uuid.UUID, userID uuid.UUID, change *models.ListChange)) *MockListRepository_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(*models.ListChange))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_RemoveMember_Call) Return(_a0 int64, _a1 error) *MockListRepository_RemoveMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListRepository_RemoveMember_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, *models.ListChange) (int64, error)) *MockListRepository_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reorder provides a mock function with given fields: listID, version, itemIDs, change
+func (_m *MockListRepository) Reorder(listID uuid.UUID, version int, itemIDs []uuid.UUID, change *models.ListChange) (bool, error) {
+	ret := _m.Called(listID, version, itemIDs, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reorder")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, []uuid.UUID, *models.ListChange) (bool, error)); ok {
+		return rf(listID, version, itemIDs, change)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, []uuid.UUID, *models.ListChange) bool); ok {
+		r0 = rf(listID, version, itemIDs, change)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int, []uuid.UUID, *models.ListChange) error); ok {
+		r1 = rf(listID, version, itemIDs, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListRepository_Reorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reorder'
+type MockListRepository_Reorder_Call struct {
+	*mock.Call
+}
+
+// Reorder is a helper method to define mock.On call
+//   - listID uuid.UUID
+//   - version int
+//   - itemIDs []uuid.UUID
+//   - change *models.ListChange
+func (_e *MockListRepository_Expecter) Reorder(listID interface{}, version interface{}, itemIDs interface{}, change interface{}) *MockListRepository_Reorder_Call {
+	return &MockListRepository_Reorder_Call{Call: _e.mock.On("Reorder", listID, version, itemIDs, change)}
+}
+
+func (_c *MockListRepository_Reorder_Call) Run(run func(listID uuid.UUID, version int, itemIDs []uuid.UUID, change *models.ListChange)) *MockListRepository_Reorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].([]uuid.UUID), args[3].(*models.ListChange))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_Reorder_Call) Return(_a0 bool, _a1 error) *MockListRepository_Reorder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListRepository_Reorder_Call) RunAndReturn(run func(uuid.UUID, int, []uuid.UUID, *models.ListChange) (bool, error)) *MockListRepository_Reorder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMember provides a mock function with given fields: member, change
+func (_m *MockListRepository) SetMember(member *models.ListMember, change *models.ListChange) error {
+	ret := _m.Called(member, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ListMember, *models.ListChange) error); ok {
+		r0 = rf(member, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListRepository_SetMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMember'
+type MockListRepository_SetMember_Call struct {
+	*mock.Call
+}
+
+// SetMember is a helper method to define mock.On call
+//   - member *models.ListMember
+//   - change *models.ListChange
+func (_e *MockListRepository_Expecter) SetMember(member interface{}, change interface{}) *MockListRepository_SetMember_Call {
+	return &MockListRepository_SetMember_Call{Call: _e.mock.On("SetMember", member, change)}
+}
+
+func (_c *MockListRepository_SetMember_Call) Run(run func(member *models.ListMember, change *models.ListChange)) *MockListRepository_SetMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ListMember), args[1].(*models.ListChange))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_SetMember_Call) Return(_a0 error) *MockListRepository_SetMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListRepository_SetMember_Call) RunAndReturn(run func(*models.ListMember, *models.ListChange) error) *MockListRepository_SetMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: list, change
+func (_m *MockListRepository) Update(list *models.List, change *models.ListChange) error {
+	ret := _m.Called(list, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.List, *models.ListChange) error); ok {
+		r0 = rf(list, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockListRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - list *models.List
+//   - change *models.ListChange
+func (_e *MockListRepository_Expecter) Update(list interface{}, change interface{}) *MockListRepository_Update_Call {
+	return &MockListRepository_Update_Call{Call: _e.mock.On("Update", list, change)}
+}
+
+func (_c *MockListRepository_Update_Call) Run(run func(list *models.List, change *models.ListChange)) *MockListRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.List), args[1].(*models.ListChange))
+	})
+	return _c
+}
+
+func (_c *MockListRepository_Update_Call) Return(_a0 error) *MockListRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListRepository_Update_Call) RunAndReturn(run func(*models.List, *models.ListChange) error) *MockListRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListRepository creates a new instance of MockListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListRepository {
+	mock := &MockListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -68,6 +68,64 @@ func (_c *MockPostRepository_Create_Call) RunAndReturn(run func(*models.Post) er
 	return _c
 }
 
+// GetAllByUserID provides a mock function with given fields: userID
+func (_m *MockPostRepository) GetAllByUserID(userID uuid.UUID) ([]models.Post, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllByUserID")
+	}
+
+	var r0 []models.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.Post, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.Post); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPostRepository_GetAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllByUserID'
+type MockPostRepository_GetAllByUserID_Call struct {
+	*mock.Call
+}
+
+// GetAllByUserID is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockPostRepository_Expecter) GetAllByUserID(userID interface{}) *MockPostRepository_GetAllByUserID_Call {
+	return &MockPostRepository_GetAllByUserID_Call{Call: _e.mock.On("GetAllByUserID", userID)}
+}
+
+func (_c *MockPostRepository_GetAllByUserID_Call) Run(run func(userID uuid.UUID)) *MockPostRepository_GetAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPostRepository_GetAllByUserID_Call) Return(_a0 []models.Post, _a1 error) *MockPostRepository_GetAllByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPostRepository_GetAllByUserID_Call) RunAndReturn(run func(uuid.UUID) ([]models.Post, error)) *MockPostRepository_GetAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByUserIDs provides a mock function with given fields: userIDs, limit
 func (_m *MockPostRepository) GetByUserIDs(userIDs []uuid.UUID, limit int) ([]models.Post, error) {
 	ret := _m.Called(userIDs, limit)
@@ -0,0 +1,314 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockProgressRepository is an autogenerated mock type for the ProgressRepository type
+type MockProgressRepository struct {
+	mock.Mock
+}
+
+type MockProgressRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProgressRepository) EXPECT() *MockProgressRepository_Expecter {
+	return &MockProgressRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListByItem provides a mock function with given fields: watchlistID
+func (_m *MockProgressRepository) ListByItem(watchlistID uuid.UUID) ([]models.EpisodeProgress, error) {
+	ret := _m.Called(watchlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByItem")
+	}
+
+	var r0 []models.EpisodeProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.EpisodeProgress, error)); ok {
+		return rf(watchlistID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.EpisodeProgress); ok {
+		r0 = rf(watchlistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EpisodeProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(watchlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressRepository_ListByItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByItem'
+type MockProgressRepository_ListByItem_Call struct {
+	*mock.Call
+}
+
+// ListByItem is a helper method to define mock.On call
+//   - watchlistID uuid.UUID
+func (_e *MockProgressRepository_Expecter) ListByItem(watchlistID interface{}) *MockProgressRepository_ListByItem_Call {
+	return &MockProgressRepository_ListByItem_Call{Call: _e.mock.On("ListByItem", watchlistID)}
+}
+
+func (_c *MockProgressRepository_ListByItem_Call) Run(run func(watchlistID uuid.UUID)) *MockProgressRepository_ListByItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProgressRepository_ListByItem_Call) Return(_a0 []models.EpisodeProgress, _a1 error) *MockProgressRepository_ListByItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressRepository_ListByItem_Call) RunAndReturn(run func(uuid.UUID) ([]models.EpisodeProgress, error)) *MockProgressRepository_ListByItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: userID
+func (_m *MockProgressRepository) ListByUserID(userID uuid.UUID) ([]models.EpisodeProgress, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []models.EpisodeProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.EpisodeProgress, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.EpisodeProgress); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EpisodeProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockProgressRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockProgressRepository_Expecter) ListByUserID(userID interface{}) *MockProgressRepository_ListByUserID_Call {
+	return &MockProgressRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", userID)}
+}
+
+func (_c *MockProgressRepository_ListByUserID_Call) Run(run func(userID uuid.UUID)) *MockProgressRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProgressRepository_ListByUserID_Call) Return(_a0 []models.EpisodeProgress, _a1 error) *MockProgressRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressRepository_ListByUserID_Call) RunAndReturn(run func(uuid.UUID) ([]models.EpisodeProgress, error)) *MockProgressRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkWatched provides a mock function with given fields: entries
+func (_m *MockProgressRepository) MarkWatched(entries []models.EpisodeProgress) error {
+	ret := _m.Called(entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkWatched")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.EpisodeProgress) error); ok {
+		r0 = rf(entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProgressRepository_MarkWatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkWatched'
+type MockProgressRepository_MarkWatched_Call struct {
+	*mock.Call
+}
+
+// MarkWatched is a helper method to define mock.On call
+//   - entries []models.EpisodeProgress
+func (_e *MockProgressRepository_Expecter) MarkWatched(entries interface{}) *MockProgressRepository_MarkWatched_Call {
+	return &MockProgressRepository_MarkWatched_Call{Call: _e.mock.On("MarkWatched", entries)}
+}
+
+func (_c *MockProgressRepository_MarkWatched_Call) Run(run func(entries []models.EpisodeProgress)) *MockProgressRepository_MarkWatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.EpisodeProgress))
+	})
+	return _c
+}
+
+func (_c *MockProgressRepository_MarkWatched_Call) Return(_a0 error) *MockProgressRepository_MarkWatched_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProgressRepository_MarkWatched_Call) RunAndReturn(run func([]models.EpisodeProgress) error) *MockProgressRepository_MarkWatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnmarkEpisode provides a mock function with given fields: watchlistID, seasonNumber, episodeNumber
+func (_m *MockProgressRepository) UnmarkEpisode(watchlistID uuid.UUID, seasonNumber int, episodeNumber int) (int64, error) {
+	ret := _m.Called(watchlistID, seasonNumber, episodeNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmarkEpisode")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int) (int64, error)); ok {
+		return rf(watchlistID, seasonNumber, episodeNumber)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int) int64); ok {
+		r0 = rf(watchlistID, seasonNumber, episodeNumber)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int, int) error); ok {
+		r1 = rf(watchlistID, seasonNumber, episodeNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressRepository_UnmarkEpisode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnmarkEpisode'
+type MockProgressRepository_UnmarkEpisode_Call struct {
+	*mock.Call
+}
+
+// UnmarkEpisode is a helper method to define mock.On call
+//   - watchlistID uuid.UUID
+//   - seasonNumber int
+//   - episodeNumber int
+func (_e *MockProgressRepository_Expecter) UnmarkEpisode(watchlistID interface{}, seasonNumber interface{}, episodeNumber interface{}) *MockProgressRepository_UnmarkEpisode_Call {
+	return &MockProgressRepository_UnmarkEpisode_Call{Call: _e.mock.On("UnmarkEpisode", watchlistID, seasonNumber, episodeNumber)}
+}
+
+func (_c *MockProgressRepository_UnmarkEpisode_Call) Run(run func(watchlistID uuid.UUID, seasonNumber int, episodeNumber int)) *MockProgressRepository_UnmarkEpisode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProgressRepository_UnmarkEpisode_Call) Return(_a0 int64, _a1 error) *MockProgressRepository_UnmarkEpisode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressRepository_UnmarkEpisode_Call) RunAndReturn(run func(uuid.UUID, int, int) (int64, error)) *MockProgressRepository_UnmarkEpisode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnmarkSeason provides a mock function with given fields: watchlistID, seasonNumber
+func (_m *MockProgressRepository) UnmarkSeason(watchlistID uuid.UUID, seasonNumber int) (int64, error) {
+	ret := _m.Called(watchlistID, seasonNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmarkSeason")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) (int64, error)); ok {
+		return rf(watchlistID, seasonNumber)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) int64); ok {
+		r0 = rf(watchlistID, seasonNumber)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int) error); ok {
+		r1 = rf(watchlistID, seasonNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressRepository_UnmarkSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnmarkSeason'
+type MockProgressRepository_UnmarkSeason_Call struct {
+	*mock.Call
+}
+
+// UnmarkSeason is a helper method to define mock.On call
+//   - watchlistID uuid.UUID
+//   - seasonNumber int
+func (_e *MockProgressRepository_Expecter) UnmarkSeason(watchlistID interface{}, seasonNumber interface{}) *MockProgressRepository_UnmarkSeason_Call {
+	return &MockProgressRepository_UnmarkSeason_Call{Call: _e.mock.On("UnmarkSeason", watchlistID, seasonNumber)}
+}
+
+func (_c *MockProgressRepository_UnmarkSeason_Call) Run(run func(watchlistID uuid.UUID, seasonNumber int)) *MockProgressRepository_UnmarkSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockProgressRepository_UnmarkSeason_Call) Return(_a0 int64, _a1 error) *MockProgressRepository_UnmarkSeason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressRepository_UnmarkSeason_Call) RunAndReturn(run func(uuid.UUID, int) (int64, error)) *MockProgressRepository_UnmarkSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProgressRepository creates a new instance of MockProgressRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProgressRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProgressRepository {
+	mock := &MockProgressRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,572 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockSessionRepository is an autogenerated mock type for the SessionRepository type
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+type MockSessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionRepository) EXPECT() *MockSessionRepository_Expecter {
+	return &MockSessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: session
+func (_m *MockSessionRepository) Create(session *models.Session) error {
+	ret := _m.Called(session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Session) error); ok {
+		r0 = rf(session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSessionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - session *models.Session
+func (_e *MockSessionRepository_Expecter) Create(session interface{}) *MockSessionRepository_Create_Call {
+	return &MockSessionRepository_Create_Call{Call: _e.mock.On("Create", session)}
+}
+
+func (_c *MockSessionRepository_Create_Call) Run(run func(session *models.Session)) *MockSessionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Session))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Create_Call) Return(_a0 error) *MockSessionRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionRepository_Create_Call) RunAndReturn(run func(*models.Session) error) *MockSessionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRefreshToken provides a mock function with given fields: token
+func (_m *MockSessionRepository) CreateRefreshToken(token *models.RefreshToken) error {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRefreshToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.RefreshToken) error); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionRepository_CreateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefreshToken'
+type MockSessionRepository_CreateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// CreateRefreshToken is a helper method to define mock.On call
+//   - token *models.RefreshToken
+func (_e *MockSessionRepository_Expecter) CreateRefreshToken(token interface{}) *MockSessionRepository_CreateRefreshToken_Call {
+	return &MockSessionRepository_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", token)}
+}
+
+func (_c *MockSessionRepository_CreateRefreshToken_Call) Run(run func(token *models.RefreshToken)) *MockSessionRepository_CreateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_CreateRefreshToken_Call) Return(_a0 error) *MockSessionRepository_CreateRefreshToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionRepository_CreateRefreshToken_Call) RunAndReturn(run func(*models.RefreshToken) error) *MockSessionRepository_CreateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: sessionID
+func (_m *MockSessionRepository) FindByID(sessionID uuid.UUID) (*models.Session, error) {
+	ret := _m.Called(sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *models.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*models.Session, error)); ok {
+		return rf(sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *models.Session); ok {
+		r0 = rf(sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockSessionRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - sessionID uuid.UUID
+func (_e *MockSessionRepository_Expecter) FindByID(sessionID interface{}) *MockSessionRepository_FindByID_Call {
+	return &MockSessionRepository_FindByID_Call{Call: _e.mock.On("FindByID", sessionID)}
+}
+
+func (_c *MockSessionRepository_FindByID_Call) Run(run func(sessionID uuid.UUID)) *MockSessionRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_FindByID_Call) Return(_a0 *models.Session, _a1 error) *MockSessionRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionRepository_FindByID_Call) RunAndReturn(run func(uuid.UUID) (*models.Session, error)) *MockSessionRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRefreshToken provides a mock function with given fields: tokenHash
+func (_m *MockSessionRepository) FindRefreshToken(tokenHash string) (*models.RefreshToken, error) {
+	ret := _m.Called(tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRefreshToken")
+	}
+
+	var r0 *models.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.RefreshToken, error)); ok {
+		return rf(tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.RefreshToken); ok {
+		r0 = rf(tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionRepository_FindRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRefreshToken'
+type MockSessionRepository_FindRefreshToken_Call struct {
+	*mock.Call
+}
+
+// FindRefreshToken is a helper method to define mock.On call
+//   - tokenHash string
+func (_e *MockSessionRepository_Expecter) FindRefreshToken(tokenHash interface{}) *MockSessionRepository_FindRefreshToken_Call {
+	return &MockSessionRepository_FindRefreshToken_Call{Call: _e.mock.On("FindRefreshToken", tokenHash)}
+}
+
+func (_c *MockSessionRepository_FindRefreshToken_Call) Run(run func(tokenHash string)) *MockSessionRepository_FindRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_FindRefreshToken_Call) Return(_a0 *models.RefreshToken, _a1 error) *MockSessionRepository_FindRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionRepository_FindRefreshToken_Call) RunAndReturn(run func(string) (*models.RefreshToken, error)) *MockSessionRepository_FindRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsActive provides a mock function with given fields: sessionID, now
+func (_m *MockSessionRepository) IsActive(sessionID uuid.UUID, now time.Time) (bool, error) {
+	ret := _m.Called(sessionID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsActive")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) (bool, error)); ok {
+		return rf(sessionID, now)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) bool); ok {
+		r0 = rf(sessionID, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, time.Time) error); ok {
+		r1 = rf(sessionID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionRepository_IsActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsActive'
+type MockSessionRepository_IsActive_Call struct {
+	*mock.Call
+}
+
+// IsActive is a helper method to define mock.On call
+//   - sessionID uuid.UUID
+//   - now time.Time
+func (_e *MockSessionRepository_Expecter) IsActive(sessionID interface{}, now interface{}) *MockSessionRepository_IsActive_Call {
+	return &MockSessionRepository_IsActive_Call{Call: _e.mock.On("IsActive", sessionID, now)}
+}
+
+func (_c *MockSessionRepository_IsActive_Call) Run(run func(sessionID uuid.UUID, now time.Time)) *MockSessionRepository_IsActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_IsActive_Call) Return(_a0 bool, _a1 error) *MockSessionRepository_IsActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionRepository_IsActive_Call) RunAndReturn(run func(uuid.UUID, time.Time) (bool, error)) *MockSessionRepository_IsActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActiveByUserID provides a mock function with given fields: userID, now
+func (_m *MockSessionRepository) ListActiveByUserID(userID uuid.UUID, now time.Time) ([]models.Session, error) {
+	ret := _m.Called(userID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveByUserID")
+	}
+
+	var r0 []models.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) ([]models.Session, error)); ok {
+		return rf(userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) []models.Session); ok {
+		r0 = rf(userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, time.Time) error); ok {
+		r1 = rf(userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionRepository_ListActiveByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveByUserID'
+type MockSessionRepository_ListActiveByUserID_Call struct {
+	*mock.Call
+}
+
+// ListActiveByUserID is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - now time.Time
+func (_e *MockSessionRepository_Expecter) ListActiveByUserID(userID interface{}, now interface{}) *MockSessionRepository_ListActiveByUserID_Call {
+	return &MockSessionRepository_ListActiveByUserID_Call{Call: _e.mock.On("ListActiveByUserID", userID, now)}
+}
+
+func (_c *MockSessionRepository_ListActiveByUserID_Call) Run(run func(userID uuid.UUID, now time.Time)) *MockSessionRepository_ListActiveByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_ListActiveByUserID_Call) Return(_a0 []models.Session, _a1 error) *MockSessionRepository_ListActiveByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionRepository_ListActiveByUserID_Call) RunAndReturn(run func(uuid.UUID, time.Time) ([]models.Session, error)) *MockSessionRepository_ListActiveByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRefreshTokenUsed provides a mock function with given fields: tokenID, at
+func (_m *MockSessionRepository) MarkRefreshTokenUsed(tokenID uuid.UUID, at time.Time) (bool, error) {
+	ret := _m.Called(tokenID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRefreshTokenUsed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) (bool, error)); ok {
+		return rf(tokenID, at)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) bool); ok {
+		r0 = rf(tokenID, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, time.Time) error); ok {
+		r1 = rf(tokenID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionRepository_MarkRefreshTokenUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRefreshTokenUsed'
+type MockSessionRepository_MarkRefreshTokenUsed_Call struct {
+	*mock.Call
+}
+
+// MarkRefreshTokenUsed is a helper method to define mock.On call
+//   - tokenID uuid.UUID
+//   - at time.Time
+func (_e *MockSessionRepository_Expecter) MarkRefreshTokenUsed(tokenID interface{}, at interface{}) *MockSessionRepository_MarkRefreshTokenUsed_Call {
+	return &MockSessionRepository_MarkRefreshTokenUsed_Call{Call: _e.mock.On("MarkRefreshTokenUsed", tokenID, at)}
+}
+
+func (_c *MockSessionRepository_MarkRefreshTokenUsed_Call) Run(run func(tokenID uuid.UUID, at time.Time)) *MockSessionRepository_MarkRefreshTokenUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_MarkRefreshTokenUsed_Call) Return(_a0 bool, _a1 error) *MockSessionRepository_MarkRefreshTokenUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionRepository_MarkRefreshTokenUsed_Call) RunAndReturn(run func(uuid.UUID, time.Time) (bool, error)) *MockSessionRepository_MarkRefreshTokenUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: sessionID, at
+func (_m *MockSessionRepository) Revoke(sessionID uuid.UUID, at time.Time) error {
+	ret := _m.Called(sessionID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = rf(sessionID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockSessionRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - sessionID uuid.UUID
+//   - at time.Time
+func (_e *MockSessionRepository_Expecter) Revoke(sessionID interface{}, at interface{}) *MockSessionRepository_Revoke_Call {
+	return &MockSessionRepository_Revoke_Call{Call: _e.mock.On("Revoke", sessionID, at)}
+}
+
+func (_c *MockSessionRepository_Revoke_Call) Run(run func(sessionID uuid.UUID, at time.Time)) *MockSessionRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Revoke_Call) Return(_a0 error) *MockSessionRepository_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionRepository_Revoke_Call) RunAndReturn(run func(uuid.UUID, time.Time) error) *MockSessionRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllForUser provides a mock function with given fields: userID, at
+func (_m *MockSessionRepository) RevokeAllForUser(userID uuid.UUID, at time.Time) (int64, error) {
+	ret := _m.Called(userID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllForUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) (int64, error)); ok {
+		return rf(userID, at)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) int64); ok {
+		r0 = rf(userID, at)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, time.Time) error); ok {
+		r1 = rf(userID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionRepository_RevokeAllForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllForUser'
+type MockSessionRepository_RevokeAllForUser_Call struct {
+	*mock.Call
+}
+
+// RevokeAllForUser is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - at time.Time
+func (_e *MockSessionRepository_Expecter) RevokeAllForUser(userID interface{}, at interface{}) *MockSessionRepository_RevokeAllForUser_Call {
+	return &MockSessionRepository_RevokeAllForUser_Call{Call: _e.mock.On("RevokeAllForUser", userID, at)}
+}
+
+func (_c *MockSessionRepository_RevokeAllForUser_Call) Run(run func(userID uuid.UUID, at time.Time)) *MockSessionRepository_RevokeAllForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeAllForUser_Call) Return(_a0 int64, _a1 error) *MockSessionRepository_RevokeAllForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionRepository_RevokeAllForUser_Call) RunAndReturn(run func(uuid.UUID, time.Time) (int64, error)) *MockSessionRepository_RevokeAllForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Touch provides a mock function with given fields: sessionID, ip, at
+func (_m *MockSessionRepository) Touch(sessionID uuid.UUID, ip string, at time.Time) error {
+	ret := _m.Called(sessionID, ip, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Touch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, time.Time) error); ok {
+		r0 = rf(sessionID, ip, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionRepository_Touch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Touch'
+type MockSessionRepository_Touch_Call struct {
+	*mock.Call
+}
+
+// Touch is a helper method to define mock.On call
+//   - sessionID uuid.UUID
+//   - ip string
+//   - at time.Time
+func (_e *MockSessionRepository_Expecter) Touch(sessionID interface{}, ip interface{}, at interface{}) *MockSessionRepository_Touch_Call {
+	return &MockSessionRepository_Touch_Call{Call: _e.mock.On("Touch", sessionID, ip, at)}
+}
+
+func (_c *MockSessionRepository_Touch_Call) Run(run func(sessionID uuid.UUID, ip string, at time.Time)) *MockSessionRepository_Touch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Touch_Call) Return(_a0 error) *MockSessionRepository_Touch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionRepository_Touch_Call) RunAndReturn(run func(uuid.UUID, string, time.Time) error) *MockSessionRepository_Touch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionRepository creates a new instance of MockSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionRepository {
+	mock := &MockSessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
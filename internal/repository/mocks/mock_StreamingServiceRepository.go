@@ -0,0 +1,298 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockStreamingServiceRepository is an autogenerated mock type for the StreamingServiceRepository type
+type MockStreamingServiceRepository struct {
+	mock.Mock
+}
+
+type MockStreamingServiceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStreamingServiceRepository) EXPECT() *MockStreamingServiceRepository_Expecter {
+	return &MockStreamingServiceRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: service
+func (_m *MockStreamingServiceRepository) Create(service *models.StreamingService) error {
+	ret := _m.Called(service)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.StreamingService) error); ok {
+		r0 = rf(service)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingServiceRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockStreamingServiceRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - service *models.StreamingService
+func (_e *MockStreamingServiceRepository_Expecter) Create(service interface{}) *MockStreamingServiceRepository_Create_Call {
+	return &MockStreamingServiceRepository_Create_Call{Call: _e.mock.On("Create", service)}
+}
+
+func (_c *MockStreamingServiceRepository_Create_Call) Run(run func(service *models.StreamingService)) *MockStreamingServiceRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.StreamingService))
+	})
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_Create_Call) Return(_a0 error) *MockStreamingServiceRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_Create_Call) RunAndReturn(run func(*models.StreamingService) error) *MockStreamingServiceRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *MockStreamingServiceRepository) Delete(id int) (int64, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int64, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) int64); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingServiceRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockStreamingServiceRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+func (_e *MockStreamingServiceRepository_Expecter) Delete(id interface{}) *MockStreamingServiceRepository_Delete_Call {
+	return &MockStreamingServiceRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockStreamingServiceRepository_Delete_Call) Run(run func(id int)) *MockStreamingServiceRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_Delete_Call) Return(_a0 int64, _a1 error) *MockStreamingServiceRepository_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_Delete_Call) RunAndReturn(run func(int) (int64, error)) *MockStreamingServiceRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *MockStreamingServiceRepository) FindByID(id int) (*models.StreamingService, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *models.StreamingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.StreamingService, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.StreamingService); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StreamingService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingServiceRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockStreamingServiceRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockStreamingServiceRepository_Expecter) FindByID(id interface{}) *MockStreamingServiceRepository_FindByID_Call {
+	return &MockStreamingServiceRepository_FindByID_Call{Call: _e.mock.On("FindByID", id)}
+}
+
+func (_c *MockStreamingServiceRepository_FindByID_Call) Run(run func(id int)) *MockStreamingServiceRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_FindByID_Call) Return(_a0 *models.StreamingService, _a1 error) *MockStreamingServiceRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_FindByID_Call) RunAndReturn(run func(int) (*models.StreamingService, error)) *MockStreamingServiceRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with no fields
+func (_m *MockStreamingServiceRepository) List() ([]models.StreamingService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.StreamingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.StreamingService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.StreamingService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StreamingService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingServiceRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockStreamingServiceRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+func (_e *MockStreamingServiceRepository_Expecter) List() *MockStreamingServiceRepository_List_Call {
+	return &MockStreamingServiceRepository_List_Call{Call: _e.mock.On("List")}
+}
+
+func (_c *MockStreamingServiceRepository_List_Call) Run(run func()) *MockStreamingServiceRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_List_Call) Return(_a0 []models.StreamingService, _a1 error) *MockStreamingServiceRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_List_Call) RunAndReturn(run func() ([]models.StreamingService, error)) *MockStreamingServiceRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: service
+func (_m *MockStreamingServiceRepository) Update(service *models.StreamingService) error {
+	ret := _m.Called(service)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.StreamingService) error); ok {
+		r0 = rf(service)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingServiceRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockStreamingServiceRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - service *models.StreamingService
+func (_e *MockStreamingServiceRepository_Expecter) Update(service interface{}) *MockStreamingServiceRepository_Update_Call {
+	return &MockStreamingServiceRepository_Update_Call{Call: _e.mock.On("Update", service)}
+}
+
+func (_c *MockStreamingServiceRepository_Update_Call) Run(run func(service *models.StreamingService)) *MockStreamingServiceRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.StreamingService))
+	})
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_Update_Call) Return(_a0 error) *MockStreamingServiceRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingServiceRepository_Update_Call) RunAndReturn(run func(*models.StreamingService) error) *MockStreamingServiceRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStreamingServiceRepository creates a new instance of MockStreamingServiceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreamingServiceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreamingServiceRepository {
+	mock := &MockStreamingServiceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
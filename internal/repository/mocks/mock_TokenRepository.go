@@ -0,0 +1,306 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockTokenRepository is an autogenerated mock type for the TokenRepository type
+type MockTokenRepository struct {
+	mock.Mock
+}
+
+type MockTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenRepository) EXPECT() *MockTokenRepository_Expecter {
+	return &MockTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: token
+func (_m *MockTokenRepository) Create(token *models.PersonalAccessToken) error {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PersonalAccessToken) error); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTokenRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - token *models.PersonalAccessToken
+func (_e *MockTokenRepository_Expecter) Create(token interface{}) *MockTokenRepository_Create_Call {
+	return &MockTokenRepository_Create_Call{Call: _e.mock.On("Create", token)}
+}
+
+func (_c *MockTokenRepository_Create_Call) Run(run func(token *models.PersonalAccessToken)) *MockTokenRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.PersonalAccessToken))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepository_Create_Call) Return(_a0 error) *MockTokenRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenRepository_Create_Call) RunAndReturn(run func(*models.PersonalAccessToken) error) *MockTokenRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByHash provides a mock function with given fields: tokenHash
+func (_m *MockTokenRepository) FindByHash(tokenHash string) (*models.PersonalAccessToken, error) {
+	ret := _m.Called(tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByHash")
+	}
+
+	var r0 *models.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.PersonalAccessToken, error)); ok {
+		return rf(tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.PersonalAccessToken); ok {
+		r0 = rf(tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenRepository_FindByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByHash'
+type MockTokenRepository_FindByHash_Call struct {
+	*mock.Call
+}
+
+// FindByHash is a helper method to define mock.On call
+//   - tokenHash string
+func (_e *MockTokenRepository_Expecter) FindByHash(tokenHash interface{}) *MockTokenRepository_FindByHash_Call {
+	return &MockTokenRepository_FindByHash_Call{Call: _e.mock.On("FindByHash", tokenHash)}
+}
+
+func (_c *MockTokenRepository_FindByHash_Call) Run(run func(tokenHash string)) *MockTokenRepository_FindByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepository_FindByHash_Call) Return(_a0 *models.PersonalAccessToken, _a1 error) *MockTokenRepository_FindByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenRepository_FindByHash_Call) RunAndReturn(run func(string) (*models.PersonalAccessToken, error)) *MockTokenRepository_FindByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActiveByUserID provides a mock function with given fields: userID
+func (_m *MockTokenRepository) ListActiveByUserID(userID uuid.UUID) ([]models.PersonalAccessToken, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveByUserID")
+	}
+
+	var r0 []models.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.PersonalAccessToken, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.PersonalAccessToken); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenRepository_ListActiveByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveByUserID'
+type MockTokenRepository_ListActiveByUserID_Call struct {
+	*mock.Call
+}
+
+// ListActiveByUserID is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockTokenRepository_Expecter) ListActiveByUserID(userID interface{}) *MockTokenRepository_ListActiveByUserID_Call {
+	return &MockTokenRepository_ListActiveByUserID_Call{Call: _e.mock.On("ListActiveByUserID", userID)}
+}
+
+func (_c *MockTokenRepository_ListActiveByUserID_Call) Run(run func(userID uuid.UUID)) *MockTokenRepository_ListActiveByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepository_ListActiveByUserID_Call) Return(_a0 []models.PersonalAccessToken, _a1 error) *MockTokenRepository_ListActiveByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenRepository_ListActiveByUserID_Call) RunAndReturn(run func(uuid.UUID) ([]models.PersonalAccessToken, error)) *MockTokenRepository_ListActiveByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: userID, tokenID, at
+func (_m *MockTokenRepository) Revoke(userID uuid.UUID, tokenID uuid.UUID, at time.Time) (int64, error) {
+	ret := _m.Called(userID, tokenID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, time.Time) (int64, error)); ok {
+		return rf(userID, tokenID, at)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, time.Time) int64); ok {
+		r0 = rf(userID, tokenID, at)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(userID, tokenID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockTokenRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - tokenID uuid.UUID
+//   - at time.Time
+func (_e *MockTokenRepository_Expecter) Revoke(userID interface{}, tokenID interface{}, at interface{}) *MockTokenRepository_Revoke_Call {
+	return &MockTokenRepository_Revoke_Call{Call: _e.mock.On("Revoke", userID, tokenID, at)}
+}
+
+func (_c *MockTokenRepository_Revoke_Call) Run(run func(userID uuid.UUID, tokenID uuid.UUID, at time.Time)) *MockTokenRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepository_Revoke_Call) Return(_a0 int64, _a1 error) *MockTokenRepository_Revoke_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenRepository_Revoke_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, time.Time) (int64, error)) *MockTokenRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchLastUsed provides a mock function with given fields: tokenID, at
+func (_m *MockTokenRepository) TouchLastUsed(tokenID uuid.UUID, at time.Time) error {
+	ret := _m.Called(tokenID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchLastUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = rf(tokenID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenRepository_TouchLastUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchLastUsed'
+type MockTokenRepository_TouchLastUsed_Call struct {
+	*mock.Call
+}
+
+// TouchLastUsed is a helper method to define mock.On call
+//   - tokenID uuid.UUID
+//   - at time.Time
+func (_e *MockTokenRepository_Expecter) TouchLastUsed(tokenID interface{}, at interface{}) *MockTokenRepository_TouchLastUsed_Call {
+	return &MockTokenRepository_TouchLastUsed_Call{Call: _e.mock.On("TouchLastUsed", tokenID, at)}
+}
+
+func (_c *MockTokenRepository_TouchLastUsed_Call) Run(run func(tokenID uuid.UUID, at time.Time)) *MockTokenRepository_TouchLastUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTokenRepository_TouchLastUsed_Call) Return(_a0 error) *MockTokenRepository_TouchLastUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenRepository_TouchLastUsed_Call) RunAndReturn(run func(uuid.UUID, time.Time) error) *MockTokenRepository_TouchLastUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenRepository creates a new instance of MockTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenRepository {
+	mock := &MockTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,6 +6,8 @@ import (
 	models "github.com/milansax96/movie-terminal-api/internal/models"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -68,29 +70,27 @@ func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(*models.User) er
 	return _c
 }
 
-// FindByGoogleID provides a mock function with given fields: googleID
-func (_m *MockUserRepository) FindByGoogleID(googleID string) (*models.User, error) {
-	ret := _m.Called(googleID)
+// EmailExists provides a mock function with given fields: email
+func (_m *MockUserRepository) EmailExists(email string) (bool, error) {
+	ret := _m.Called(email)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByGoogleID")
+		panic("no return value specified for EmailExists")
 	}
 
-	var r0 *models.User
+	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*models.User, error)); ok {
-		return rf(googleID)
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(email)
 	}
-	if rf, ok := ret.Get(0).(func(string) *models.User); ok {
-		r0 = rf(googleID)
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(email)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.User)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(googleID)
+		r1 = rf(email)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -98,30 +98,30 @@ func (_m *MockUserRepository) FindByGoogleID(googleID string) (*models.User, err
 	return r0, r1
 }
 
-// MockUserRepository_FindByGoogleID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByGoogleID'
-type MockUserRepository_FindByGoogleID_Call struct {
+// MockUserRepository_EmailExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmailExists'
+type MockUserRepository_EmailExists_Call struct {
 	*mock.Call
 }
 
-// FindByGoogleID is a helper method to define mock.On call
-//   - googleID string
-func (_e *MockUserRepository_Expecter) FindByGoogleID(googleID interface{}) *MockUserRepository_FindByGoogleID_Call {
-	return &MockUserRepository_FindByGoogleID_Call{Call: _e.mock.On("FindByGoogleID", googleID)}
+// EmailExists is a helper method to define mock.On call
+//   - email string
+func (_e *MockUserRepository_Expecter) EmailExists(email interface{}) *MockUserRepository_EmailExists_Call {
+	return &MockUserRepository_EmailExists_Call{Call: _e.mock.On("EmailExists", email)}
 }
 
-func (_c *MockUserRepository_FindByGoogleID_Call) Run(run func(googleID string)) *MockUserRepository_FindByGoogleID_Call {
+func (_c *MockUserRepository_EmailExists_Call) Run(run func(email string)) *MockUserRepository_EmailExists_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_FindByGoogleID_Call) Return(_a0 *models.User, _a1 error) *MockUserRepository_FindByGoogleID_Call {
+func (_c *MockUserRepository_EmailExists_Call) Return(_a0 bool, _a1 error) *MockUserRepository_EmailExists_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUserRepository_FindByGoogleID_Call) RunAndReturn(run func(string) (*models.User, error)) *MockUserRepository_FindByGoogleID_Call {
+func (_c *MockUserRepository_EmailExists_Call) RunAndReturn(run func(string) (bool, error)) *MockUserRepository_EmailExists_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -242,6 +242,64 @@ func (_c *MockUserRepository_FindByIDWithStreaming_Call) RunAndReturn(run func(u
 	return _c
 }
 
+// FindDueForDeletion provides a mock function with given fields: now
+func (_m *MockUserRepository) FindDueForDeletion(now time.Time) ([]uuid.UUID, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDueForDeletion")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]uuid.UUID, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []uuid.UUID); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_FindDueForDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDueForDeletion'
+type MockUserRepository_FindDueForDeletion_Call struct {
+	*mock.Call
+}
+
+// FindDueForDeletion is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockUserRepository_Expecter) FindDueForDeletion(now interface{}) *MockUserRepository_FindDueForDeletion_Call {
+	return &MockUserRepository_FindDueForDeletion_Call{Call: _e.mock.On("FindDueForDeletion", now)}
+}
+
+func (_c *MockUserRepository_FindDueForDeletion_Call) Run(run func(now time.Time)) *MockUserRepository_FindDueForDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_FindDueForDeletion_Call) Return(_a0 []uuid.UUID, _a1 error) *MockUserRepository_FindDueForDeletion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_FindDueForDeletion_Call) RunAndReturn(run func(time.Time) ([]uuid.UUID, error)) *MockUserRepository_FindDueForDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindStreamingServicesByIDs provides a mock function with given fields: ids
 func (_m *MockUserRepository) FindStreamingServicesByIDs(ids []int) ([]models.StreamingService, error) {
 	ret := _m.Called(ids)
@@ -300,6 +358,52 @@ func (_c *MockUserRepository_FindStreamingServicesByIDs_Call) RunAndReturn(run f
 	return _c
 }
 
+// Purge provides a mock function with given fields: userID
+func (_m *MockUserRepository) Purge(userID uuid.UUID) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockUserRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockUserRepository_Expecter) Purge(userID interface{}) *MockUserRepository_Purge_Call {
+	return &MockUserRepository_Purge_Call{Call: _e.mock.On("Purge", userID)}
+}
+
+func (_c *MockUserRepository_Purge_Call) Run(run func(userID uuid.UUID)) *MockUserRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Purge_Call) Return(_a0 error) *MockUserRepository_Purge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_Purge_Call) RunAndReturn(run func(uuid.UUID) error) *MockUserRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplaceStreamingServices provides a mock function with given fields: userID, services
 func (_m *MockUserRepository) ReplaceStreamingServices(userID uuid.UUID, services []models.StreamingService) error {
 	ret := _m.Called(userID, services)
@@ -347,6 +451,65 @@ func (_c *MockUserRepository_ReplaceStreamingServices_Call) RunAndReturn(run fun
 	return _c
 }
 
+// SearchAll provides a mock function with given fields: query, limit
+func (_m *MockUserRepository) SearchAll(query string, limit int) ([]models.User, error) {
+	ret := _m.Called(query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAll")
+	}
+
+	var r0 []models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]models.User, error)); ok {
+		return rf(query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []models.User); ok {
+		r0 = rf(query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_SearchAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAll'
+type MockUserRepository_SearchAll_Call struct {
+	*mock.Call
+}
+
+// SearchAll is a helper method to define mock.On call
+//   - query string
+//   - limit int
+func (_e *MockUserRepository_Expecter) SearchAll(query interface{}, limit interface{}) *MockUserRepository_SearchAll_Call {
+	return &MockUserRepository_SearchAll_Call{Call: _e.mock.On("SearchAll", query, limit)}
+}
+
+func (_c *MockUserRepository_SearchAll_Call) Run(run func(query string, limit int)) *MockUserRepository_SearchAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SearchAll_Call) Return(_a0 []models.User, _a1 error) *MockUserRepository_SearchAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_SearchAll_Call) RunAndReturn(run func(string, int) ([]models.User, error)) *MockUserRepository_SearchAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchByUsername provides a mock function with given fields: query, limit
 func (_m *MockUserRepository) SearchByUsername(query string, limit int) ([]models.User, error) {
 	ret := _m.Called(query, limit)
@@ -406,6 +569,252 @@ func (_c *MockUserRepository_SearchByUsername_Call) RunAndReturn(run func(string
 	return _c
 }
 
+// SetBan provides a mock function with given fields: userID, at, reason
+func (_m *MockUserRepository) SetBan(userID uuid.UUID, at *time.Time, reason string) error {
+	ret := _m.Called(userID, at, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, *time.Time, string) error); ok {
+		r0 = rf(userID, at, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_SetBan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBan'
+type MockUserRepository_SetBan_Call struct {
+	*mock.Call
+}
+
+// SetBan is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - at *time.Time
+//   - reason string
+func (_e *MockUserRepository_Expecter) SetBan(userID interface{}, at interface{}, reason interface{}) *MockUserRepository_SetBan_Call {
+	return &MockUserRepository_SetBan_Call{Call: _e.mock.On("SetBan", userID, at, reason)}
+}
+
+func (_c *MockUserRepository_SetBan_Call) Run(run func(userID uuid.UUID, at *time.Time, reason string)) *MockUserRepository_SetBan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(*time.Time), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SetBan_Call) Return(_a0 error) *MockUserRepository_SetBan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_SetBan_Call) RunAndReturn(run func(uuid.UUID, *time.Time, string) error) *MockUserRepository_SetBan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDeleteAfter provides a mock function with given fields: userID, at
+func (_m *MockUserRepository) SetDeleteAfter(userID uuid.UUID, at *time.Time) error {
+	ret := _m.Called(userID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDeleteAfter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, *time.Time) error); ok {
+		r0 = rf(userID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_SetDeleteAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDeleteAfter'
+type MockUserRepository_SetDeleteAfter_Call struct {
+	*mock.Call
+}
+
+// SetDeleteAfter is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - at *time.Time
+func (_e *MockUserRepository_Expecter) SetDeleteAfter(userID interface{}, at interface{}) *MockUserRepository_SetDeleteAfter_Call {
+	return &MockUserRepository_SetDeleteAfter_Call{Call: _e.mock.On("SetDeleteAfter", userID, at)}
+}
+
+func (_c *MockUserRepository_SetDeleteAfter_Call) Run(run func(userID uuid.UUID, at *time.Time)) *MockUserRepository_SetDeleteAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SetDeleteAfter_Call) Return(_a0 error) *MockUserRepository_SetDeleteAfter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_SetDeleteAfter_Call) RunAndReturn(run func(uuid.UUID, *time.Time) error) *MockUserRepository_SetDeleteAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRole provides a mock function with given fields: userID, role
+func (_m *MockUserRepository) SetRole(userID uuid.UUID, role string) error {
+	ret := _m.Called(userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) error); ok {
+		r0 = rf(userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_SetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRole'
+type MockUserRepository_SetRole_Call struct {
+	*mock.Call
+}
+
+// SetRole is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - role string
+func (_e *MockUserRepository_Expecter) SetRole(userID interface{}, role interface{}) *MockUserRepository_SetRole_Call {
+	return &MockUserRepository_SetRole_Call{Call: _e.mock.On("SetRole", userID, role)}
+}
+
+func (_c *MockUserRepository_SetRole_Call) Run(run func(userID uuid.UUID, role string)) *MockUserRepository_SetRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SetRole_Call) Return(_a0 error) *MockUserRepository_SetRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_SetRole_Call) RunAndReturn(run func(uuid.UUID, string) error) *MockUserRepository_SetRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TakenUsernames provides a mock function with given fields: candidates
+func (_m *MockUserRepository) TakenUsernames(candidates []string) ([]string, error) {
+	ret := _m.Called(candidates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakenUsernames")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]string, error)); ok {
+		return rf(candidates)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []string); ok {
+		r0 = rf(candidates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(candidates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_TakenUsernames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakenUsernames'
+type MockUserRepository_TakenUsernames_Call struct {
+	*mock.Call
+}
+
+// TakenUsernames is a helper method to define mock.On call
+//   - candidates []string
+func (_e *MockUserRepository_Expecter) TakenUsernames(candidates interface{}) *MockUserRepository_TakenUsernames_Call {
+	return &MockUserRepository_TakenUsernames_Call{Call: _e.mock.On("TakenUsernames", candidates)}
+}
+
+func (_c *MockUserRepository_TakenUsernames_Call) Run(run func(candidates []string)) *MockUserRepository_TakenUsernames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_TakenUsernames_Call) Return(_a0 []string, _a1 error) *MockUserRepository_TakenUsernames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_TakenUsernames_Call) RunAndReturn(run func([]string) ([]string, error)) *MockUserRepository_TakenUsernames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: user
+func (_m *MockUserRepository) UpdateProfile(user *models.User) error {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.User) error); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockUserRepository_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - user *models.User
+func (_e *MockUserRepository_Expecter) UpdateProfile(user interface{}) *MockUserRepository_UpdateProfile_Call {
+	return &MockUserRepository_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", user)}
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) Run(run func(user *models.User)) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.User))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) Return(_a0 error) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) RunAndReturn(run func(*models.User) error) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProfilePicture provides a mock function with given fields: userID, picture
 func (_m *MockUserRepository) UpdateProfilePicture(userID uuid.UUID, picture string) error {
 	ret := _m.Called(userID, picture)
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	models "github.com/milansax96/movie-terminal-api/internal/models"
+	repository "github.com/milansax96/movie-terminal-api/internal/repository"
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -125,6 +126,65 @@ func (_c *MockWatchlistRepository_Exists_Call) RunAndReturn(run func(uuid.UUID,
 	return _c
 }
 
+// Find provides a mock function with given fields: userID, tmdbID
+func (_m *MockWatchlistRepository) Find(userID uuid.UUID, tmdbID int) (*models.Watchlist, error) {
+	ret := _m.Called(userID, tmdbID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *models.Watchlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) (*models.Watchlist, error)); ok {
+		return rf(userID, tmdbID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) *models.Watchlist); ok {
+		r0 = rf(userID, tmdbID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Watchlist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int) error); ok {
+		r1 = rf(userID, tmdbID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWatchlistRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockWatchlistRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - tmdbID int
+func (_e *MockWatchlistRepository_Expecter) Find(userID interface{}, tmdbID interface{}) *MockWatchlistRepository_Find_Call {
+	return &MockWatchlistRepository_Find_Call{Call: _e.mock.On("Find", userID, tmdbID)}
+}
+
+func (_c *MockWatchlistRepository_Find_Call) Run(run func(userID uuid.UUID, tmdbID int)) *MockWatchlistRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockWatchlistRepository_Find_Call) Return(_a0 *models.Watchlist, _a1 error) *MockWatchlistRepository_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWatchlistRepository_Find_Call) RunAndReturn(run func(uuid.UUID, int) (*models.Watchlist, error)) *MockWatchlistRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByUserID provides a mock function with given fields: userID
 func (_m *MockWatchlistRepository) GetByUserID(userID uuid.UUID) ([]models.Watchlist, error) {
 	ret := _m.Called(userID)
@@ -183,6 +243,98 @@ func (_c *MockWatchlistRepository_GetByUserID_Call) RunAndReturn(run func(uuid.U
 	return _c
 }
 
+// Invalidate provides a mock function with given fields: userID
+func (_m *MockWatchlistRepository) Invalidate(userID uuid.UUID) {
+	_m.Called(userID)
+}
+
+// MockWatchlistRepository_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type MockWatchlistRepository_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockWatchlistRepository_Expecter) Invalidate(userID interface{}) *MockWatchlistRepository_Invalidate_Call {
+	return &MockWatchlistRepository_Invalidate_Call{Call: _e.mock.On("Invalidate", userID)}
+}
+
+func (_c *MockWatchlistRepository_Invalidate_Call) Run(run func(userID uuid.UUID)) *MockWatchlistRepository_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWatchlistRepository_Invalidate_Call) Return() *MockWatchlistRepository_Invalidate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockWatchlistRepository_Invalidate_Call) RunAndReturn(run func(uuid.UUID)) *MockWatchlistRepository_Invalidate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Page provides a mock function with given fields: userID, query
+func (_m *MockWatchlistRepository) Page(userID uuid.UUID, query repository.WatchlistQuery) ([]models.Watchlist, error) {
+	ret := _m.Called(userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Page")
+	}
+
+	var r0 []models.Watchlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, repository.WatchlistQuery) ([]models.Watchlist, error)); ok {
+		return rf(userID, query)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, repository.WatchlistQuery) []models.Watchlist); ok {
+		r0 = rf(userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Watchlist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, repository.WatchlistQuery) error); ok {
+		r1 = rf(userID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWatchlistRepository_Page_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Page'
+type MockWatchlistRepository_Page_Call struct {
+	*mock.Call
+}
+
+// Page is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - query repository.WatchlistQuery
+func (_e *MockWatchlistRepository_Expecter) Page(userID interface{}, query interface{}) *MockWatchlistRepository_Page_Call {
+	return &MockWatchlistRepository_Page_Call{Call: _e.mock.On("Page", userID, query)}
+}
+
+func (_c *MockWatchlistRepository_Page_Call) Run(run func(userID uuid.UUID, query repository.WatchlistQuery)) *MockWatchlistRepository_Page_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(repository.WatchlistQuery))
+	})
+	return _c
+}
+
+func (_c *MockWatchlistRepository_Page_Call) Return(_a0 []models.Watchlist, _a1 error) *MockWatchlistRepository_Page_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWatchlistRepository_Page_Call) RunAndReturn(run func(uuid.UUID, repository.WatchlistQuery) ([]models.Watchlist, error)) *MockWatchlistRepository_Page_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Remove provides a mock function with given fields: userID, tmdbID
 func (_m *MockWatchlistRepository) Remove(userID uuid.UUID, tmdbID int) (int64, error) {
 	ret := _m.Called(userID, tmdbID)
@@ -240,6 +392,170 @@ func (_c *MockWatchlistRepository_Remove_Call) RunAndReturn(run func(uuid.UUID,
 	return _c
 }
 
+// SavedAmong provides a mock function with given fields: userID, tmdbIDs
+func (_m *MockWatchlistRepository) SavedAmong(userID uuid.UUID, tmdbIDs []int) (map[int]bool, error) {
+	ret := _m.Called(userID, tmdbIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavedAmong")
+	}
+
+	var r0 map[int]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, []int) (map[int]bool, error)); ok {
+		return rf(userID, tmdbIDs)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, []int) map[int]bool); ok {
+		r0 = rf(userID, tmdbIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, []int) error); ok {
+		r1 = rf(userID, tmdbIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWatchlistRepository_SavedAmong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavedAmong'
+type MockWatchlistRepository_SavedAmong_Call struct {
+	*mock.Call
+}
+
+// SavedAmong is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - tmdbIDs []int
+func (_e *MockWatchlistRepository_Expecter) SavedAmong(userID interface{}, tmdbIDs interface{}) *MockWatchlistRepository_SavedAmong_Call {
+	return &MockWatchlistRepository_SavedAmong_Call{Call: _e.mock.On("SavedAmong", userID, tmdbIDs)}
+}
+
+func (_c *MockWatchlistRepository_SavedAmong_Call) Run(run func(userID uuid.UUID, tmdbIDs []int)) *MockWatchlistRepository_SavedAmong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockWatchlistRepository_SavedAmong_Call) Return(_a0 map[int]bool, _a1 error) *MockWatchlistRepository_SavedAmong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWatchlistRepository_SavedAmong_Call) RunAndReturn(run func(uuid.UUID, []int) (map[int]bool, error)) *MockWatchlistRepository_SavedAmong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncDetails provides a mock function with given fields: tmdbID, mediaType, details
+func (_m *MockWatchlistRepository) SyncDetails(tmdbID int, mediaType string, details *models.Watchlist) error {
+	ret := _m.Called(tmdbID, mediaType, details)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncDetails")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string, *models.Watchlist) error); ok {
+		r0 = rf(tmdbID, mediaType, details)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWatchlistRepository_SyncDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncDetails'
+type MockWatchlistRepository_SyncDetails_Call struct {
+	*mock.Call
+}
+
+// SyncDetails is a helper method to define mock.On call
+//   - tmdbID int
+//   - mediaType string
+//   - details *models.Watchlist
+func (_e *MockWatchlistRepository_Expecter) SyncDetails(tmdbID interface{}, mediaType interface{}, details interface{}) *MockWatchlistRepository_SyncDetails_Call {
+	return &MockWatchlistRepository_SyncDetails_Call{Call: _e.mock.On("SyncDetails", tmdbID, mediaType, details)}
+}
+
+func (_c *MockWatchlistRepository_SyncDetails_Call) Run(run func(tmdbID int, mediaType string, details *models.Watchlist)) *MockWatchlistRepository_SyncDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(*models.Watchlist))
+	})
+	return _c
+}
+
+func (_c *MockWatchlistRepository_SyncDetails_Call) Return(_a0 error) *MockWatchlistRepository_SyncDetails_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWatchlistRepository_SyncDetails_Call) RunAndReturn(run func(int, string, *models.Watchlist) error) *MockWatchlistRepository_SyncDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsyncedTitles provides a mock function with no fields
+func (_m *MockWatchlistRepository) UnsyncedTitles() ([]models.Watchlist, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsyncedTitles")
+	}
+
+	var r0 []models.Watchlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Watchlist, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Watchlist); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Watchlist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWatchlistRepository_UnsyncedTitles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsyncedTitles'
+type MockWatchlistRepository_UnsyncedTitles_Call struct {
+	*mock.Call
+}
+
+// UnsyncedTitles is a helper method to define mock.On call
+func (_e *MockWatchlistRepository_Expecter) UnsyncedTitles() *MockWatchlistRepository_UnsyncedTitles_Call {
+	return &MockWatchlistRepository_UnsyncedTitles_Call{Call: _e.mock.On("UnsyncedTitles")}
+}
+
+func (_c *MockWatchlistRepository_UnsyncedTitles_Call) Run(run func()) *MockWatchlistRepository_UnsyncedTitles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWatchlistRepository_UnsyncedTitles_Call) Return(_a0 []models.Watchlist, _a1 error) *MockWatchlistRepository_UnsyncedTitles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWatchlistRepository_UnsyncedTitles_Call) RunAndReturn(run func() ([]models.Watchlist, error)) *MockWatchlistRepository_UnsyncedTitles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWatchlistRepository creates a new instance of MockWatchlistRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWatchlistRepository(t interface {
@@ -11,6 +11,7 @@ import (
 type PostRepository interface {
 	Create(post *models.Post) error
 	GetByUserIDs(userIDs []uuid.UUID, limit int) ([]models.Post, error)
+	GetAllByUserID(userID uuid.UUID) ([]models.Post, error)
 }
 
 type gormPostRepository struct {
@@ -32,3 +33,10 @@ func (r *gormPostRepository) GetByUserIDs(userIDs []uuid.UUID, limit int) ([]mod
 
 	return posts, err
 }
+
+func (r *gormPostRepository) GetAllByUserID(userID uuid.UUID) ([]models.Post, error) {
+	var posts []models.Post
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&posts).Error
+
+	return posts, err
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// ProgressRepository defines database operations for TV episode progress.
+type ProgressRepository interface {
+	ListByItem(watchlistID uuid.UUID) ([]models.EpisodeProgress, error)
+	ListByUserID(userID uuid.UUID) ([]models.EpisodeProgress, error)
+	MarkWatched(entries []models.EpisodeProgress) error
+	UnmarkEpisode(watchlistID uuid.UUID, seasonNumber int, episodeNumber int) (int64, error)
+	UnmarkSeason(watchlistID uuid.UUID, seasonNumber int) (int64, error)
+}
+
+type gormProgressRepository struct {
+	db *gorm.DB
+}
+
+// NewProgressRepository creates a new ProgressRepository backed by GORM.
+func NewProgressRepository(db *gorm.DB) ProgressRepository {
+	return &gormProgressRepository{db: db}
+}
+
+func (r *gormProgressRepository) ListByItem(watchlistID uuid.UUID) ([]models.EpisodeProgress, error) {
+	var entries []models.EpisodeProgress
+	err := r.db.Where("watchlist_id = ?", watchlistID).
+		Order("season_number, episode_number").
+		Find(&entries).Error
+
+	return entries, err
+}
+
+func (r *gormProgressRepository) ListByUserID(userID uuid.UUID) ([]models.EpisodeProgress, error) {
+	var entries []models.EpisodeProgress
+	err := r.db.Where("user_id = ?", userID).
+		Order("tmdb_id, season_number, episode_number").
+		Find(&entries).Error
+
+	return entries, err
+}
+
+// MarkWatched records the episodes as watched. Episodes already marked keep
+// their original watched time.
+func (r *gormProgressRepository) MarkWatched(entries []models.EpisodeProgress) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return r.db.Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entries).Error
+}
+
+func (r *gormProgressRepository) UnmarkEpisode(watchlistID uuid.UUID, seasonNumber int, episodeNumber int) (int64, error) {
+	result := r.db.Where("watchlist_id = ? AND season_number = ? AND episode_number = ?", watchlistID, seasonNumber, episodeNumber).
+		Delete(&models.EpisodeProgress{})
+
+	return result.RowsAffected, result.Error
+}
+
+func (r *gormProgressRepository) UnmarkSeason(watchlistID uuid.UUID, seasonNumber int) (int64, error) {
+	result := r.db.Where("watchlist_id = ? AND season_number = ?", watchlistID, seasonNumber).
+		Delete(&models.EpisodeProgress{})
+
+	return result.RowsAffected, result.Error
+}
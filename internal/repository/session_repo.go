@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// SessionRepository defines database operations for login sessions and their refresh tokens.
+type SessionRepository interface {
+	Create(session *models.Session) error
+	FindByID(sessionID uuid.UUID) (*models.Session, error)
+	IsActive(sessionID uuid.UUID, now time.Time) (bool, error)
+	ListActiveByUserID(userID uuid.UUID, now time.Time) ([]models.Session, error)
+	Touch(sessionID uuid.UUID, ip string, at time.Time) error
+	Revoke(sessionID uuid.UUID, at time.Time) error
+	RevokeAllForUser(userID uuid.UUID, at time.Time) (int64, error)
+	CreateRefreshToken(token *models.RefreshToken) error
+	FindRefreshToken(tokenHash string) (*models.RefreshToken, error)
+	MarkRefreshTokenUsed(tokenID uuid.UUID, at time.Time) (bool, error)
+}
+
+type gormSessionRepository struct {
+	db *gorm.DB
+}
+
+// NewSessionRepository creates a new SessionRepository backed by GORM.
+func NewSessionRepository(db *gorm.DB) SessionRepository {
+	return &gormSessionRepository{db: db}
+}
+
+func (r *gormSessionRepository) Create(session *models.Session) error {
+	return r.db.Create(session).Error
+}
+
+func (r *gormSessionRepository) FindByID(sessionID uuid.UUID) (*models.Session, error) {
+	var session models.Session
+	err := r.db.First(&session, "id = ?", sessionID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// IsActive reports whether the session is unrevoked, unexpired, and belongs
+// to a user who is not banned. It runs on every authenticated request.
+func (r *gormSessionRepository) IsActive(sessionID uuid.UUID, now time.Time) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Session{}).
+		Joins("JOIN users ON users.id = sessions.user_id").
+		Where("sessions.id = ? AND sessions.revoked_at IS NULL AND sessions.expires_at > ? AND users.banned_at IS NULL", sessionID, now).
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *gormSessionRepository) ListActiveByUserID(userID uuid.UUID, now time.Time) ([]models.Session, error) {
+	var sessions []models.Session
+	err := r.db.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+		Order("last_seen_at DESC").Find(&sessions).Error
+
+	return sessions, err
+}
+
+func (r *gormSessionRepository) Touch(sessionID uuid.UUID, ip string, at time.Time) error {
+	return r.db.Model(&models.Session{}).Where("id = ?", sessionID).
+		Updates(map[string]interface{}{"last_seen_at": at, "ip_address": ip}).Error
+}
+
+func (r *gormSessionRepository) Revoke(sessionID uuid.UUID, at time.Time) error {
+	return r.db.Model(&models.Session{}).Where("id = ? AND revoked_at IS NULL", sessionID).
+		Update("revoked_at", at).Error
+}
+
+func (r *gormSessionRepository) RevokeAllForUser(userID uuid.UUID, at time.Time) (int64, error) {
+	result := r.db.Model(&models.Session{}).Where("user_id = ? AND revoked_at IS NULL", userID).Update("revoked_at", at)
+
+	return result.RowsAffected, result.Error
+}
+
+func (r *gormSessionRepository) CreateRefreshToken(token *models.RefreshToken) error {
+	return r.db.Create(token).Error
+}
+
+func (r *gormSessionRepository) FindRefreshToken(tokenHash string) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// MarkRefreshTokenUsed flags a token as consumed. It reports false when the
+// token had already been used, which callers treat as a replay.
+func (r *gormSessionRepository) MarkRefreshTokenUsed(tokenID uuid.UUID, at time.Time) (bool, error) {
+	result := r.db.Model(&models.RefreshToken{}).Where("id = ? AND used_at IS NULL", tokenID).Update("used_at", at)
+
+	return result.RowsAffected == 1, result.Error
+}
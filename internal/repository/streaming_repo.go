@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// StreamingServiceRepository defines database operations for the streaming service catalog.
+type StreamingServiceRepository interface {
+	List() ([]models.StreamingService, error)
+	FindByID(id int) (*models.StreamingService, error)
+	Create(service *models.StreamingService) error
+	Update(service *models.StreamingService) error
+	Delete(id int) (int64, error)
+}
+
+type gormStreamingServiceRepository struct {
+	db *gorm.DB
+}
+
+// NewStreamingServiceRepository creates a new StreamingServiceRepository backed by GORM.
+func NewStreamingServiceRepository(db *gorm.DB) StreamingServiceRepository {
+	return &gormStreamingServiceRepository{db: db}
+}
+
+func (r *gormStreamingServiceRepository) List() ([]models.StreamingService, error) {
+	var services []models.StreamingService
+	err := r.db.Order("name ASC").Find(&services).Error
+
+	return services, err
+}
+
+func (r *gormStreamingServiceRepository) FindByID(id int) (*models.StreamingService, error) {
+	var service models.StreamingService
+	err := r.db.First(&service, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &service, nil
+}
+
+func (r *gormStreamingServiceRepository) Create(service *models.StreamingService) error {
+	return r.db.Create(service).Error
+}
+
+// Update saves every field, so clearing the provider ID or regions sticks.
+func (r *gormStreamingServiceRepository) Update(service *models.StreamingService) error {
+	return r.db.Select("*").Updates(service).Error
+}
+
+// Delete removes the service and unsubscribes every user from it.
+func (r *gormStreamingServiceRepository) Delete(id int) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("service_id = ?", id).Delete(&models.UserStreamingService{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&models.StreamingService{}, "id = ?", id)
+		deleted = result.RowsAffected
+
+		return result.Error
+	})
+
+	return deleted, err
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// TokenRepository defines database operations for personal access tokens.
+type TokenRepository interface {
+	Create(token *models.PersonalAccessToken) error
+	ListActiveByUserID(userID uuid.UUID) ([]models.PersonalAccessToken, error)
+	FindByHash(tokenHash string) (*models.PersonalAccessToken, error)
+	Revoke(userID uuid.UUID, tokenID uuid.UUID, at time.Time) (int64, error)
+	TouchLastUsed(tokenID uuid.UUID, at time.Time) error
+}
+
+type gormTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewTokenRepository creates a new TokenRepository backed by GORM.
+func NewTokenRepository(db *gorm.DB) TokenRepository {
+	return &gormTokenRepository{db: db}
+}
+
+func (r *gormTokenRepository) Create(token *models.PersonalAccessToken) error {
+	return r.db.Create(token).Error
+}
+
+func (r *gormTokenRepository) ListActiveByUserID(userID uuid.UUID) ([]models.PersonalAccessToken, error) {
+	var tokens []models.PersonalAccessToken
+	err := r.db.Where("user_id = ? AND revoked_at IS NULL", userID).Order("created_at DESC").Find(&tokens).Error
+
+	return tokens, err
+}
+
+// FindByHash looks up a token by its hash. Tokens belonging to banned users
+// are reported as not found.
+func (r *gormTokenRepository) FindByHash(tokenHash string) (*models.PersonalAccessToken, error) {
+	var token models.PersonalAccessToken
+	err := r.db.Joins("JOIN users ON users.id = personal_access_tokens.user_id").
+		Where("personal_access_tokens.token_hash = ? AND users.banned_at IS NULL", tokenHash).
+		First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *gormTokenRepository) Revoke(userID uuid.UUID, tokenID uuid.UUID, at time.Time) (int64, error) {
+	result := r.db.Model(&models.PersonalAccessToken{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", tokenID, userID).
+		Update("revoked_at", at)
+
+	return result.RowsAffected, result.Error
+}
+
+func (r *gormTokenRepository) TouchLastUsed(tokenID uuid.UUID, at time.Time) error {
+	return r.db.Model(&models.PersonalAccessToken{}).Where("id = ?", tokenID).Update("last_used_at", at).Error
+}
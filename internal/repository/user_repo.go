@@ -2,6 +2,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
@@ -10,33 +12,33 @@ import (
 
 // UserRepository defines database operations for users.
 type UserRepository interface {
-	FindByGoogleID(googleID string) (*models.User, error)
 	Create(user *models.User) error
 	UpdateProfilePicture(userID uuid.UUID, picture string) error
+	UpdateProfile(user *models.User) error
+	EmailExists(email string) (bool, error)
+	TakenUsernames(candidates []string) ([]string, error)
 	FindByIDWithStreaming(userID uuid.UUID) (*models.User, error)
 	FindByID(userID uuid.UUID) (*models.User, error)
 	ReplaceStreamingServices(userID uuid.UUID, services []models.StreamingService) error
 	SearchByUsername(query string, limit int) ([]models.User, error)
+	SearchAll(query string, limit int) ([]models.User, error)
+	SetBan(userID uuid.UUID, at *time.Time, reason string) error
+	SetRole(userID uuid.UUID, role string) error
 	FindStreamingServicesByIDs(ids []int) ([]models.StreamingService, error)
+	SetDeleteAfter(userID uuid.UUID, at *time.Time) error
+	FindDueForDeletion(now time.Time) ([]uuid.UUID, error)
+	Purge(userID uuid.UUID) error
 }
 
 type gormUserRepository struct {
-	db *gorm.DB
+	db                *gorm.DB
+	onWatchlistChange WatchlistHook
 }
 
 // NewUserRepository creates a new UserRepository backed by GORM.
-func NewUserRepository(db *gorm.DB) UserRepository {
-	return &gormUserRepository{db: db}
-}
-
-func (r *gormUserRepository) FindByGoogleID(googleID string) (*models.User, error) {
-	var user models.User
-	err := r.db.Where("google_id = ?", googleID).First(&user).Error
-	if err != nil {
-		return nil, err
-	}
-
-	return &user, nil
+// onWatchlistChange is called when purging a user removes their watchlist.
+func NewUserRepository(db *gorm.DB, onWatchlistChange WatchlistHook) UserRepository {
+	return &gormUserRepository{db: db, onWatchlistChange: onWatchlistChange}
 }
 
 func (r *gormUserRepository) Create(user *models.User) error {
@@ -47,6 +49,25 @@ func (r *gormUserRepository) UpdateProfilePicture(userID uuid.UUID, picture stri
 	return r.db.Model(&models.User{}).Where("id = ?", userID).Update("profile_picture", picture).Error
 }
 
+func (r *gormUserRepository) UpdateProfile(user *models.User) error {
+	return r.db.Model(user).Select("username", "display_name", "bio").Updates(user).Error
+}
+
+func (r *gormUserRepository) EmailExists(email string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.User{}).Where("email = ?", email).Count(&count).Error
+
+	return count > 0, err
+}
+
+// TakenUsernames returns which of the candidate handles already belong to someone.
+func (r *gormUserRepository) TakenUsernames(candidates []string) ([]string, error) {
+	var taken []string
+	err := r.db.Model(&models.User{}).Where("username IN ?", candidates).Pluck("username", &taken).Error
+
+	return taken, err
+}
+
 func (r *gormUserRepository) FindByIDWithStreaming(userID uuid.UUID) (*models.User, error) {
 	var user models.User
 	err := r.db.Preload("StreamingServices").First(&user, "id = ?", userID).Error
@@ -78,14 +99,98 @@ func (r *gormUserRepository) ReplaceStreamingServices(userID uuid.UUID, services
 
 func (r *gormUserRepository) SearchByUsername(query string, limit int) ([]models.User, error) {
 	var users []models.User
-	err := r.db.Where("username ILIKE ?", "%"+query+"%").Limit(limit).Find(&users).Error
+	pattern := "%" + query + "%"
+	err := r.db.Where("(username ILIKE ? OR display_name ILIKE ?) AND delete_after IS NULL AND banned_at IS NULL", pattern, pattern).
+		Limit(limit).Find(&users).Error
+
+	return users, err
+}
+
+// SearchAll matches handle, display name or email across every account,
+// including banned ones and those pending deletion.
+func (r *gormUserRepository) SearchAll(query string, limit int) ([]models.User, error) {
+	var users []models.User
+	pattern := "%" + query + "%"
+	err := r.db.Where("username ILIKE ? OR display_name ILIKE ? OR email ILIKE ?", pattern, pattern, pattern).
+		Order("created_at DESC").Limit(limit).Find(&users).Error
 
 	return users, err
 }
 
+// SetBan bans the user, or lifts the ban when at is nil.
+func (r *gormUserRepository) SetBan(userID uuid.UUID, at *time.Time, reason string) error {
+	return r.db.Model(&models.User{}).Where("id = ?", userID).
+		Updates(map[string]interface{}{"banned_at": at, "ban_reason": reason}).Error
+}
+
+func (r *gormUserRepository) SetRole(userID uuid.UUID, role string) error {
+	return r.db.Model(&models.User{}).Where("id = ?", userID).Update("role", role).Error
+}
+
 func (r *gormUserRepository) FindStreamingServicesByIDs(ids []int) ([]models.StreamingService, error) {
 	var services []models.StreamingService
 	err := r.db.Where("id IN ?", ids).Find(&services).Error
 
 	return services, err
 }
+
+// SetDeleteAfter schedules the account for deletion at the given time, or
+// cancels a pending deletion when at is nil.
+func (r *gormUserRepository) SetDeleteAfter(userID uuid.UUID, at *time.Time) error {
+	return r.db.Model(&models.User{}).Where("id = ?", userID).Update("delete_after", at).Error
+}
+
+func (r *gormUserRepository) FindDueForDeletion(now time.Time) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.Model(&models.User{}).Where("delete_after <= ?", now).Pluck("id", &ids).Error
+
+	return ids, err
+}
+
+// Purge permanently removes the user and everything they own in a single
+// transaction. Friendships are removed from both sides.
+func (r *gormUserRepository) Purge(userID uuid.UUID) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		sessionIDs := tx.Model(&models.Session{}).Select("id").Where("user_id = ?", userID)
+
+		deletes := []func() error{
+			func() error { return tx.Where("session_id IN (?)", sessionIDs).Delete(&models.RefreshToken{}).Error },
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.Session{}).Error },
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.PersonalAccessToken{}).Error },
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.DeviceAuthorization{}).Error },
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.UserIdentity{}).Error },
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.UserStreamingService{}).Error },
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.DiaryEntry{}).Error },
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.List{}).Error },
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.ListMember{}).Error },
+			func() error {
+				return tx.Where("user_id = ? OR member_id = ?", userID, userID).Delete(&models.ListChange{}).Error
+			},
+			func() error {
+				return tx.Model(&models.ListItem{}).Where("added_by = ?", userID).Update("added_by", nil).Error
+			},
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.EpisodeProgress{}).Error },
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.Watchlist{}).Error },
+			func() error {
+				return tx.Where("user_id = ? OR friend_id = ?", userID, userID).Delete(&models.Friendship{}).Error
+			},
+			func() error { return tx.Where("user_id = ?", userID).Delete(&models.Post{}).Error },
+			func() error { return tx.Where("id = ?", userID).Delete(&models.User{}).Error },
+		}
+
+		for _, del := range deletes {
+			if err := del(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.onWatchlistChange(userID)
+
+	return nil
+}
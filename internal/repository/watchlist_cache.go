@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	cache "github.com/patrickmn/go-cache"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// Membership answers are kept briefly. Add and Remove drop the user's
+// answers straight away, as does Invalidate for writes made by other
+// repositories through their WatchlistHook.
+const (
+	membershipTTL     = time.Minute
+	membershipCleanup = 5 * time.Minute
+)
+
+// membership holds the watchlist answers already looked up for one user.
+type membership struct {
+	mu    sync.Mutex
+	saved map[int]bool
+}
+
+type cachedWatchlistRepository struct {
+	WatchlistRepository
+	store *cache.Cache
+}
+
+// NewCachedWatchlistRepository returns a WatchlistRepository that caches
+// SavedAmong answers per user in memory and otherwise defers to inner. Exists
+// is left uncached: it is a single lookup on the (user_id, tmdb_id) index.
+//
+// The cache is local to the process and only sees writes made through it or
+// reported to Invalidate, so it assumes a single API instance. With more
+// than one, another instance's writes show up once membershipTTL passes.
+func NewCachedWatchlistRepository(inner WatchlistRepository) WatchlistRepository {
+	return &cachedWatchlistRepository{
+		WatchlistRepository: inner,
+		store:               cache.New(membershipTTL, membershipCleanup),
+	}
+}
+
+func (r *cachedWatchlistRepository) Add(item *models.Watchlist) error {
+	err := r.WatchlistRepository.Add(item)
+	r.Invalidate(item.UserID)
+
+	return err
+}
+
+func (r *cachedWatchlistRepository) Remove(userID uuid.UUID, tmdbID int) (int64, error) {
+	rows, err := r.WatchlistRepository.Remove(userID, tmdbID)
+	r.Invalidate(userID)
+
+	return rows, err
+}
+
+func (r *cachedWatchlistRepository) Invalidate(userID uuid.UUID) {
+	r.store.Delete(userID.String())
+	r.WatchlistRepository.Invalidate(userID)
+}
+
+// SavedAmong answers from the cache where it can and looks up the rest in one
+// query. Answers for a user dropped while the query runs are not kept.
+func (r *cachedWatchlistRepository) SavedAmong(userID uuid.UUID, tmdbIDs []int) (map[int]bool, error) {
+	m := r.membership(userID)
+	saved := make(map[int]bool)
+
+	var missing []int
+	m.mu.Lock()
+	for _, id := range tmdbIDs {
+		known, ok := m.saved[id]
+		switch {
+		case !ok:
+			missing = append(missing, id)
+		case known:
+			saved[id] = true
+		}
+	}
+	m.mu.Unlock()
+
+	if len(missing) == 0 {
+		return saved, nil
+	}
+
+	found, err := r.WatchlistRepository.SavedAmong(userID, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	for _, id := range missing {
+		m.saved[id] = found[id]
+		if found[id] {
+			saved[id] = true
+		}
+	}
+	m.mu.Unlock()
+
+	return saved, nil
+}
+
+// membership returns the user's cached answers, starting an empty set when
+// there are none.
+func (r *cachedWatchlistRepository) membership(userID uuid.UUID) *membership {
+	key := userID.String()
+	if cached, found := r.store.Get(key); found {
+		return cached.(*membership)
+	}
+
+	m := &membership{saved: make(map[int]bool)}
+	if err := r.store.Add(key, m, cache.DefaultExpiration); err != nil {
+		// Another request started the set first.
+		if cached, found := r.store.Get(key); found {
+			return cached.(*membership)
+		}
+	}
+
+	return m
+}
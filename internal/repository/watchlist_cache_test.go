@@ -0,0 +1,139 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+	repoMocks "github.com/milansax96/movie-terminal-api/internal/repository/mocks"
+)
+
+func newCachedWatchlist(t *testing.T) (repository.WatchlistRepository, *repoMocks.MockWatchlistRepository) {
+	t.Helper()
+
+	inner := repoMocks.NewMockWatchlistRepository(t)
+
+	return repository.NewCachedWatchlistRepository(inner), inner
+}
+
+func TestSavedAmong_CacheHit(t *testing.T) {
+	repo, inner := newCachedWatchlist(t)
+	userID := uuid.New()
+	inner.On("SavedAmong", userID, []int{550, 680}).Return(map[int]bool{550: true}, nil).Once()
+
+	first, err := repo.SavedAmong(userID, []int{550, 680})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]bool{550: true}, first)
+
+	second, err := repo.SavedAmong(userID, []int{680, 550})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]bool{550: true}, second)
+
+	inner.AssertNumberOfCalls(t, "SavedAmong", 1)
+}
+
+func TestSavedAmong_LooksUpOnlyUnknownIDs(t *testing.T) {
+	repo, inner := newCachedWatchlist(t)
+	userID := uuid.New()
+	inner.On("SavedAmong", userID, []int{550}).Return(map[int]bool{550: true}, nil).Once()
+	inner.On("SavedAmong", userID, []int{680}).Return(map[int]bool{}, nil).Once()
+
+	_, err := repo.SavedAmong(userID, []int{550})
+	require.NoError(t, err)
+
+	saved, err := repo.SavedAmong(userID, []int{550, 680})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]bool{550: true}, saved)
+}
+
+func TestSavedAmong_UsersDontCollide(t *testing.T) {
+	repo, inner := newCachedWatchlist(t)
+	alice, bob := uuid.New(), uuid.New()
+	inner.On("SavedAmong", alice, []int{550}).Return(map[int]bool{550: true}, nil).Once()
+	inner.On("SavedAmong", bob, []int{550}).Return(map[int]bool{}, nil).Once()
+
+	saved, err := repo.SavedAmong(alice, []int{550})
+	require.NoError(t, err)
+	assert.True(t, saved[550])
+
+	saved, err = repo.SavedAmong(bob, []int{550})
+	require.NoError(t, err)
+	assert.False(t, saved[550])
+}
+
+func TestSavedAmong_ErrorNotCached(t *testing.T) {
+	repo, inner := newCachedWatchlist(t)
+	userID := uuid.New()
+	inner.On("SavedAmong", userID, []int{550}).Return(nil, errors.New("db down")).Once()
+	inner.On("SavedAmong", userID, []int{550}).Return(map[int]bool{550: true}, nil).Once()
+
+	_, err := repo.SavedAmong(userID, []int{550})
+	assert.Error(t, err)
+
+	saved, err := repo.SavedAmong(userID, []int{550})
+	require.NoError(t, err)
+	assert.True(t, saved[550])
+}
+
+func TestSavedAmong_InvalidatedOnWrite(t *testing.T) {
+	tests := map[string]struct {
+		write  func(repository.WatchlistRepository, *repoMocks.MockWatchlistRepository, uuid.UUID)
+		before map[int]bool
+		after  map[int]bool
+	}{
+		"add": {func(repo repository.WatchlistRepository, inner *repoMocks.MockWatchlistRepository, userID uuid.UUID) {
+			inner.On("Add", mock.AnythingOfType("*models.Watchlist")).Return(nil)
+			inner.On("Invalidate", userID).Return()
+			assert.NoError(t, repo.Add(&models.Watchlist{UserID: userID, TMDBId: 550}))
+		}, map[int]bool{}, map[int]bool{550: true}},
+		"remove": {func(repo repository.WatchlistRepository, inner *repoMocks.MockWatchlistRepository, userID uuid.UUID) {
+			inner.On("Remove", userID, 550).Return(int64(1), nil)
+			inner.On("Invalidate", userID).Return()
+			_, err := repo.Remove(userID, 550)
+			assert.NoError(t, err)
+		}, map[int]bool{550: true}, map[int]bool{}},
+		"removed elsewhere": {func(repo repository.WatchlistRepository, inner *repoMocks.MockWatchlistRepository, userID uuid.UUID) {
+			inner.On("Invalidate", userID).Return()
+			repo.Invalidate(userID)
+		}, map[int]bool{550: true}, map[int]bool{}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo, inner := newCachedWatchlist(t)
+			userID := uuid.New()
+			inner.On("SavedAmong", userID, []int{550}).Return(tt.before, nil).Once()
+			inner.On("SavedAmong", userID, []int{550}).Return(tt.after, nil).Once()
+
+			saved, err := repo.SavedAmong(userID, []int{550})
+			require.NoError(t, err)
+			assert.Equal(t, tt.before[550], saved[550])
+
+			tt.write(repo, inner, userID)
+
+			saved, err = repo.SavedAmong(userID, []int{550})
+			require.NoError(t, err)
+			assert.Equal(t, tt.after[550], saved[550])
+		})
+	}
+}
+
+func TestExists_NotCached(t *testing.T) {
+	repo, inner := newCachedWatchlist(t)
+	userID := uuid.New()
+	inner.On("SavedAmong", userID, []int{550}).Return(map[int]bool{550: true}, nil).Once()
+	inner.On("Exists", userID, 550).Return(false, nil).Once()
+
+	_, err := repo.SavedAmong(userID, []int{550})
+	require.NoError(t, err)
+
+	saved, err := repo.Exists(userID, 550)
+	require.NoError(t, err)
+	assert.False(t, saved, "Exists always asks the database")
+}
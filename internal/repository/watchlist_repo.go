@@ -1,8 +1,11 @@
 package repository
 
 import (
+	"fmt"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/milansax96/movie-terminal-api/internal/models"
 )
@@ -11,8 +14,62 @@ import (
 type WatchlistRepository interface {
 	Add(item *models.Watchlist) error
 	GetByUserID(userID uuid.UUID) ([]models.Watchlist, error)
+	Page(userID uuid.UUID, query WatchlistQuery) ([]models.Watchlist, error)
+	Find(userID uuid.UUID, tmdbID int) (*models.Watchlist, error)
 	Remove(userID uuid.UUID, tmdbID int) (int64, error)
 	Exists(userID uuid.UUID, tmdbID int) (bool, error)
+	// SavedAmong reports which of tmdbIDs are on the user's watchlist.
+	SavedAmong(userID uuid.UUID, tmdbIDs []int) (map[int]bool, error)
+	// Invalidate drops anything cached about the user's watchlist after
+	// another repository changed it. It is the WatchlistHook those
+	// repositories are given.
+	Invalidate(userID uuid.UUID)
+	// UnsyncedTitles lists each title saved without its details once,
+	// whoever saved it.
+	UnsyncedTitles() ([]models.Watchlist, error)
+	// SyncDetails stores the release date, rating and genres of details on
+	// every unsynced item of the title.
+	SyncDetails(tmdbID int, mediaType string, details *models.Watchlist) error
+}
+
+// WatchlistHook is called with the user whose watchlist a repository other
+// than WatchlistRepository changed, once the change is committed.
+type WatchlistHook func(userID uuid.UUID)
+
+// Watchlist columns a page can be sorted by. Ties are broken by ID.
+const (
+	WatchlistByAdded   = "added_at"
+	WatchlistByTitle   = "title"
+	WatchlistByRelease = "release_date"
+	WatchlistByRating  = "vote_average"
+)
+
+// watchlistSortKeys reads the value of each sort column from an item.
+var watchlistSortKeys = map[string]func(item *models.Watchlist) any{
+	WatchlistByAdded:   func(item *models.Watchlist) any { return item.AddedAt },
+	WatchlistByTitle:   func(item *models.Watchlist) any { return item.Title },
+	WatchlistByRelease: func(item *models.Watchlist) any { return item.ReleaseDate },
+	WatchlistByRating:  func(item *models.Watchlist) any { return item.VoteAverage },
+}
+
+// WatchlistQuery selects a page of a user's watchlist. Zero values are unset.
+type WatchlistQuery struct {
+	// SortBy is one of the WatchlistBy columns.
+	SortBy    string
+	Ascending bool
+
+	MediaType string
+	GenreID   int
+
+	// ProviderIDs limits the page to titles streaming in Region on one of
+	// the TMDB providers.
+	ProviderIDs []int
+	Region      string
+
+	// After is the last item of the previous page. Without a Limit every
+	// matching item is returned.
+	After *models.Watchlist
+	Limit int
 }
 
 type gormWatchlistRepository struct {
@@ -35,6 +92,59 @@ func (r *gormWatchlistRepository) GetByUserID(userID uuid.UUID) ([]models.Watchl
 	return items, err
 }
 
+// Page returns up to query.Limit items of the user's watchlist in sort order,
+// continuing after query.After.
+func (r *gormWatchlistRepository) Page(userID uuid.UUID, query WatchlistQuery) ([]models.Watchlist, error) {
+	sortKey, ok := watchlistSortKeys[query.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown watchlist sort %q", query.SortBy)
+	}
+
+	dir, cmp := "DESC", "<"
+	if query.Ascending {
+		dir, cmp = "ASC", ">"
+	}
+
+	db := r.db.Where("user_id = ?", userID)
+
+	if query.MediaType != "" {
+		db = db.Where("media_type = ?", query.MediaType)
+	}
+
+	if query.GenreID != 0 {
+		db = db.Where("EXISTS (SELECT 1 FROM watchlist_genres WHERE watchlist_genres.watchlist_id = watchlists.id AND watchlist_genres.genre_id = ?)", query.GenreID)
+	}
+
+	if len(query.ProviderIDs) > 0 {
+		db = db.Where(`EXISTS (SELECT 1 FROM title_availabilities
+			WHERE title_availabilities.tmdb_id = watchlists.tmdb_id AND title_availabilities.media_type = watchlists.media_type
+			AND title_availabilities.region = ? AND title_availabilities.provider_id IN ?)`, query.Region, query.ProviderIDs)
+	}
+
+	if query.After != nil {
+		db = db.Where(fmt.Sprintf("(%s, id) %s (?, ?)", query.SortBy, cmp), sortKey(query.After), query.After.ID)
+	}
+
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+
+	var items []models.Watchlist
+	err := db.Order(fmt.Sprintf("%s %s, id %s", query.SortBy, dir, dir)).Find(&items).Error
+
+	return items, err
+}
+
+func (r *gormWatchlistRepository) Find(userID uuid.UUID, tmdbID int) (*models.Watchlist, error) {
+	var item models.Watchlist
+	err := r.db.Where("user_id = ? AND tmdb_id = ?", userID, tmdbID).First(&item).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
 func (r *gormWatchlistRepository) Remove(userID uuid.UUID, tmdbID int) (int64, error) {
 	result := r.db.Where("user_id = ? AND tmdb_id = ?", userID, tmdbID).Delete(&models.Watchlist{})
 
@@ -47,3 +157,70 @@ func (r *gormWatchlistRepository) Exists(userID uuid.UUID, tmdbID int) (bool, er
 
 	return count > 0, err
 }
+
+func (r *gormWatchlistRepository) UnsyncedTitles() ([]models.Watchlist, error) {
+	var titles []models.Watchlist
+	err := r.db.Model(&models.Watchlist{}).
+		Distinct("tmdb_id", "media_type").
+		Where("details_synced = ?", false).
+		Find(&titles).Error
+
+	return titles, err
+}
+
+func (r *gormWatchlistRepository) SyncDetails(tmdbID int, mediaType string, details *models.Watchlist) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var ids []uuid.UUID
+		err := tx.Model(&models.Watchlist{}).
+			Where("tmdb_id = ? AND media_type = ? AND details_synced = ?", tmdbID, mediaType, false).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		err = tx.Model(&models.Watchlist{}).Where("id IN ?", ids).Updates(map[string]any{
+			"release_date":   details.ReleaseDate,
+			"vote_average":   details.VoteAverage,
+			"details_synced": true,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		var genres []models.WatchlistGenre
+		for _, id := range ids {
+			for _, g := range details.Genres {
+				genres = append(genres, models.WatchlistGenre{WatchlistID: id, GenreID: g.GenreID})
+			}
+		}
+		if len(genres) == 0 {
+			return nil
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&genres).Error
+	})
+}
+
+// Invalidate does nothing: nothing is cached.
+func (r *gormWatchlistRepository) Invalidate(_ uuid.UUID) {}
+
+// SavedAmong looks the IDs up in one query on the (user_id, tmdb_id) index
+// rather than loading the whole watchlist.
+func (r *gormWatchlistRepository) SavedAmong(userID uuid.UUID, tmdbIDs []int) (map[int]bool, error) {
+	saved := make(map[int]bool)
+	if len(tmdbIDs) == 0 {
+		return saved, nil
+	}
+
+	var ids []int
+	err := r.db.Model(&models.Watchlist{}).Where("user_id = ? AND tmdb_id IN ?", userID, tmdbIDs).Pluck("tmdb_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		saved[id] = true
+	}
+
+	return saved, nil
+}
@@ -0,0 +1,145 @@
+package repository_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+)
+
+// openTestDB connects to the PostgreSQL database named by TEST_DATABASE_DSN,
+// skipping when it is unset. The database should be a scratch one: the
+// watchlist and diary tables are migrated into it.
+func openTestDB(tb testing.TB) *gorm.DB {
+	tb.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		tb.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true, Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(tb, err)
+	require.NoError(tb, db.AutoMigrate(&models.Watchlist{}, &models.WatchlistGenre{}, &models.DiaryEntry{}))
+
+	return db
+}
+
+// seedWatchlist saves size titles, with even TMDB IDs, for a new user and
+// removes them afterwards.
+func seedWatchlist(tb testing.TB, db *gorm.DB, size int) uuid.UUID {
+	tb.Helper()
+
+	userID := uuid.New()
+	items := make([]models.Watchlist, size)
+	for i := range items {
+		items[i] = models.Watchlist{
+			UserID: userID, TMDBId: i * 2, Title: fmt.Sprintf("Title %d", i), MediaType: "movie",
+			PosterPath: "/poster.jpg", BackdropPath: "/backdrop.jpg", ReleaseDate: "2001-01-01", DetailsSynced: true,
+		}
+	}
+	require.NoError(tb, db.CreateInBatches(items, 500).Error)
+
+	tb.Cleanup(func() {
+		db.Where("user_id = ?", userID).Delete(&models.Watchlist{})
+	})
+
+	return userID
+}
+
+// benchmarkPage is a page of 20 results, half of them saved.
+var benchmarkPage = func() []int {
+	ids := make([]int, 20)
+	for i := range ids {
+		ids[i] = i
+	}
+
+	return ids
+}()
+
+// BenchmarkWatchlistMembership compares flagging a page of results by
+// loading the whole watchlist, as enrichment used to, with SavedAmong and
+// with SavedAmong behind the membership cache.
+func BenchmarkWatchlistMembership(b *testing.B) {
+	db := openTestDB(b)
+	repo := repository.NewWatchlistRepository(db)
+
+	for _, size := range []int{100, 1000, 5000} {
+		userID := seedWatchlist(b, db, size)
+
+		b.Run(fmt.Sprintf("load_all/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				items, err := repo.GetByUserID(userID)
+				require.NoError(b, err)
+
+				saved := make(map[int]struct{}, len(items))
+				for _, item := range items {
+					saved[item.TMDBId] = struct{}{}
+				}
+				for _, id := range benchmarkPage {
+					_, _ = saved[id]
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("saved_among/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				_, err := repo.SavedAmong(userID, benchmarkPage)
+				require.NoError(b, err)
+			}
+		})
+
+		b.Run(fmt.Sprintf("cached/%d", size), func(b *testing.B) {
+			cached := repository.NewCachedWatchlistRepository(repo)
+			for b.Loop() {
+				_, err := cached.SavedAmong(userID, benchmarkPage)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func TestSavedAmong(t *testing.T) {
+	db := openTestDB(t)
+	repo := repository.NewWatchlistRepository(db)
+	userID := seedWatchlist(t, db, 10)
+
+	saved, err := repo.SavedAmong(userID, []int{0, 1, 2, 18, 20})
+	require.NoError(t, err)
+	require.Equal(t, map[int]bool{0: true, 2: true, 18: true}, saved)
+
+	saved, err = repo.SavedAmong(uuid.New(), []int{0, 2})
+	require.NoError(t, err)
+	require.Empty(t, saved, "other users' watchlists don't count")
+}
+
+func TestDiaryCreate_DropsCachedMembership(t *testing.T) {
+	db := openTestDB(t)
+	watchlist := repository.NewCachedWatchlistRepository(repository.NewWatchlistRepository(db))
+	diary := repository.NewDiaryRepository(db, watchlist.Invalidate)
+	userID := seedWatchlist(t, db, 2)
+	t.Cleanup(func() {
+		db.Where("user_id = ?", userID).Delete(&models.DiaryEntry{})
+	})
+
+	saved, err := watchlist.SavedAmong(userID, []int{2})
+	require.NoError(t, err)
+	require.True(t, saved[2])
+
+	removed, err := diary.Create(&models.DiaryEntry{UserID: userID, TMDBId: 2, MediaType: "movie", WatchedOn: time.Now()}, true)
+	require.NoError(t, err)
+	require.True(t, removed)
+
+	saved, err = watchlist.SavedAmong(userID, []int{2})
+	require.NoError(t, err)
+	require.False(t, saved[2], "the diary's removal reaches the cache")
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+)
+
+// accountDeletionGrace is how long a deleted account can still be restored.
+const accountDeletionGrace = 7 * 24 * time.Hour
+
+// AccountService handles personal data export and account deletion.
+type AccountService struct {
+	userRepo      repository.UserRepository
+	identityRepo  repository.IdentityRepository
+	watchlistRepo repository.WatchlistRepository
+	friendRepo    repository.FriendshipRepository
+	postRepo      repository.PostRepository
+	progressRepo  repository.ProgressRepository
+	diaryRepo     repository.DiaryRepository
+	listRepo      repository.ListRepository
+}
+
+// NewAccountService creates a new AccountService.
+func NewAccountService(userRepo repository.UserRepository, identityRepo repository.IdentityRepository, watchlistRepo repository.WatchlistRepository, friendRepo repository.FriendshipRepository, postRepo repository.PostRepository, progressRepo repository.ProgressRepository, diaryRepo repository.DiaryRepository, listRepo repository.ListRepository) *AccountService {
+	return &AccountService{
+		userRepo:      userRepo,
+		identityRepo:  identityRepo,
+		watchlistRepo: watchlistRepo,
+		friendRepo:    friendRepo,
+		postRepo:      postRepo,
+		progressRepo:  progressRepo,
+		diaryRepo:     diaryRepo,
+		listRepo:      listRepo,
+	}
+}
+
+// AccountExport is everything stored about a user. The profile includes
+// their streaming services.
+type AccountExport struct {
+	ExportedAt  time.Time                `json:"exported_at"`
+	Profile     *models.User             `json:"profile"`
+	Identities  []models.UserIdentity    `json:"identities"`
+	Watchlist   []models.Watchlist       `json:"watchlist"`
+	Friendships []models.Friendship      `json:"friendships"`
+	Posts       []models.Post            `json:"posts"`
+	Progress    []models.EpisodeProgress `json:"episode_progress"`
+	Diary       []models.DiaryEntry      `json:"diary"`
+	Lists       []models.List            `json:"lists"`
+}
+
+// Export gathers the user's personal data.
+func (s *AccountService) Export(userID uuid.UUID) (*AccountExport, error) {
+	profile, err := s.userRepo.FindByIDWithStreaming(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	identities, err := s.identityRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	watchlist, err := s.watchlistRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	friendships, err := s.friendRepo.GetAllByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.postRepo.GetAllByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.progressRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	diary, err := s.diaryRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := s.listRepo.ListWithItems(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountExport{
+		ExportedAt:  time.Now().UTC(),
+		Profile:     profile,
+		Identities:  identities,
+		Watchlist:   watchlist,
+		Friendships: friendships,
+		Posts:       posts,
+		Progress:    progress,
+		Diary:       diary,
+		Lists:       lists,
+	}, nil
+}
+
+// RequestDeletion schedules the account for permanent deletion after the
+// grace period and returns when that will happen. Asking again keeps the
+// original schedule.
+func (s *AccountService) RequestDeletion(userID uuid.UUID) (time.Time, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, ErrNotFound
+	} else if err != nil {
+		return time.Time{}, err
+	}
+
+	if user.DeleteAfter != nil {
+		return *user.DeleteAfter, nil
+	}
+
+	deleteAfter := time.Now().Add(accountDeletionGrace)
+	if err := s.userRepo.SetDeleteAfter(userID, &deleteAfter); err != nil {
+		return time.Time{}, err
+	}
+
+	return deleteAfter, nil
+}
+
+// CancelDeletion restores an account that is pending deletion.
+func (s *AccountService) CancelDeletion(userID uuid.UUID) error {
+	return s.userRepo.SetDeleteAfter(userID, nil)
+}
+
+// PurgeDue permanently deletes every account whose grace period ended before
+// now and reports how many were removed. A failure on one account does not
+// stop the others.
+func (s *AccountService) PurgeDue(now time.Time) (int, error) {
+	ids, err := s.userRepo.FindDueForDeletion(now)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	var errs []error
+	for _, id := range ids {
+		if err := s.userRepo.Purge(id); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+		purged++
+	}
+
+	return purged, errors.Join(errs...)
+}
+
+// RunPurge calls PurgeDue every interval until ctx is cancelled.
+func (s *AccountService) RunPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			purged, err := s.PurgeDue(now)
+			if err != nil {
+				log.Printf("Account purge failed: %v", err)
+			}
+
+			if purged > 0 {
+				log.Printf("Purged %d deleted accounts", purged)
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+func TestExport(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	env.Users.FindsUser(userID, &models.User{ID: userID, StreamingServices: []models.StreamingService{{Slug: "netflix"}}})
+	env.Identities.ListsIdentities(userID, []models.UserIdentity{{Provider: "google"}})
+	env.Watchlist.ReturnsWatchlist(userID, []models.Watchlist{{TMDBId: 550}})
+	env.Friends.On("GetAllByUserID", userID).Return([]models.Friendship{{Status: "pending"}}, nil)
+	env.Posts.On("GetAllByUserID", userID).Return([]models.Post{{Blurb: "great"}}, nil)
+	env.Progress.ReturnsForUser(userID, []models.EpisodeProgress{{TMDBId: 1399, SeasonNumber: 1, EpisodeNumber: 1}})
+	env.Diary.ReturnsForUser(userID, []models.DiaryEntry{{TMDBId: 550, Notes: "still holds up"}})
+	env.Lists.On("ListWithItems", userID).Return([]models.List{{Title: "Halloween 2026", Items: []models.ListItem{{TMDBId: 948}}}}, nil)
+
+	export, err := env.AccountService().Export(userID)
+	require.NoError(t, err)
+	assert.Equal(t, "netflix", export.Profile.StreamingServices[0].Slug)
+	assert.Len(t, export.Identities, 1)
+	assert.Len(t, export.Watchlist, 1)
+	assert.Len(t, export.Friendships, 1)
+	assert.Len(t, export.Posts, 1)
+	assert.Len(t, export.Progress, 1)
+	assert.Len(t, export.Diary, 1)
+	assert.Len(t, export.Lists[0].Items, 1)
+}
+
+func TestRequestDeletion(t *testing.T) {
+	scheduled := time.Now().Add(48 * time.Hour)
+
+	tests := map[string]struct {
+		user  *models.User
+		setup func(*TestEnv, uuid.UUID)
+		want  func(*testing.T, time.Time)
+	}{
+		"schedules after grace period": {&models.User{}, func(env *TestEnv, userID uuid.UUID) {
+			env.Users.On("SetDeleteAfter", userID, mock.AnythingOfType("*time.Time")).Return(nil)
+		}, func(t *testing.T, at time.Time) {
+			assert.WithinDuration(t, time.Now().Add(accountDeletionGrace), at, time.Minute)
+		}},
+		"already pending keeps schedule": {&models.User{DeleteAfter: &scheduled}, func(_ *TestEnv, _ uuid.UUID) {}, func(t *testing.T, at time.Time) {
+			assert.Equal(t, scheduled, at)
+		}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			userID := uuid.New()
+			tt.user.ID = userID
+			env.Users.FindsByID(userID, tt.user)
+			tt.setup(env, userID)
+
+			at, err := env.AccountService().RequestDeletion(userID)
+			require.NoError(t, err)
+			tt.want(t, at)
+		})
+	}
+}
+
+func TestCancelDeletion(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	env.Users.On("SetDeleteAfter", userID, (*time.Time)(nil)).Return(nil)
+
+	require.NoError(t, env.AccountService().CancelDeletion(userID))
+}
+
+func TestPurgeDue(t *testing.T) {
+	env := newTestEnv(t)
+	now := time.Now()
+	ok1, failing, ok2 := uuid.New(), uuid.New(), uuid.New()
+	env.Users.On("FindDueForDeletion", now).Return([]uuid.UUID{ok1, failing, ok2}, nil)
+	env.Users.On("Purge", ok1).Return(nil)
+	env.Users.On("Purge", failing).Return(errors.New("db down"))
+	env.Users.On("Purge", ok2).Return(nil)
+
+	purged, err := env.AccountService().PurgeDue(now)
+	assert.Error(t, err)
+	assert.Equal(t, 2, purged)
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+)
+
+// adminSearchLimit caps the number of users returned by an admin search.
+const adminSearchLimit = 50
+
+// AdminService handles user moderation for administrators.
+type AdminService struct {
+	userRepo    repository.UserRepository
+	sessionRepo repository.SessionRepository
+}
+
+// NewAdminService creates a new AdminService.
+func NewAdminService(userRepo repository.UserRepository, sessionRepo repository.SessionRepository) *AdminService {
+	return &AdminService{userRepo: userRepo, sessionRepo: sessionRepo}
+}
+
+// AdminUserView is a user as seen by an administrator, including moderation
+// state and active sessions that are hidden from everyone else.
+type AdminUserView struct {
+	*models.User
+	BannedAt  *time.Time       `json:"banned_at,omitempty"`
+	BanReason string           `json:"ban_reason,omitempty"`
+	Sessions  []models.Session `json:"sessions"`
+}
+
+// SearchUsers finds users by handle, display name or email, including banned
+// users and those pending deletion.
+func (s *AdminService) SearchUsers(query string) ([]models.User, error) {
+	return s.userRepo.SearchAll(strings.TrimSpace(query), adminSearchLimit)
+}
+
+// GetUser returns a user with their moderation state and active sessions.
+func (s *AdminService) GetUser(userID uuid.UUID) (*AdminUserView, error) {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.sessionRepo.ListActiveByUserID(userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdminUserView{
+		User:      user,
+		BannedAt:  user.BannedAt,
+		BanReason: user.BanReason,
+		Sessions:  sessions,
+	}, nil
+}
+
+// Ban blocks the user from logging in and revokes all of their sessions.
+// Admins cannot ban themselves or other admins.
+func (s *AdminService) Ban(adminID uuid.UUID, userID uuid.UUID, reason string) error {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if userID == adminID || user.Role == models.RoleAdmin {
+		return ErrProtectedUser
+	}
+
+	now := time.Now()
+	if err := s.userRepo.SetBan(userID, &now, strings.TrimSpace(reason)); err != nil {
+		return err
+	}
+
+	if _, err := s.sessionRepo.RevokeAllForUser(userID, now); err != nil {
+		return err
+	}
+
+	log.Printf("admin %s banned user %s", adminID, userID)
+
+	return nil
+}
+
+// Unban lifts a ban. The user has to log in again since their sessions
+// were revoked when they were banned.
+func (s *AdminService) Unban(adminID uuid.UUID, userID uuid.UUID) error {
+	if _, err := s.findUser(userID); err != nil {
+		return err
+	}
+
+	if err := s.userRepo.SetBan(userID, nil, ""); err != nil {
+		return err
+	}
+
+	log.Printf("admin %s unbanned user %s", adminID, userID)
+
+	return nil
+}
+
+// RevokeSessions signs the user out everywhere and returns how many sessions were revoked.
+func (s *AdminService) RevokeSessions(adminID uuid.UUID, userID uuid.UUID) (int64, error) {
+	if _, err := s.findUser(userID); err != nil {
+		return 0, err
+	}
+
+	revoked, err := s.sessionRepo.RevokeAllForUser(userID, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	log.Printf("admin %s revoked %d sessions of user %s", adminID, revoked, userID)
+
+	return revoked, nil
+}
+
+// SetRole changes the user's role. Admins cannot change their own role, so
+// there is always at least one admin left. Roles are carried in access
+// tokens, so a demoted user's sessions are revoked.
+func (s *AdminService) SetRole(adminID uuid.UUID, userID uuid.UUID, role string) (*models.User, error) {
+	if !slices.Contains(models.Roles, role) {
+		verr := &ValidationError{}
+		verr.add("role", "must be one of "+strings.Join(models.Roles, ", "))
+
+		return nil, verr
+	}
+
+	user, err := s.findUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID == adminID {
+		return nil, ErrProtectedUser
+	}
+
+	if user.Role == role {
+		return user, nil
+	}
+
+	if err := s.userRepo.SetRole(userID, role); err != nil {
+		return nil, err
+	}
+
+	if user.Role == models.RoleAdmin {
+		if _, err := s.sessionRepo.RevokeAllForUser(userID, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("admin %s changed role of user %s from %s to %s", adminID, userID, user.Role, role)
+	user.Role = role
+
+	return user, nil
+}
+
+func (s *AdminService) findUser(userID uuid.UUID) (*models.User, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+func TestAdminGetUser(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	bannedAt := time.Now()
+	env.Users.FindsByID(userID, &models.User{ID: userID, BannedAt: &bannedAt, BanReason: "spam"})
+	env.Sessions.ListsSessions(userID, []models.Session{*activeSession(userID)})
+
+	view, err := env.AdminService().GetUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, &bannedAt, view.BannedAt)
+	assert.Equal(t, "spam", view.BanReason)
+	assert.Len(t, view.Sessions, 1)
+}
+
+func TestAdminBan(t *testing.T) {
+	adminID := uuid.New()
+
+	tests := map[string]struct {
+		user  *models.User
+		setup func(*TestEnv, uuid.UUID)
+		err   error
+	}{
+		"bans and revokes sessions": {&models.User{ID: uuid.New(), Role: models.RoleUser}, func(env *TestEnv, userID uuid.UUID) {
+			env.Users.On("SetBan", userID, mock.AnythingOfType("*time.Time"), "spam").Return(nil)
+			env.Sessions.RevokesAllForUser(userID, 2)
+		}, nil},
+		"other admin": {&models.User{ID: uuid.New(), Role: models.RoleAdmin}, func(_ *TestEnv, _ uuid.UUID) {}, ErrProtectedUser},
+		"self":        {&models.User{ID: adminID, Role: models.RoleUser}, func(_ *TestEnv, _ uuid.UUID) {}, ErrProtectedUser},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			env.Users.FindsByID(tt.user.ID, tt.user)
+			tt.setup(env, tt.user.ID)
+
+			err := env.AdminService().Ban(adminID, tt.user.ID, " spam ")
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("unknown user", func(t *testing.T) {
+		env := newTestEnv(t)
+		userID := uuid.New()
+		env.Users.ByIDNotFound(userID)
+
+		assert.ErrorIs(t, env.AdminService().Ban(adminID, userID, "spam"), ErrNotFound)
+	})
+}
+
+func TestAdminUnban(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	env.Users.FindsByID(userID, &models.User{ID: userID})
+	env.Users.On("SetBan", userID, (*time.Time)(nil), "").Return(nil)
+
+	require.NoError(t, env.AdminService().Unban(uuid.New(), userID))
+}
+
+func TestAdminRevokeSessions(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	env.Users.FindsByID(userID, &models.User{ID: userID})
+	env.Sessions.RevokesAllForUser(userID, 3)
+
+	revoked, err := env.AdminService().RevokeSessions(uuid.New(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), revoked)
+}
+
+func TestAdminSetRole(t *testing.T) {
+	adminID := uuid.New()
+
+	tests := map[string]struct {
+		user  *models.User
+		role  string
+		setup func(*TestEnv, uuid.UUID)
+		err   error
+	}{
+		"promotes": {&models.User{ID: uuid.New(), Role: models.RoleUser}, models.RoleAdmin, func(env *TestEnv, userID uuid.UUID) {
+			env.Users.On("SetRole", userID, models.RoleAdmin).Return(nil)
+		}, nil},
+		"demotion revokes sessions": {&models.User{ID: uuid.New(), Role: models.RoleAdmin}, models.RoleUser, func(env *TestEnv, userID uuid.UUID) {
+			env.Users.On("SetRole", userID, models.RoleUser).Return(nil)
+			env.Sessions.RevokesAllForUser(userID, 1)
+		}, nil},
+		"unchanged": {&models.User{ID: uuid.New(), Role: models.RoleUser}, models.RoleUser, func(_ *TestEnv, _ uuid.UUID) {}, nil},
+		"self":      {&models.User{ID: adminID, Role: models.RoleAdmin}, models.RoleUser, func(_ *TestEnv, _ uuid.UUID) {}, ErrProtectedUser},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			env.Users.FindsByID(tt.user.ID, tt.user)
+			tt.setup(env, tt.user.ID)
+
+			user, err := env.AdminService().SetRole(adminID, tt.user.ID, tt.role)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.role, user.Role)
+		})
+	}
+
+	t.Run("unknown role", func(t *testing.T) {
+		env := newTestEnv(t)
+
+		_, err := env.AdminService().SetRole(adminID, uuid.New(), "superuser")
+		assert.ErrorIs(t, err, ErrInvalidInput)
+	})
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// Device authorization grant parameters.
+const (
+	deviceCodeTTL      = 10 * time.Minute
+	devicePollInterval = 5 * time.Second
+
+	// userCodeAlphabet omits vowels and look-alike characters so codes are
+	// easy to type and never spell words (RFC 8628 section 6.1).
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+// DeviceCode is returned to a terminal client that starts the device flow.
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// RequestDeviceCode starts a device authorization grant for a headless client.
+func (s *AuthService) RequestDeviceCode(_ context.Context) (*DeviceCode, error) {
+	deviceCode, err := generateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	userCode, err := generateUserCode()
+	if err != nil {
+		return nil, err
+	}
+
+	auth := &models.DeviceAuthorization{
+		DeviceCodeHash: hashToken(deviceCode),
+		UserCode:       userCode,
+		Status:         models.DeviceStatusPending,
+		ExpiresAt:      time.Now().Add(deviceCodeTTL),
+	}
+
+	if err := s.deviceRepo.Create(auth); err != nil {
+		return nil, err
+	}
+
+	verificationURI := strings.TrimRight(s.cfg.PublicURL, "/") + "/api/v1/auth/device"
+
+	return &DeviceCode{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURI + "?user_code=" + userCode,
+		ExpiresIn:               int(deviceCodeTTL.Seconds()),
+		Interval:                int(devicePollInterval.Seconds()),
+	}, nil
+}
+
+// ApproveDevice signs the user in with their Google access token from the
+// browser and approves the pending grant identified by userCode. The
+// verification page uses Google Identity Services, so it is Google-only.
+func (s *AuthService) ApproveDevice(ctx context.Context, userCode string, accessToken string) error {
+	auth, err := s.deviceRepo.FindPendingByUserCode(normalizeUserCode(userCode), time.Now())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	user, isNew, err := s.resolveUser(ctx, ProviderGoogle, accessToken)
+	if err != nil {
+		return err
+	}
+
+	approved, err := s.deviceRepo.Approve(auth.ID, user.ID, isNew)
+	if err != nil {
+		return err
+	}
+
+	if !approved {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// PollDeviceToken exchanges an approved device code for a new session. Until
+// the user approves, it returns ErrAuthorizationPending, or ErrSlowDown when
+// the client polls faster than the advertised interval.
+func (s *AuthService) PollDeviceToken(_ context.Context, deviceCode string, client ClientInfo) (*AuthResult, error) {
+	auth, err := s.deviceRepo.FindByDeviceCodeHash(hashToken(deviceCode))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidToken
+	} else if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if auth.Status == models.DeviceStatusConsumed || now.After(auth.ExpiresAt) {
+		return nil, ErrExpiredToken
+	}
+
+	if auth.Status == models.DeviceStatusPending {
+		tooSoon := auth.LastPolledAt != nil && now.Sub(*auth.LastPolledAt) < devicePollInterval
+		if err := s.deviceRepo.MarkPolled(auth.ID, now); err != nil {
+			return nil, err
+		}
+
+		if tooSoon {
+			return nil, ErrSlowDown
+		}
+
+		return nil, ErrAuthorizationPending
+	}
+
+	consumed, err := s.deviceRepo.Consume(auth.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !consumed || auth.UserID == nil {
+		return nil, ErrExpiredToken
+	}
+
+	user, err := s.userRepo.FindByID(*auth.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.IssueTokens(user, client)
+	if err != nil {
+		return nil, err
+	}
+	result.IsNew = auth.IsNewUser
+
+	return result, nil
+}
+
+// generateUserCode returns a code formatted as XXXX-XXXX.
+func generateUserCode() (string, error) {
+	alphabetSize := big.NewInt(int64(len(userCodeAlphabet)))
+
+	code := make([]byte, 0, userCodeLength+1)
+	for i := 0; i < userCodeLength; i++ {
+		if i == userCodeLength/2 {
+			code = append(code, '-')
+		}
+
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("generating user code: %w", err)
+		}
+		code = append(code, userCodeAlphabet[n.Int64()])
+	}
+
+	return string(code), nil
+}
+
+// normalizeUserCode accepts codes typed in any case, with or without the dash.
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != userCodeLength {
+		return code
+	}
+
+	return code[:userCodeLength/2] + "-" + code[userCodeLength/2:]
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// newFakeIdP starts an in-process stand-in for an OIDC userinfo endpoint
+// that recognises the given access tokens.
+func newFakeIdP(t *testing.T, users map[string]oidcUserInfo) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info, ok := users[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+		_ = json.NewEncoder(w).Encode(info)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestDeviceFlow_EndToEnd(t *testing.T) {
+	env := newTestEnv(t)
+	idp := newFakeIdP(t, map[string]oidcUserInfo{
+		"google-token": {Sub: "google-123", Email: "term@example.com", Name: "Terminal User"},
+	})
+	env.Config.GoogleUserInfoURL = idp.URL
+
+	var grant models.DeviceAuthorization
+	env.Devices.EXPECT().Create(mock.Anything).RunAndReturn(func(a *models.DeviceAuthorization) error {
+		a.ID = uuid.New()
+		grant = *a
+
+		return nil
+	})
+	env.Devices.EXPECT().FindPendingByUserCode(mock.Anything, mock.Anything).
+		RunAndReturn(func(code string, _ time.Time) (*models.DeviceAuthorization, error) {
+			if code != grant.UserCode || grant.Status != models.DeviceStatusPending {
+				return nil, gorm.ErrRecordNotFound
+			}
+			g := grant
+
+			return &g, nil
+		})
+	env.Devices.EXPECT().FindByDeviceCodeHash(mock.Anything).
+		RunAndReturn(func(hash string) (*models.DeviceAuthorization, error) {
+			if hash != grant.DeviceCodeHash {
+				return nil, gorm.ErrRecordNotFound
+			}
+			g := grant
+
+			return &g, nil
+		})
+	env.Devices.EXPECT().MarkPolled(mock.Anything, mock.Anything).RunAndReturn(func(_ uuid.UUID, at time.Time) error {
+		grant.LastPolledAt = &at
+
+		return nil
+	})
+	env.Devices.EXPECT().Approve(mock.Anything, mock.Anything, true).
+		RunAndReturn(func(_ uuid.UUID, userID uuid.UUID, isNew bool) (bool, error) {
+			grant.Status = models.DeviceStatusApproved
+			grant.UserID = &userID
+			grant.IsNewUser = isNew
+
+			return true, nil
+		})
+	env.Devices.EXPECT().Consume(mock.Anything).RunAndReturn(func(_ uuid.UUID) (bool, error) {
+		grant.Status = models.DeviceStatusConsumed
+
+		return true, nil
+	})
+
+	user := &models.User{ID: uuid.New()}
+	env.Identities.IdentityNotFound(ProviderGoogle, "google-123")
+	env.Users.EmailExists("term@example.com", false)
+	env.Users.HandlesTaken()
+	env.Identities.On("CreateUserWithIdentity", mock.AnythingOfType("*models.User"), mock.AnythingOfType("*models.UserIdentity")).
+		Run(func(args mock.Arguments) {
+			created := args.Get(0).(*models.User)
+			created.ID = user.ID
+			user = created
+		}).Return(nil)
+	env.Users.EXPECT().FindByID(mock.Anything).RunAndReturn(func(uuid.UUID) (*models.User, error) {
+		return user, nil
+	})
+	env.Sessions.CreatesSession()
+	env.Sessions.CreatesRefreshToken()
+
+	svc := env.AuthService()
+	ctx := context.Background()
+
+	code, err := svc.RequestDeviceCode(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "http://api.test/api/v1/auth/device", code.VerificationURI)
+	assert.Contains(t, code.VerificationURIComplete, code.UserCode)
+
+	_, err = svc.PollDeviceToken(ctx, code.DeviceCode, ClientInfo{})
+	assert.ErrorIs(t, err, ErrAuthorizationPending)
+
+	_, err = svc.PollDeviceToken(ctx, code.DeviceCode, ClientInfo{})
+	assert.ErrorIs(t, err, ErrSlowDown)
+
+	assert.ErrorIs(t, svc.ApproveDevice(ctx, code.UserCode, "wrong-token"), ErrInvalidToken)
+	require.NoError(t, svc.ApproveDevice(ctx, strings.ToLower(code.UserCode), "google-token"))
+
+	result, err := svc.PollDeviceToken(ctx, code.DeviceCode, ClientInfo{UserAgent: "cli/1.0"})
+	require.NoError(t, err)
+	assert.True(t, result.IsNew)
+	assert.Equal(t, "terminal_user", result.User.Username)
+	assert.Equal(t, "Terminal User", result.User.DisplayName)
+	assert.NotEmpty(t, result.Token)
+	assert.NotEmpty(t, result.RefreshToken)
+
+	_, err = svc.PollDeviceToken(ctx, code.DeviceCode, ClientInfo{})
+	assert.ErrorIs(t, err, ErrExpiredToken)
+}
+
+func TestPollDeviceToken(t *testing.T) {
+	tests := map[string]struct {
+		setup func(*TestEnv)
+		err   error
+	}{
+		"unknown device code": {func(env *TestEnv) {
+			env.Devices.DeviceCodeNotFound("device-code")
+		}, ErrInvalidToken},
+		"expired": {func(env *TestEnv) {
+			env.Devices.FindsByDeviceCode("device-code", &models.DeviceAuthorization{
+				Status:    models.DeviceStatusPending,
+				ExpiresAt: time.Now().Add(-time.Second),
+			})
+		}, ErrExpiredToken},
+		"pending": {func(env *TestEnv) {
+			id := uuid.New()
+			env.Devices.FindsByDeviceCode("device-code", &models.DeviceAuthorization{
+				ID:        id,
+				Status:    models.DeviceStatusPending,
+				ExpiresAt: time.Now().Add(time.Minute),
+			})
+			env.Devices.MarksPolled(id)
+		}, ErrAuthorizationPending},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			tt.setup(env)
+
+			_, err := env.AuthService().PollDeviceToken(context.Background(), "device-code", ClientInfo{})
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestApproveDevice_UnknownCode(t *testing.T) {
+	env := newTestEnv(t)
+	env.Devices.UserCodeNotFound("BCDF-GHJK")
+
+	err := env.AuthService().ApproveDevice(context.Background(), "bcdfghjk", "google-token")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGenerateUserCode(t *testing.T) {
+	code, err := generateUserCode()
+	require.NoError(t, err)
+	assert.Len(t, code, userCodeLength+1)
+	assert.Equal(t, code, normalizeUserCode(strings.ToLower(strings.ReplaceAll(code, "-", ""))))
+	for _, ch := range strings.ReplaceAll(code, "-", "") {
+		assert.Contains(t, userCodeAlphabet, string(ch))
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// ListIdentities returns the provider accounts linked to the user.
+func (s *AuthService) ListIdentities(userID uuid.UUID) ([]models.UserIdentity, error) {
+	return s.identityRepo.ListByUserID(userID)
+}
+
+// LinkIdentity attaches another provider account to a signed-in user so
+// either can be used to log in. Linking an account that already belongs to
+// someone else returns ErrAlreadyExists; relinking one's own is a no-op.
+func (s *AuthService) LinkIdentity(ctx context.Context, userID uuid.UUID, provider string, accessToken string) (*models.UserIdentity, error) {
+	info, err := s.fetchIdentity(ctx, provider, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.identityRepo.FindByProviderSubject(provider, info.Subject)
+	if err == nil {
+		if existing.UserID != userID {
+			return nil, ErrAlreadyExists
+		}
+
+		return existing, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	identity := &models.UserIdentity{
+		UserID:   userID,
+		Provider: provider,
+		Subject:  info.Subject,
+		Email:    info.Email,
+	}
+
+	if err := s.identityRepo.Create(identity); err != nil {
+		return nil, ErrAlreadyExists
+	}
+
+	return identity, nil
+}
+
+// UnlinkIdentity removes a linked provider account. The last remaining
+// identity cannot be removed, since the user would have no way to log in.
+func (s *AuthService) UnlinkIdentity(userID uuid.UUID, identityID uuid.UUID) error {
+	identities, err := s.identityRepo.ListByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, identity := range identities {
+		if identity.ID == identityID {
+			found = true
+
+			break
+		}
+	}
+
+	if !found {
+		return ErrNotFound
+	}
+
+	deleted, err := s.identityRepo.DeleteUnlessLast(userID, identityID)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrLastIdentity
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// identityEnv points the Google provider at a fake userinfo endpoint that
+// accepts "google-token" for the given subject.
+func identityEnv(t *testing.T, subject string) *TestEnv {
+	env := newTestEnv(t)
+	idp := newFakeIdP(t, map[string]oidcUserInfo{
+		"google-token": {Sub: subject, Email: "user@example.com", Name: "Some User", Picture: "pic.png"},
+		"no-email":     {Sub: subject},
+	})
+	env.Config.GoogleUserInfoURL = idp.URL
+
+	return env
+}
+
+func TestLogin(t *testing.T) {
+	tests := map[string]struct {
+		provider string
+		token    string
+		setup    func(*TestEnv, uuid.UUID)
+		isNew    bool
+		err      error
+	}{
+		"new user": {ProviderGoogle, "google-token", func(env *TestEnv, userID uuid.UUID) {
+			env.Identities.IdentityNotFound(ProviderGoogle, "sub-1")
+			env.Users.EmailExists("user@example.com", false)
+			env.Users.HandlesTaken()
+			env.Identities.SignsUp(userID)
+			env.Sessions.CreatesSession()
+			env.Sessions.CreatesRefreshToken()
+		}, true, nil},
+		"linked user": {ProviderGoogle, "google-token", func(env *TestEnv, userID uuid.UUID) {
+			env.Identities.FindsIdentity(&models.UserIdentity{UserID: userID, Provider: ProviderGoogle, Subject: "sub-1"})
+			env.Users.FindsByID(userID, &models.User{ID: userID, ProfilePicture: "pic.png"})
+			env.Sessions.CreatesSession()
+			env.Sessions.CreatesRefreshToken()
+		}, false, nil},
+		"email taken": {ProviderGoogle, "google-token", func(env *TestEnv, _ uuid.UUID) {
+			env.Identities.IdentityNotFound(ProviderGoogle, "sub-1")
+			env.Users.EmailExists("user@example.com", true)
+		}, false, ErrAlreadyExists},
+		"unknown provider": {"myspace", "google-token", func(_ *TestEnv, _ uuid.UUID) {}, false, ErrUnknownProvider},
+		"rejected token":   {ProviderGoogle, "bad-token", func(_ *TestEnv, _ uuid.UUID) {}, false, ErrInvalidToken},
+		"missing email":    {ProviderGoogle, "no-email", func(_ *TestEnv, _ uuid.UUID) {}, false, ErrMissingClaims},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := identityEnv(t, "sub-1")
+			userID := uuid.New()
+			tt.setup(env, userID)
+
+			result, err := env.AuthService().Login(context.Background(), tt.provider, tt.token, ClientInfo{})
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, userID, result.User.ID)
+			assert.Equal(t, tt.isNew, result.IsNew)
+		})
+	}
+}
+
+func TestLinkIdentity(t *testing.T) {
+	tests := map[string]struct {
+		setup func(*TestEnv, uuid.UUID)
+		err   error
+	}{
+		"links new account": {func(env *TestEnv, _ uuid.UUID) {
+			env.Identities.IdentityNotFound(ProviderGoogle, "sub-1")
+			env.Identities.CreatesIdentity()
+		}, nil},
+		"already linked to caller": {func(env *TestEnv, userID uuid.UUID) {
+			env.Identities.FindsIdentity(&models.UserIdentity{UserID: userID, Provider: ProviderGoogle, Subject: "sub-1"})
+		}, nil},
+		"linked to someone else": {func(env *TestEnv, _ uuid.UUID) {
+			env.Identities.FindsIdentity(&models.UserIdentity{UserID: uuid.New(), Provider: ProviderGoogle, Subject: "sub-1"})
+		}, ErrAlreadyExists},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := identityEnv(t, "sub-1")
+			userID := uuid.New()
+			tt.setup(env, userID)
+
+			identity, err := env.AuthService().LinkIdentity(context.Background(), userID, ProviderGoogle, "google-token")
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, userID, identity.UserID)
+		})
+	}
+}
+
+func TestUnlinkIdentity(t *testing.T) {
+	tests := map[string]struct {
+		setup func(*TestEnv, uuid.UUID, uuid.UUID)
+		err   error
+	}{
+		"unlinks": {func(env *TestEnv, userID, identityID uuid.UUID) {
+			env.Identities.ListsIdentities(userID, []models.UserIdentity{{ID: identityID}, {ID: uuid.New()}})
+			env.Identities.DeletesIdentity(userID, identityID, 1)
+		}, nil},
+		"last identity": {func(env *TestEnv, userID, identityID uuid.UUID) {
+			env.Identities.ListsIdentities(userID, []models.UserIdentity{{ID: identityID}})
+			env.Identities.DeletesIdentity(userID, identityID, 0)
+		}, ErrLastIdentity},
+		"not the caller's": {func(env *TestEnv, userID, _ uuid.UUID) {
+			env.Identities.ListsIdentities(userID, []models.UserIdentity{{ID: uuid.New()}})
+		}, ErrNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			userID, identityID := uuid.New(), uuid.New()
+			tt.setup(env, userID, identityID)
+
+			err := env.AuthService().UnlinkIdentity(userID, identityID)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLogin_GeneratesHandle(t *testing.T) {
+	env := identityEnv(t, "sub-1")
+	env.Identities.IdentityNotFound(ProviderGoogle, "sub-1")
+	env.Users.EmailExists("user@example.com", false)
+	env.Users.HandlesTaken("some_user", "some_user2")
+
+	var created *models.User
+	env.Identities.On("CreateUserWithIdentity", mock.AnythingOfType("*models.User"), mock.AnythingOfType("*models.UserIdentity")).
+		Run(func(args mock.Arguments) { created = args.Get(0).(*models.User) }).Return(nil)
+	env.Sessions.CreatesSession()
+	env.Sessions.CreatesRefreshToken()
+
+	_, err := env.AuthService().Login(context.Background(), ProviderGoogle, "google-token", ClientInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, "some_user3", created.Username)
+	assert.Equal(t, "Some User", created.DisplayName)
+}
+
+func TestLogin_RetriesHandleRace(t *testing.T) {
+	env := identityEnv(t, "sub-1")
+	env.Identities.IdentityNotFound(ProviderGoogle, "sub-1")
+	env.Users.EmailExists("user@example.com", false)
+	env.Users.HandlesTaken()
+
+	var attempts []string
+	env.Identities.On("CreateUserWithIdentity", mock.AnythingOfType("*models.User"), mock.AnythingOfType("*models.UserIdentity")).
+		Run(func(args mock.Arguments) { attempts = append(attempts, args.Get(0).(*models.User).Username) }).
+		Return(gorm.ErrDuplicatedKey).Once()
+	env.Identities.On("CreateUserWithIdentity", mock.AnythingOfType("*models.User"), mock.AnythingOfType("*models.UserIdentity")).
+		Return(nil).Once()
+	env.Sessions.CreatesSession()
+	env.Sessions.CreatesRefreshToken()
+
+	result, err := env.AuthService().Login(context.Background(), ProviderGoogle, "google-token", ClientInfo{})
+	require.NoError(t, err)
+	assert.True(t, result.IsNew)
+	assert.Equal(t, []string{"some_user"}, attempts)
+}
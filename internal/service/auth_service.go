@@ -2,152 +2,423 @@ package service
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"net/http"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"github.com/milansax96/movie-terminal-api/config"
+	"github.com/milansax96/movie-terminal-api/internal/handle"
 	"github.com/milansax96/movie-terminal-api/internal/middleware"
 	"github.com/milansax96/movie-terminal-api/internal/models"
 	"github.com/milansax96/movie-terminal-api/internal/repository"
+	"github.com/milansax96/movie-terminal-api/internal/signing"
 
 	"gorm.io/gorm"
 )
 
-// AuthService handles authentication via Google OAuth.
+// maxHandleAttempts bounds signup retries after losing a handle to a concurrent signup.
+const maxHandleAttempts = 3
+
+// Token lifetimes. Access tokens are short-lived and renewed with a rotating
+// refresh token; the refresh token's lifetime bounds the whole session.
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// AuthService handles sign-in through external identity providers and manages login sessions.
 type AuthService struct {
-	userRepo repository.UserRepository
-	cfg      *config.Config
+	userRepo     repository.UserRepository
+	sessionRepo  repository.SessionRepository
+	deviceRepo   repository.DeviceAuthRepository
+	identityRepo repository.IdentityRepository
+	providers    map[string]IdentityProvider
+	keys         *signing.KeySet
+	cfg          *config.Config
 }
 
-// NewAuthService creates a new AuthService.
-func NewAuthService(userRepo repository.UserRepository, cfg *config.Config) *AuthService {
-	return &AuthService{userRepo: userRepo, cfg: cfg}
+// NewAuthService creates a new AuthService that accepts logins from the given providers.
+func NewAuthService(userRepo repository.UserRepository, sessionRepo repository.SessionRepository, deviceRepo repository.DeviceAuthRepository, identityRepo repository.IdentityRepository, keys *signing.KeySet, cfg *config.Config, providers ...IdentityProvider) *AuthService {
+	byName := make(map[string]IdentityProvider, len(providers))
+	for _, p := range providers {
+		byName[p.Name()] = p
+	}
+
+	return &AuthService{
+		userRepo:     userRepo,
+		sessionRepo:  sessionRepo,
+		deviceRepo:   deviceRepo,
+		identityRepo: identityRepo,
+		providers:    byName,
+		keys:         keys,
+		cfg:          cfg,
+	}
 }
 
 // AuthResult holds the result of a login attempt.
 type AuthResult struct {
-	User  *models.User
-	Token string
-	IsNew bool
+	User         *models.User
+	Token        string
+	RefreshToken string
+	IsNew        bool
+}
+
+// ClientInfo describes the device a session is created from.
+type ClientInfo struct {
+	UserAgent string
+	IP        string
 }
 
-type googleUserInfo struct {
-	Sub     string `json:"sub"`
-	Email   string `json:"email"`
-	Name    string `json:"name"`
-	Picture string `json:"picture"`
+// Providers returns the names of the identity providers users can sign in with.
+func (s *AuthService) Providers() []string {
+	names := make([]string, 0, len(s.providers))
+	for name := range s.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
 }
 
-func (s *AuthService) fetchGoogleUserInfo(ctx context.Context, accessToken string) (_ *googleUserInfo, err error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", "https://www.googleapis.com/oauth2/v3/userinfo", nil)
+// Login authenticates a user with an access token from the named identity
+// provider and starts a new session. First-time logins create the account.
+func (s *AuthService) Login(ctx context.Context, provider string, accessToken string, client ClientInfo) (*AuthResult, error) {
+	user, isNew, err := s.resolveUser(ctx, provider, accessToken)
 	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+		return nil, err
 	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
 
-	resp, err := http.DefaultClient.Do(req)
+	result, err := s.IssueTokens(user, client)
 	if err != nil {
-		return nil, fmt.Errorf("calling userinfo endpoint: %w", err)
+		return nil, err
 	}
-	defer func() {
-		if cerr := resp.Body.Close(); cerr != nil && err == nil {
-			err = cerr
-		}
-	}()
+	result.IsNew = isNew
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, ErrInvalidToken
+	return result, nil
+}
+
+// fetchIdentity verifies an access token with the named provider.
+func (s *AuthService) fetchIdentity(ctx context.Context, provider string, accessToken string) (*ExternalIdentity, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, ErrUnknownProvider
 	}
 
-	var info googleUserInfo
-	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
-		return nil, fmt.Errorf("decoding userinfo: %w", err)
+	info, err := p.UserInfo(ctx, accessToken)
+	if err != nil {
+		return nil, err
 	}
 
-	return &info, nil
+	if info.Subject == "" || info.Email == "" {
+		return nil, ErrMissingClaims
+	}
+
+	return info, nil
 }
 
-// GoogleLogin authenticates a user via Google OAuth access token.
-func (s *AuthService) GoogleLogin(ctx context.Context, accessToken string) (*AuthResult, error) {
-	info, err := s.fetchGoogleUserInfo(ctx, accessToken)
+// resolveUser looks up the user linked to the provider account behind an
+// access token, creating the account on first login. It reports whether the
+// user was just created. Accounts are never linked implicitly by email; an
+// existing email has to link the new provider from a signed-in session.
+func (s *AuthService) resolveUser(ctx context.Context, provider string, accessToken string) (*models.User, bool, error) {
+	info, err := s.fetchIdentity(ctx, provider, accessToken)
 	if err != nil {
-		if errors.Is(err, ErrInvalidToken) {
-			return nil, ErrInvalidToken
+		return nil, false, err
+	}
+
+	identity, err := s.identityRepo.FindByProviderSubject(provider, info.Subject)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		user, err := s.signUp(provider, info)
+		if err != nil {
+			return nil, false, err
 		}
 
-		return nil, err
+		return user, true, nil
+	} else if err != nil {
+		return nil, false, err
 	}
 
-	googleID := info.Sub
-	email := info.Email
-	name := info.Name
-	picture := info.Picture
-
-	if googleID == "" || email == "" {
-		return nil, ErrMissingClaims
+	user, err := s.userRepo.FindByID(identity.UserID)
+	if err != nil {
+		return nil, false, err
 	}
 
-	user, err := s.userRepo.FindByGoogleID(googleID)
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		username := name
-		if username == "" {
-			username = email
+	// Update profile picture if changed
+	if info.Picture != "" && info.Picture != user.ProfilePicture {
+		err := s.userRepo.UpdateProfilePicture(user.ID, info.Picture)
+		if err != nil {
+			return nil, false, err
 		}
 
-		user = &models.User{
-			Username:       username,
-			Email:          email,
-			GoogleID:       googleID,
-			ProfilePicture: picture,
+		user.ProfilePicture = info.Picture
+	}
+
+	return user, false, nil
+}
+
+// signUp creates an account for a first-time login with a generated handle.
+// Losing a race for the handle to a concurrent signup just moves on to the
+// next free candidate.
+func (s *AuthService) signUp(provider string, info *ExternalIdentity) (*models.User, error) {
+	displayName := strings.TrimSpace(info.Name)
+	if displayName == "" {
+		displayName, _, _ = strings.Cut(info.Email, "@")
+	}
+
+	for attempt := 0; attempt < maxHandleAttempts; attempt++ {
+		taken, err := s.userRepo.EmailExists(info.Email)
+		if err != nil {
+			return nil, err
 		}
 
-		if err := s.userRepo.Create(user); err != nil {
+		if taken {
 			return nil, ErrAlreadyExists
 		}
 
-		token, err := s.generateToken(user.ID.String())
+		username, err := s.availableHandle(handle.FromName(displayName))
 		if err != nil {
 			return nil, err
 		}
 
-		return &AuthResult{User: user, Token: token, IsNew: true}, nil
+		user := &models.User{
+			Username:       username,
+			DisplayName:    truncateRunes(displayName, maxDisplayNameLength),
+			Email:          info.Email,
+			ProfilePicture: info.Picture,
+		}
+		identity := &models.UserIdentity{Provider: provider, Subject: info.Subject, Email: info.Email}
+
+		err = s.identityRepo.CreateUserWithIdentity(user, identity)
+		if err == nil {
+			return user, nil
+		}
+
+		if !errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, err
+		}
+	}
+
+	return nil, ErrHandleTaken
+}
+
+// availableHandle returns the first candidate for base that nobody holds.
+func (s *AuthService) availableHandle(base string) (string, error) {
+	suffixes := make([]string, 3)
+	for i := range suffixes {
+		n, err := rand.Int(rand.Reader, big.NewInt(10000))
+		if err != nil {
+			return "", fmt.Errorf("generating handle: %w", err)
+		}
+		suffixes[i] = fmt.Sprintf("%04d", n.Int64())
+	}
+
+	candidates := handle.Candidates(base, suffixes...)
+	taken, err := s.userRepo.TakenUsernames(candidates)
+	if err != nil {
+		return "", err
+	}
+
+	for _, candidate := range candidates {
+		if !slices.Contains(taken, candidate) {
+			return candidate, nil
+		}
+	}
+
+	return "", ErrHandleTaken
+}
+
+// IssueTokens starts a new session for the user and returns its first access and refresh tokens.
+func (s *AuthService) IssueTokens(user *models.User, client ClientInfo) (*AuthResult, error) {
+	if user.IsBanned() {
+		return nil, ErrAccountBanned
+	}
+
+	now := time.Now()
+	session := &models.Session{
+		UserID:     user.ID,
+		UserAgent:  client.UserAgent,
+		IPAddress:  client.IP,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(refreshTokenTTL),
+	}
+
+	if err := s.sessionRepo.Create(session); err != nil {
+		return nil, err
+	}
+
+	return s.issueForSession(user, session)
+}
+
+// Refresh exchanges a refresh token for a new access/refresh token pair.
+// Each refresh token is single-use: presenting one that was already rotated
+// is treated as theft and revokes the whole session.
+func (s *AuthService) Refresh(_ context.Context, refreshToken string, client ClientInfo) (*AuthResult, error) {
+	token, err := s.sessionRepo.FindRefreshToken(hashToken(refreshToken))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidToken
 	} else if err != nil {
 		return nil, err
 	}
 
-	// Update profile picture if changed
-	if picture != "" && picture != user.ProfilePicture {
-		err := s.userRepo.UpdateProfilePicture(user.ID, picture)
-		if err != nil {
+	session, err := s.sessionRepo.FindByID(token.SessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidToken
+	} else if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !session.IsActive(now) || now.After(token.ExpiresAt) {
+		return nil, ErrInvalidToken
+	}
+
+	fresh, err := s.sessionRepo.MarkRefreshTokenUsed(token.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fresh {
+		if err := s.sessionRepo.Revoke(session.ID, now); err != nil {
 			return nil, err
 		}
 
-		user.ProfilePicture = picture
+		return nil, ErrTokenReused
+	}
+
+	if err := s.sessionRepo.Touch(session.ID, client.IP, now); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(session.UserID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidToken
+	} else if err != nil {
+		return nil, err
+	}
+
+	if user.IsBanned() {
+		return nil, ErrAccountBanned
+	}
+
+	return s.issueForSession(user, session)
+}
+
+// Logout revokes the session that owns the given refresh token.
+func (s *AuthService) Logout(_ context.Context, refreshToken string) error {
+	token, err := s.sessionRepo.FindRefreshToken(hashToken(refreshToken))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrInvalidToken
+	} else if err != nil {
+		return err
+	}
+
+	return s.sessionRepo.Revoke(token.SessionID, time.Now())
+}
+
+// IsSessionActive reports whether the session behind an access token is still
+// valid and its user is not banned.
+// It satisfies middleware.SessionValidator.
+func (s *AuthService) IsSessionActive(sessionID string) (bool, error) {
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return false, nil
+	}
+
+	return s.sessionRepo.IsActive(id, time.Now())
+}
+
+// ListSessions returns the user's active sessions, flagging the one identified by currentSessionID.
+func (s *AuthService) ListSessions(userID uuid.UUID, currentSessionID string) ([]models.Session, error) {
+	sessions, err := s.sessionRepo.ListActiveByUserID(userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID.String() == currentSessionID
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revokes one of the user's sessions. Sessions belonging to
+// other users are reported as not found.
+func (s *AuthService) RevokeSession(userID uuid.UUID, sessionID uuid.UUID) error {
+	session, err := s.sessionRepo.FindByID(sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if session.UserID != userID {
+		return ErrNotFound
+	}
+
+	return s.sessionRepo.Revoke(session.ID, time.Now())
+}
+
+func (s *AuthService) issueForSession(user *models.User, session *models.Session) (*AuthResult, error) {
+	refreshToken, err := generateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.sessionRepo.CreateRefreshToken(&models.RefreshToken{
+		SessionID: session.ID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: session.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	token, err := s.generateToken(user.ID.String())
+	token, err := s.generateToken(user, session.ID.String())
 	if err != nil {
 		return nil, err
 	}
 
-	return &AuthResult{User: user, Token: token, IsNew: false}, nil
+	return &AuthResult{User: user, Token: token, RefreshToken: refreshToken}, nil
 }
 
-func (s *AuthService) generateToken(userID string) (string, error) {
+func (s *AuthService) generateToken(user *models.User, sessionID string) (string, error) {
 	claims := &middleware.Claims{
-		UserID: userID,
+		UserID:    user.ID.String(),
+		SessionID: sessionID,
+		Role:      user.Role,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * 7 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(accessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return s.keys.Sign(claims)
+}
+
+// generateOpaqueToken returns a URL-safe random token with 256 bits of entropy.
+func generateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken returns the hex SHA-256 digest under which opaque tokens are stored.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
 
-	return token.SignedString([]byte(s.cfg.JWTSecret))
+	return hex.EncodeToString(sum[:])
 }
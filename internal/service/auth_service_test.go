@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/middleware"
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+func TestIssueTokens(t *testing.T) {
+	env := newTestEnv(t)
+	user := &models.User{ID: uuid.New(), Role: models.RoleAdmin}
+	env.Sessions.CreatesSession()
+	env.Sessions.CreatesRefreshToken()
+
+	result, err := env.AuthService().IssueTokens(user, ClientInfo{UserAgent: "cli/1.0", IP: "10.0.0.1"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.RefreshToken)
+
+	claims := &middleware.Claims{}
+	_, err = jwt.ParseWithClaims(result.Token, claims, func(_ *jwt.Token) (interface{}, error) {
+		return []byte("test-secret"), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, user.ID.String(), claims.UserID)
+	assert.NotEmpty(t, claims.SessionID)
+	assert.Equal(t, models.RoleAdmin, claims.Role)
+	assert.WithinDuration(t, time.Now().Add(accessTokenTTL), claims.ExpiresAt.Time, time.Minute)
+}
+
+func TestIssueTokens_Banned(t *testing.T) {
+	env := newTestEnv(t)
+	bannedAt := time.Now()
+
+	_, err := env.AuthService().IssueTokens(&models.User{ID: uuid.New(), BannedAt: &bannedAt}, ClientInfo{})
+	assert.ErrorIs(t, err, ErrAccountBanned)
+}
+
+func TestRefresh(t *testing.T) {
+	tests := map[string]struct {
+		setup func(*TestEnv, *models.Session, *models.RefreshToken)
+		err   error
+	}{
+		"rotates token": {func(env *TestEnv, session *models.Session, token *models.RefreshToken) {
+			env.Sessions.FindsRefreshToken("raw-token", token)
+			env.Sessions.FindsSession(session)
+			env.Sessions.MarksTokenUsed(token.ID, true)
+			env.Sessions.TouchesSession(session.ID)
+			env.Users.FindsByID(session.UserID, &models.User{ID: session.UserID})
+			env.Sessions.CreatesRefreshToken()
+		}, nil},
+		"unknown token": {func(env *TestEnv, _ *models.Session, _ *models.RefreshToken) {
+			env.Sessions.RefreshTokenNotFound("raw-token")
+		}, ErrInvalidToken},
+		"revoked session": {func(env *TestEnv, session *models.Session, token *models.RefreshToken) {
+			revokedAt := time.Now().Add(-time.Minute)
+			session.RevokedAt = &revokedAt
+			env.Sessions.FindsRefreshToken("raw-token", token)
+			env.Sessions.FindsSession(session)
+		}, ErrInvalidToken},
+		"banned user": {func(env *TestEnv, session *models.Session, token *models.RefreshToken) {
+			bannedAt := time.Now()
+			env.Sessions.FindsRefreshToken("raw-token", token)
+			env.Sessions.FindsSession(session)
+			env.Sessions.MarksTokenUsed(token.ID, true)
+			env.Sessions.TouchesSession(session.ID)
+			env.Users.FindsByID(session.UserID, &models.User{ID: session.UserID, BannedAt: &bannedAt})
+		}, ErrAccountBanned},
+		"reuse revokes session": {func(env *TestEnv, session *models.Session, token *models.RefreshToken) {
+			env.Sessions.FindsRefreshToken("raw-token", token)
+			env.Sessions.FindsSession(session)
+			env.Sessions.MarksTokenUsed(token.ID, false)
+			env.Sessions.RevokesSession(session.ID)
+		}, ErrTokenReused},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			session := activeSession(uuid.New())
+			token := &models.RefreshToken{ID: uuid.New(), SessionID: session.ID, ExpiresAt: session.ExpiresAt}
+			tt.setup(env, session, token)
+
+			result, err := env.AuthService().Refresh(context.Background(), "raw-token", ClientInfo{})
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, result.Token)
+			assert.NotEqual(t, "raw-token", result.RefreshToken)
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	tests := map[string]struct {
+		setup func(*TestEnv, *models.RefreshToken)
+		err   error
+	}{
+		"revokes session": {func(env *TestEnv, token *models.RefreshToken) {
+			env.Sessions.FindsRefreshToken("raw-token", token)
+			env.Sessions.RevokesSession(token.SessionID)
+		}, nil},
+		"unknown token": {func(env *TestEnv, _ *models.RefreshToken) {
+			env.Sessions.RefreshTokenNotFound("raw-token")
+		}, ErrInvalidToken},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			token := &models.RefreshToken{ID: uuid.New(), SessionID: uuid.New()}
+			tt.setup(env, token)
+
+			err := env.AuthService().Logout(context.Background(), "raw-token")
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestIsSessionActive(t *testing.T) {
+	tests := map[string]struct {
+		active bool
+	}{
+		"active":                     {true},
+		"revoked, expired or banned": {false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			sessionID := uuid.New()
+			env.Sessions.ChecksActive(sessionID, tt.active)
+
+			active, err := env.AuthService().IsSessionActive(sessionID.String())
+			require.NoError(t, err)
+			assert.Equal(t, tt.active, active)
+		})
+	}
+
+	t.Run("malformed session ID", func(t *testing.T) {
+		env := newTestEnv(t)
+
+		active, err := env.AuthService().IsSessionActive("not-a-uuid")
+		require.NoError(t, err)
+		assert.False(t, active)
+	})
+}
+
+func TestListSessions(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	current := activeSession(userID)
+	other := activeSession(userID)
+	env.Sessions.ListsSessions(userID, []models.Session{*current, *other})
+
+	sessions, err := env.AuthService().ListSessions(userID, current.ID.String())
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.True(t, sessions[0].Current)
+	assert.False(t, sessions[1].Current)
+}
+
+func TestRevokeSession(t *testing.T) {
+	tests := map[string]struct {
+		setup func(*TestEnv, uuid.UUID, *models.Session)
+		err   error
+	}{
+		"own session": {func(env *TestEnv, userID uuid.UUID, session *models.Session) {
+			session.UserID = userID
+			env.Sessions.FindsSession(session)
+			env.Sessions.RevokesSession(session.ID)
+		}, nil},
+		"other user's session": {func(env *TestEnv, _ uuid.UUID, session *models.Session) {
+			env.Sessions.FindsSession(session)
+		}, ErrNotFound},
+		"unknown session": {func(env *TestEnv, _ uuid.UUID, session *models.Session) {
+			env.Sessions.SessionNotFound(session.ID)
+		}, ErrNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			userID := uuid.New()
+			session := activeSession(uuid.New())
+			tt.setup(env, userID, session)
+
+			err := env.AuthService().RevokeSession(userID, session.ID)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+// AvailabilityEvent tells a user that a title on their watchlist started
+// streaming on one of their services.
+type AvailabilityEvent struct {
+	UserID      uuid.UUID `json:"user_id"`
+	TMDBId      int       `json:"tmdb_id"`
+	MediaType   string    `json:"media_type"`
+	Title       string    `json:"title"`
+	Region      string    `json:"region"`
+	ServiceID   int       `json:"service_id"`
+	ServiceName string    `json:"service_name"`
+	DetectedAt  time.Time `json:"detected_at"`
+}
+
+// AvailabilityNotifier delivers availability events to users.
+type AvailabilityNotifier interface {
+	NotifyAvailable(ctx context.Context, event AvailabilityEvent) error
+}
+
+// LogNotifier writes availability events to the server log.
+type LogNotifier struct{}
+
+// NotifyAvailable logs the event.
+func (LogNotifier) NotifyAvailable(_ context.Context, event AvailabilityEvent) error {
+	log.Printf("Now streaming for user %s: %s %d (%q) on %s in %s",
+		event.UserID, event.MediaType, event.TMDBId, event.Title, event.ServiceName, event.Region)
+
+	return nil
+}
+
+// AvailableService is one of the user's streaming services carrying a title.
+type AvailableService struct {
+	ServiceID      int       `json:"service_id"`
+	Name           string    `json:"name"`
+	Slug           string    `json:"slug"`
+	AvailableSince time.Time `json:"available_since"`
+}
+
+// WatchlistAvailability is a watchlist item streaming on at least one of the
+// user's services.
+type WatchlistAvailability struct {
+	models.Watchlist
+	AvailableSince time.Time          `json:"available_since"`
+	Services       []AvailableService `json:"services"`
+}
+
+// AvailabilityService tracks which providers stream watchlisted titles in
+// the configured regions and tells users when a title arrives on one of
+// their services.
+type AvailabilityService struct {
+	tmdb             tmdb.API
+	availabilityRepo repository.AvailabilityRepository
+	watchlistRepo    repository.WatchlistRepository
+	userRepo         repository.UserRepository
+	notifier         AvailabilityNotifier
+	clock            Clock
+	regions          []string
+}
+
+// NewAvailabilityService creates a new AvailabilityService that tracks the
+// given regions.
+func NewAvailabilityService(tmdbClient tmdb.API, availabilityRepo repository.AvailabilityRepository, watchlistRepo repository.WatchlistRepository, userRepo repository.UserRepository, notifier AvailabilityNotifier, clock Clock, regions []string) *AvailabilityService {
+	return &AvailabilityService{
+		tmdb:             tmdbClient,
+		availabilityRepo: availabilityRepo,
+		watchlistRepo:    watchlistRepo,
+		userRepo:         userRepo,
+		notifier:         notifier,
+		clock:            clock,
+		regions:          regions,
+	}
+}
+
+// Run checks every tracked title straight away and then once per interval
+// until ctx is cancelled.
+func (s *AvailabilityService) Run(ctx context.Context, interval time.Duration) {
+	for {
+		if err := s.CheckAll(ctx); err != nil {
+			log.Printf("Availability check failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.clock.After(interval):
+		}
+	}
+}
+
+// CheckAll re-checks the providers of every watchlisted title, least
+// recently checked first. A failure on one title does not stop the others.
+func (s *AvailabilityService) CheckAll(ctx context.Context) error {
+	titles, err := s.availabilityRepo.ListTrackedTitles()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range titles {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if err := s.checkTitle(ctx, &titles[i]); err != nil {
+			errs = append(errs, fmt.Errorf("%s %d: %w", titles[i].MediaType, titles[i].TMDBId, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// availabilityKey identifies a provider in a region.
+type availabilityKey struct {
+	region     string
+	providerID int
+}
+
+// checkTitle compares a title's current providers with the last check and
+// records the difference. The first check of a title only sets the baseline,
+// so it is neither logged as a change nor notified.
+func (s *AvailabilityService) checkTitle(ctx context.Context, title *models.TrackedTitle) error {
+	res, err := s.tmdb.GetProviders(title.MediaType, title.TMDBId)
+	if err != nil {
+		return err
+	}
+
+	previous, err := s.availabilityRepo.ListByTitle(title.TMDBId, title.MediaType)
+	if err != nil {
+		return err
+	}
+
+	now := s.clock.Now()
+	current := s.currentAvailability(title, res.ToDomain(title.MediaType), now)
+
+	var added, removed []models.TitleAvailability
+	for key, row := range current {
+		if !slices.ContainsFunc(previous, func(p models.TitleAvailability) bool {
+			return p.Region == key.region && p.ProviderID == key.providerID
+		}) {
+			added = append(added, row)
+		}
+	}
+
+	for _, row := range previous {
+		if _, ok := current[availabilityKey{row.Region, row.ProviderID}]; !ok {
+			removed = append(removed, row)
+		}
+	}
+
+	firstCheck := title.CheckedAt == nil
+
+	var changes []models.AvailabilityChange
+	if !firstCheck {
+		changes = availabilityChanges(added, true, now)
+		changes = append(changes, availabilityChanges(removed, false, now)...)
+	}
+
+	title.CheckedAt = &now
+	if err := s.availabilityRepo.Record(title, added, removed, changes); err != nil {
+		return err
+	}
+
+	if firstCheck || len(added) == 0 {
+		return nil
+	}
+
+	return s.notifyWatchers(ctx, title, added, now)
+}
+
+// currentAvailability lists the providers streaming the title in the
+// tracked regions without extra payment.
+func (s *AvailabilityService) currentAvailability(title *models.TrackedTitle, providers models.WatchProviders, now time.Time) map[availabilityKey]models.TitleAvailability {
+	current := make(map[availabilityKey]models.TitleAvailability)
+	for _, region := range s.regions {
+		offers := providers.Regions[region]
+		for _, list := range [][]models.WatchProvider{offers.Stream, offers.Free, offers.Ads} {
+			for _, p := range list {
+				key := availabilityKey{region, p.ProviderID}
+				if _, ok := current[key]; ok {
+					continue
+				}
+
+				current[key] = models.TitleAvailability{
+					TMDBId:         title.TMDBId,
+					MediaType:      title.MediaType,
+					Region:         region,
+					ProviderID:     p.ProviderID,
+					ProviderName:   p.Name,
+					AvailableSince: now,
+				}
+			}
+		}
+	}
+
+	return current
+}
+
+func availabilityChanges(rows []models.TitleAvailability, available bool, now time.Time) []models.AvailabilityChange {
+	changes := make([]models.AvailabilityChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, models.AvailabilityChange{
+			TMDBId:       row.TMDBId,
+			MediaType:    row.MediaType,
+			Region:       row.Region,
+			ProviderID:   row.ProviderID,
+			ProviderName: row.ProviderName,
+			Available:    available,
+			DetectedAt:   now,
+		})
+	}
+
+	return changes
+}
+
+// notifyWatchers sends one event per user and service for the providers
+// that started streaming the title.
+func (s *AvailabilityService) notifyWatchers(ctx context.Context, title *models.TrackedTitle, added []models.TitleAvailability, now time.Time) error {
+	watchers, err := s.availabilityRepo.FindWatchers(title.TMDBId, title.MediaType)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, user := range watchers {
+		notified := make(map[int]struct{})
+		for _, row := range added {
+			for _, svc := range user.StreamingServices {
+				if _, ok := notified[svc.ID]; ok || svc.TMDBProviderID == nil ||
+					*svc.TMDBProviderID != row.ProviderID || !svc.OffersRegion(row.Region) {
+					continue
+				}
+				notified[svc.ID] = struct{}{}
+
+				err := s.notifier.NotifyAvailable(ctx, AvailabilityEvent{
+					UserID:      user.ID,
+					TMDBId:      title.TMDBId,
+					MediaType:   title.MediaType,
+					Title:       title.Title,
+					Region:      row.Region,
+					ServiceID:   svc.ID,
+					ServiceName: svc.Name,
+					DetectedAt:  now,
+				})
+				if err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GetAvailability returns the user's watchlist items streaming in region on
+// their services, most recently arrived first. The region defaults to the
+// first tracked region and must be one of them.
+func (s *AvailabilityService) GetAvailability(userID uuid.UUID, region string) ([]WatchlistAvailability, error) {
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if region == "" && len(s.regions) > 0 {
+		region = s.regions[0]
+	}
+
+	if !slices.Contains(s.regions, region) {
+		verr := &ValidationError{}
+		verr.add("region", "must be one of "+strings.Join(s.regions, ", "))
+
+		return nil, verr
+	}
+
+	user, err := s.userRepo.FindByIDWithStreaming(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	items, err := s.watchlistRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.availabilityRepo.ListForUser(userID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []WatchlistAvailability{}
+	for _, item := range items {
+		entry := WatchlistAvailability{Watchlist: item, Services: []AvailableService{}}
+		for _, row := range rows {
+			if row.TMDBId != item.TMDBId || row.MediaType != item.MediaType {
+				continue
+			}
+
+			for _, svc := range user.StreamingServices {
+				if svc.TMDBProviderID == nil || *svc.TMDBProviderID != row.ProviderID || !svc.OffersRegion(region) {
+					continue
+				}
+
+				entry.Services = append(entry.Services, AvailableService{
+					ServiceID:      svc.ID,
+					Name:           svc.Name,
+					Slug:           svc.Slug,
+					AvailableSince: row.AvailableSince,
+				})
+				if row.AvailableSince.After(entry.AvailableSince) {
+					entry.AvailableSince = row.AvailableSince
+				}
+			}
+		}
+
+		if len(entry.Services) > 0 {
+			results = append(results, entry)
+		}
+	}
+
+	slices.SortStableFunc(results, func(a, b WatchlistAvailability) int {
+		return b.AvailableSince.Compare(a.AvailableSince)
+	})
+
+	return results, nil
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+func streamingIn(region string, providers ...tmdb.WatchProvider) *tmdb.WatchProvidersResponse {
+	return &tmdb.WatchProvidersResponse{Results: map[string]tmdb.RegionWatchProviders{
+		region: {Flatrate: providers},
+		"GB":   {Flatrate: []tmdb.WatchProvider{{ProviderID: 39, ProviderName: "Now TV"}}},
+	}}
+}
+
+func TestCheckAll_FirstCheckSetsBaseline(t *testing.T) {
+	env := newTestEnv(t)
+	env.Avail.TracksTitles([]models.TrackedTitle{{TMDBId: 550, MediaType: "movie", Title: "Fight Club"}})
+	env.TMDB.ReturnsProviders("movie", 550, streamingIn("US", tmdb.WatchProvider{ProviderID: 8, ProviderName: "Netflix"}))
+	env.Avail.HasAvailability(550, "movie", nil)
+
+	checks := map[int]*RecordedCheck{}
+	env.Avail.Records(checks)
+
+	err := env.AvailabilityService("US").CheckAll(context.Background())
+	require.NoError(t, err)
+
+	check := checks[550]
+	require.NotNil(t, check)
+	assert.Equal(t, env.Clock.Now(), *check.Title.CheckedAt)
+	require.Len(t, check.Added, 1)
+	assert.Equal(t, "Netflix", check.Added[0].ProviderName)
+	assert.Equal(t, "US", check.Added[0].Region, "untracked regions are ignored")
+	assert.Empty(t, check.Changes, "the first check is not a change")
+	assert.Empty(t, env.Notifier.Events)
+}
+
+func TestCheckAll_RecordsChangesAndNotifies(t *testing.T) {
+	netflix, hulu := 8, 15
+	lastCheck := time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC)
+	hasHulu := models.User{ID: uuid.New(), StreamingServices: []models.StreamingService{
+		{ID: 2, Name: "Hulu", TMDBProviderID: &hulu, Regions: []string{"US"}},
+	}}
+	netflixOnly := models.User{ID: uuid.New(), StreamingServices: []models.StreamingService{
+		{ID: 1, Name: "Netflix", TMDBProviderID: &netflix},
+	}}
+
+	env := newTestEnv(t)
+	env.Avail.TracksTitles([]models.TrackedTitle{{TMDBId: 550, MediaType: "movie", Title: "Fight Club", CheckedAt: &lastCheck}})
+	env.TMDB.ReturnsProviders("movie", 550, streamingIn("US", tmdb.WatchProvider{ProviderID: hulu, ProviderName: "Hulu"}))
+	env.Avail.HasAvailability(550, "movie", []models.TitleAvailability{
+		{TMDBId: 550, MediaType: "movie", Region: "US", ProviderID: netflix, ProviderName: "Netflix", AvailableSince: lastCheck},
+	})
+	env.Avail.FindsWatchers(550, "movie", []models.User{hasHulu, netflixOnly})
+
+	checks := map[int]*RecordedCheck{}
+	env.Avail.Records(checks)
+
+	err := env.AvailabilityService("US").CheckAll(context.Background())
+	require.NoError(t, err)
+
+	check := checks[550]
+	require.NotNil(t, check)
+	require.Len(t, check.Added, 1)
+	assert.Equal(t, hulu, check.Added[0].ProviderID)
+	require.Len(t, check.Removed, 1)
+	assert.Equal(t, netflix, check.Removed[0].ProviderID)
+
+	require.Len(t, check.Changes, 2)
+	assert.ElementsMatch(t, []bool{true, false}, []bool{check.Changes[0].Available, check.Changes[1].Available})
+	assert.Equal(t, env.Clock.Now(), check.Changes[0].DetectedAt)
+
+	require.Len(t, env.Notifier.Events, 1)
+	event := env.Notifier.Events[0]
+	assert.Equal(t, hasHulu.ID, event.UserID)
+	assert.Equal(t, "Fight Club", event.Title)
+	assert.Equal(t, "Hulu", event.ServiceName)
+	assert.Equal(t, "US", event.Region)
+}
+
+func TestCheckAll_ContinuesAfterFailure(t *testing.T) {
+	env := newTestEnv(t)
+	env.Avail.TracksTitles([]models.TrackedTitle{
+		{TMDBId: 550, MediaType: "movie"},
+		{TMDBId: 1399, MediaType: "tv"},
+	})
+	env.TMDB.ProvidersFail("movie", 550, errors.New("tmdb down"))
+	env.TMDB.ReturnsProviders("tv", 1399, streamingIn("US"))
+	env.Avail.HasAvailability(1399, "tv", nil)
+
+	checks := map[int]*RecordedCheck{}
+	env.Avail.Records(checks)
+
+	err := env.AvailabilityService("US").CheckAll(context.Background())
+
+	assert.ErrorContains(t, err, "tmdb down")
+	assert.NotContains(t, checks, 550)
+	assert.Contains(t, checks, 1399)
+}
+
+func TestAvailabilityRun_ChecksEachInterval(t *testing.T) {
+	const interval = 6 * time.Hour
+
+	env := newTestEnv(t)
+	env.Avail.TracksTitles(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		env.AvailabilityService("US").Run(ctx, interval)
+		close(done)
+	}()
+
+	env.Clock.BlockUntilWaiting(t)
+	env.Avail.AssertNumberOfCalls(t, "ListTrackedTitles", 1)
+
+	env.Clock.Advance(interval - time.Minute)
+	assert.Equal(t, 1, env.Clock.Pending(), "no check before the interval elapses")
+
+	env.Clock.Advance(time.Minute)
+	env.Clock.BlockUntilWaiting(t)
+	env.Avail.AssertNumberOfCalls(t, "ListTrackedTitles", 2)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not stop after cancellation")
+	}
+}
+
+func TestGetAvailability(t *testing.T) {
+	netflix, hulu, prime := 8, 15, 9
+	userID := uuid.New()
+	older := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)
+
+	env := newTestEnv(t)
+	env.Users.FindsUser(userID, &models.User{ID: userID, StreamingServices: []models.StreamingService{
+		{ID: 1, Name: "Netflix", Slug: "netflix", TMDBProviderID: &netflix},
+		{ID: 2, Name: "Hulu", Slug: "hulu", TMDBProviderID: &hulu, Regions: []string{"US"}},
+	}})
+	env.Watchlist.ReturnsWatchlist(userID, []models.Watchlist{
+		{TMDBId: 550, MediaType: "movie", Title: "Fight Club"},
+		{TMDBId: 603, MediaType: "movie", Title: "The Matrix"},
+		{TMDBId: 1399, MediaType: "tv", Title: "Game of Thrones"},
+	})
+	env.Avail.ReturnsForUser(userID, "US", []models.TitleAvailability{
+		{TMDBId: 1399, MediaType: "tv", Region: "US", ProviderID: hulu, AvailableSince: newer},
+		{TMDBId: 550, MediaType: "movie", Region: "US", ProviderID: netflix, AvailableSince: older},
+		{TMDBId: 603, MediaType: "movie", Region: "US", ProviderID: prime, AvailableSince: newer},
+	})
+
+	items, err := env.AvailabilityService("US", "GB").GetAvailability(userID, "")
+	require.NoError(t, err)
+	require.Len(t, items, 2, "titles only on other services are left out")
+	assert.Equal(t, 1399, items[0].TMDBId, "most recent arrival first")
+	assert.Equal(t, "hulu", items[0].Services[0].Slug)
+	assert.Equal(t, 550, items[1].TMDBId)
+	assert.Equal(t, older, items[1].AvailableSince)
+}
+
+func TestGetAvailability_NormalizesRegion(t *testing.T) {
+	netflix := 8
+	userID := uuid.New()
+
+	env := newTestEnv(t)
+	env.Users.FindsUser(userID, &models.User{ID: userID, StreamingServices: []models.StreamingService{
+		{ID: 1, Name: "Netflix", Slug: "netflix", TMDBProviderID: &netflix},
+	}})
+	env.Watchlist.ReturnsWatchlist(userID, []models.Watchlist{{TMDBId: 550, MediaType: "movie"}})
+	env.Avail.ReturnsForUser(userID, "GB", []models.TitleAvailability{
+		{TMDBId: 550, MediaType: "movie", Region: "GB", ProviderID: netflix},
+	})
+
+	items, err := env.AvailabilityService("US", "GB").GetAvailability(userID, " gb ")
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+}
+
+func TestGetAvailability_DefaultsToFirstTrackedRegion(t *testing.T) {
+	netflix := 8
+	userID := uuid.New()
+
+	env := newTestEnv(t)
+	env.Users.FindsUser(userID, &models.User{ID: userID, StreamingServices: []models.StreamingService{
+		{ID: 1, Name: "Netflix", Slug: "netflix", TMDBProviderID: &netflix},
+	}})
+	env.Watchlist.ReturnsWatchlist(userID, []models.Watchlist{{TMDBId: 550, MediaType: "movie"}})
+	env.Avail.ReturnsForUser(userID, "GB", []models.TitleAvailability{
+		{TMDBId: 550, MediaType: "movie", Region: "GB", ProviderID: netflix},
+	})
+
+	items, err := env.AvailabilityService("GB", "DE").GetAvailability(userID, "")
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+}
+
+func TestGetAvailability_Errors(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("untracked region", func(t *testing.T) {
+		env := newTestEnv(t)
+
+		_, err := env.AvailabilityService("US").GetAvailability(userID, "GB")
+
+		var verr *ValidationError
+		require.ErrorAs(t, err, &verr)
+		assert.Contains(t, verr.Fields, "region")
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.Users.UserNotFound(userID)
+
+		_, err := env.AvailabilityService("US").GetAvailability(userID, "US")
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		env := newTestEnv(t)
+		errDBDown := errors.New("db down")
+		env.Users.FindUserFails(userID, errDBDown)
+
+		_, err := env.AvailabilityService("US").GetAvailability(userID, "US")
+
+		assert.ErrorIs(t, err, errDBDown)
+		assert.NotErrorIs(t, err, ErrNotFound)
+	})
+}
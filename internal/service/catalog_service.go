@@ -0,0 +1,159 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+)
+
+// maxServiceNameLength caps streaming service names, in characters.
+const maxServiceNameLength = 50
+
+var (
+	serviceSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_]{0,39}$`)
+	regionPattern      = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// CatalogService manages the catalog of streaming services users can subscribe to.
+type CatalogService struct {
+	streamingRepo repository.StreamingServiceRepository
+}
+
+// NewCatalogService creates a new CatalogService.
+func NewCatalogService(streamingRepo repository.StreamingServiceRepository) *CatalogService {
+	return &CatalogService{streamingRepo: streamingRepo}
+}
+
+// StreamingServiceInput holds every field of a streaming service an admin can set.
+type StreamingServiceInput struct {
+	Name           string   `json:"name"`
+	Slug           string   `json:"slug"`
+	TMDBProviderID *int     `json:"tmdb_provider_id"`
+	LogoPath       string   `json:"logo_path"`
+	Regions        []string `json:"regions"`
+}
+
+// ListServices returns every streaming service in the catalog.
+func (s *CatalogService) ListServices() ([]models.StreamingService, error) {
+	return s.streamingRepo.List()
+}
+
+// CreateService adds a streaming service. A slug or TMDB provider ID that is
+// already in the catalog returns ErrAlreadyExists.
+func (s *CatalogService) CreateService(input StreamingServiceInput) (*models.StreamingService, error) {
+	service := &models.StreamingService{}
+	if err := applyServiceInput(service, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.streamingRepo.Create(service); err != nil {
+		return nil, catalogWriteError(err)
+	}
+
+	return service, nil
+}
+
+// UpdateService replaces every field of an existing streaming service.
+func (s *CatalogService) UpdateService(id int, input StreamingServiceInput) (*models.StreamingService, error) {
+	service, err := s.streamingRepo.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := applyServiceInput(service, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.streamingRepo.Update(service); err != nil {
+		return nil, catalogWriteError(err)
+	}
+
+	return service, nil
+}
+
+// DeleteService removes a streaming service from the catalog and from every
+// user's subscriptions.
+func (s *CatalogService) DeleteService(id int) error {
+	deleted, err := s.streamingRepo.Delete(id)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// applyServiceInput validates input and copies it onto service. Regions are
+// upper-cased and deduplicated.
+func applyServiceInput(service *models.StreamingService, input StreamingServiceInput) error {
+	verr := &ValidationError{}
+
+	name := strings.TrimSpace(input.Name)
+	switch {
+	case name == "":
+		verr.add("name", "must not be empty")
+	case utf8.RuneCountInString(name) > maxServiceNameLength:
+		verr.add("name", fmt.Sprintf("must be at most %d characters", maxServiceNameLength))
+	}
+
+	slug := strings.ToLower(strings.TrimSpace(input.Slug))
+	if !serviceSlugPattern.MatchString(slug) {
+		verr.add("slug", "must be 1-40 characters of lowercase letters, digits and underscores")
+	}
+
+	if input.TMDBProviderID != nil && *input.TMDBProviderID <= 0 {
+		verr.add("tmdb_provider_id", "must be a positive TMDB watch provider ID")
+	}
+
+	logoPath := strings.TrimSpace(input.LogoPath)
+	if logoPath != "" && (!strings.HasPrefix(logoPath, "/") || strings.ContainsAny(logoPath, " ?#")) {
+		verr.add("logo_path", "must be a TMDB image path such as /logo.jpg")
+	}
+
+	regions := make([]string, 0, len(input.Regions))
+	for _, region := range input.Regions {
+		region = strings.ToUpper(strings.TrimSpace(region))
+		if !regionPattern.MatchString(region) {
+			verr.add("regions", "must be ISO 3166-1 alpha-2 country codes")
+
+			break
+		}
+
+		if !slices.Contains(regions, region) {
+			regions = append(regions, region)
+		}
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return err
+	}
+
+	service.Name = name
+	service.Slug = slug
+	service.TMDBProviderID = input.TMDBProviderID
+	service.LogoPath = logoPath
+	service.Regions = regions
+
+	return nil
+}
+
+func catalogWriteError(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrAlreadyExists
+	}
+
+	return err
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+func providerID(n int) *int {
+	return &n
+}
+
+func TestCreateService(t *testing.T) {
+	tests := map[string]struct {
+		input  StreamingServiceInput
+		setup  func(*TestEnv)
+		fields []string
+		err    error
+	}{
+		"valid": {StreamingServiceInput{
+			Name: "Crunchyroll", Slug: "crunchyroll", TMDBProviderID: providerID(283), LogoPath: "/logo.jpg",
+		}, func(env *TestEnv) { env.Streaming.CreatesService(nil) }, nil, nil},
+		"without provider mapping": {StreamingServiceInput{Name: "Local", Slug: "local"}, func(env *TestEnv) {
+			env.Streaming.CreatesService(nil)
+		}, nil, nil},
+		"invalid fields": {StreamingServiceInput{
+			Name: "", Slug: "has space", TMDBProviderID: providerID(0),
+			LogoPath: "https://example.com/logo.jpg", Regions: []string{"USA"},
+		}, func(_ *TestEnv) {}, []string{"name", "slug", "tmdb_provider_id", "logo_path", "regions"}, ErrInvalidInput},
+		"duplicate": {StreamingServiceInput{Name: "Netflix", Slug: "netflix", TMDBProviderID: providerID(8)}, func(env *TestEnv) {
+			env.Streaming.CreatesService(gorm.ErrDuplicatedKey)
+		}, nil, ErrAlreadyExists},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			tt.setup(env)
+
+			service, err := env.CatalogService().CreateService(tt.input)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				if tt.fields != nil {
+					var verr *ValidationError
+					require.ErrorAs(t, err, &verr)
+					for _, field := range tt.fields {
+						assert.Contains(t, verr.Fields, field)
+					}
+				}
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.input.TMDBProviderID, service.TMDBProviderID)
+		})
+	}
+
+	t.Run("normalizes fields", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.Streaming.CreatesService(nil)
+
+		service, err := env.CatalogService().CreateService(StreamingServiceInput{
+			Name: " Crunchyroll ", Slug: "Crunchyroll", Regions: []string{"us", "GB", "US"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Crunchyroll", service.Name)
+		assert.Equal(t, "crunchyroll", service.Slug)
+		assert.Equal(t, []string{"US", "GB"}, service.Regions)
+	})
+}
+
+func TestUpdateService(t *testing.T) {
+	tests := map[string]struct {
+		setup func(*TestEnv)
+		err   error
+	}{
+		"updates": {func(env *TestEnv) {
+			env.Streaming.FindsService(&models.StreamingService{ID: 4, Name: "HBO Max", Slug: "hbo_max"})
+			env.Streaming.UpdatesService(nil)
+		}, nil},
+		"not found": {func(env *TestEnv) {
+			env.Streaming.ServiceNotFound(4)
+		}, ErrNotFound},
+		"provider ID taken": {func(env *TestEnv) {
+			env.Streaming.FindsService(&models.StreamingService{ID: 4, Name: "HBO Max", Slug: "hbo_max"})
+			env.Streaming.UpdatesService(gorm.ErrDuplicatedKey)
+		}, ErrAlreadyExists},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			tt.setup(env)
+
+			service, err := env.CatalogService().UpdateService(4, StreamingServiceInput{
+				Name: "Max", Slug: "max", TMDBProviderID: providerID(1899),
+			})
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Max", service.Name)
+			assert.Equal(t, 4, service.ID)
+		})
+	}
+}
+
+func TestDeleteService(t *testing.T) {
+	tests := map[string]struct {
+		deleted int64
+		err     error
+	}{
+		"deletes":   {1, nil},
+		"not found": {0, ErrNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			env.Streaming.DeletesService(7, tt.deleted)
+
+			err := env.CatalogService().DeleteService(7)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
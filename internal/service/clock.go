@@ -0,0 +1,23 @@
+package service
+
+import "time"
+
+// Clock tells the time and waits. Background jobs take one so tests can
+// control time.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the wall clock.
+type SystemClock struct{}
+
+// Now returns the current time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After waits for d to elapse and then sends the current time.
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+)
+
+// Bounds on diary entries.
+const (
+	minDiaryRating     = 0.5
+	maxDiaryRating     = 5.0
+	maxDiaryNoteLength = 5000
+)
+
+// DiaryEntryInput logs a viewing. WatchedOn is a YYYY-MM-DD date and
+// defaults to today; a nil Rating leaves the viewing unrated.
+type DiaryEntryInput struct {
+	TMDBId     int      `json:"tmdb_id"`
+	MediaType  string   `json:"media_type"`
+	Title      string   `json:"title"`
+	PosterPath string   `json:"poster_path"`
+	WatchedOn  string   `json:"watched_on"`
+	Rating     *float64 `json:"rating"`
+	Rewatch    bool     `json:"rewatch"`
+	Notes      string   `json:"notes"`
+
+	// RemoveFromWatchlist takes the title off the watchlist along with
+	// logging it.
+	RemoveFromWatchlist bool `json:"remove_from_watchlist"`
+}
+
+// DiaryEntryUpdate holds the diary fields to change; nil fields are left as
+// they are. A rating of 0 clears it.
+type DiaryEntryUpdate struct {
+	WatchedOn *string  `json:"watched_on"`
+	Rating    *float64 `json:"rating"`
+	Rewatch   *bool    `json:"rewatch"`
+	Notes     *string  `json:"notes"`
+}
+
+// DiaryService keeps the user's log of what they watched.
+type DiaryService struct {
+	diaryRepo repository.DiaryRepository
+	clock     Clock
+}
+
+// NewDiaryService creates a new DiaryService.
+func NewDiaryService(diaryRepo repository.DiaryRepository, clock Clock) *DiaryService {
+	return &DiaryService{
+		diaryRepo: diaryRepo,
+		clock:     clock,
+	}
+}
+
+// ListEntries returns the user's diary, most recently watched first.
+func (s *DiaryService) ListEntries(userID uuid.UUID) ([]models.DiaryEntry, error) {
+	return s.diaryRepo.ListByUserID(userID)
+}
+
+// LogEntry adds a viewing to the diary and reports whether the title was
+// taken off the watchlist. The viewing is marked a rewatch if the user has
+// logged the title before. Invalid fields are reported together in a
+// *ValidationError.
+func (s *DiaryService) LogEntry(userID uuid.UUID, input DiaryEntryInput) (*models.DiaryEntry, bool, error) {
+	verr := &ValidationError{}
+	entry := &models.DiaryEntry{
+		UserID:     userID,
+		TMDBId:     input.TMDBId,
+		MediaType:  input.MediaType,
+		Title:      strings.TrimSpace(input.Title),
+		PosterPath: input.PosterPath,
+		Rewatch:    input.Rewatch,
+	}
+
+	if input.TMDBId <= 0 {
+		verr.add("tmdb_id", "is required")
+	}
+
+	if input.MediaType != "movie" && input.MediaType != "tv" {
+		verr.add("media_type", "must be movie or tv")
+	}
+
+	entry.WatchedOn = s.today()
+	if input.WatchedOn != "" {
+		entry.WatchedOn = s.watchedOn(verr, input.WatchedOn, entry.WatchedOn)
+	}
+
+	if input.Rating != nil {
+		entry.Rating = diaryRating(verr, *input.Rating)
+	}
+
+	entry.Notes = diaryNotes(verr, input.Notes)
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, false, err
+	}
+
+	if !entry.Rewatch {
+		watched, err := s.diaryRepo.HasWatched(userID, entry.TMDBId, entry.MediaType, entry.WatchedOn)
+		if err != nil {
+			return nil, false, err
+		}
+		entry.Rewatch = watched
+	}
+
+	removed, err := s.diaryRepo.Create(entry, input.RemoveFromWatchlist)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return entry, removed, nil
+}
+
+// UpdateEntry edits a diary entry of the user.
+func (s *DiaryService) UpdateEntry(userID uuid.UUID, id uuid.UUID, update DiaryEntryUpdate) (*models.DiaryEntry, error) {
+	entry, err := s.diaryRepo.Find(userID, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	verr := &ValidationError{}
+
+	if update.WatchedOn != nil {
+		entry.WatchedOn = s.watchedOn(verr, *update.WatchedOn, entry.WatchedOn)
+	}
+
+	if update.Rating != nil {
+		entry.Rating = nil
+		if *update.Rating != 0 {
+			entry.Rating = diaryRating(verr, *update.Rating)
+		}
+	}
+
+	if update.Rewatch != nil {
+		entry.Rewatch = *update.Rewatch
+	}
+
+	if update.Notes != nil {
+		entry.Notes = diaryNotes(verr, *update.Notes)
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	if err := s.diaryRepo.Update(entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// DeleteEntry removes a diary entry of the user.
+func (s *DiaryService) DeleteEntry(userID uuid.UUID, id uuid.UUID) error {
+	deleted, err := s.diaryRepo.Delete(userID, id)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// today is the current UTC date. Viewings may be dated a day past it, as it
+// is already tomorrow for users east of UTC.
+func (s *DiaryService) today() time.Time {
+	now := s.clock.Now().UTC()
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// watchedOn parses a YYYY-MM-DD date that isn't in the future, recording a
+// problem and returning fallback otherwise.
+func (s *DiaryService) watchedOn(verr *ValidationError, value string, fallback time.Time) time.Time {
+	date, err := time.Parse(airDateLayout, value)
+	switch {
+	case err != nil:
+		verr.add("watched_on", "must be a date in YYYY-MM-DD format")
+	case date.After(s.today().AddDate(0, 0, 1)):
+		verr.add("watched_on", "must not be in the future")
+	default:
+		return date
+	}
+
+	return fallback
+}
+
+// diaryRating checks a rating is a whole or half star from 0.5 to 5.
+func diaryRating(verr *ValidationError, rating float64) *float64 {
+	if rating < minDiaryRating || rating > maxDiaryRating || math.Mod(rating*2, 1) != 0 {
+		verr.add("rating", fmt.Sprintf("must be from %.1f to %.0f in steps of 0.5", minDiaryRating, maxDiaryRating))
+
+		return nil
+	}
+
+	return &rating
+}
+
+func diaryNotes(verr *ValidationError, notes string) string {
+	notes = strings.TrimSpace(notes)
+	if utf8.RuneCountInString(notes) > maxDiaryNoteLength {
+		verr.add("notes", fmt.Sprintf("must be at most %d characters", maxDiaryNoteLength))
+	}
+
+	return notes
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+func rating(stars float64) *float64 {
+	return &stars
+}
+
+func TestLogEntry(t *testing.T) {
+	userID := uuid.New()
+	env := newTestEnv(t)
+
+	var created *models.DiaryEntry
+	env.Diary.Creates(&created, true, true)
+
+	entry, removed, err := env.DiaryService().LogEntry(userID, DiaryEntryInput{
+		TMDBId: 550, MediaType: "movie", Title: " Fight Club ", WatchedOn: "2026-02-14",
+		Rating: rating(4.5), Rewatch: true, Notes: " still holds up ", RemoveFromWatchlist: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, removed)
+	assert.Same(t, created, entry)
+	assert.Equal(t, userID, entry.UserID)
+	assert.Equal(t, "Fight Club", entry.Title)
+	assert.Equal(t, time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC), entry.WatchedOn)
+	assert.Equal(t, 4.5, *entry.Rating)
+	assert.True(t, entry.Rewatch)
+	assert.Equal(t, "still holds up", entry.Notes)
+}
+
+func TestLogEntry_DefaultsToToday(t *testing.T) {
+	userID := uuid.New()
+	today := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	env := newTestEnv(t)
+
+	var created *models.DiaryEntry
+	env.Diary.HasWatched(userID, 1399, "tv", today, false)
+	env.Diary.Creates(&created, false, false)
+
+	entry, removed, err := env.DiaryService().LogEntry(userID, DiaryEntryInput{TMDBId: 1399, MediaType: "tv"})
+	require.NoError(t, err)
+	assert.False(t, removed)
+	assert.Equal(t, today, entry.WatchedOn)
+	assert.Nil(t, entry.Rating)
+	assert.False(t, entry.Rewatch)
+}
+
+func TestLogEntry_FlagsRewatch(t *testing.T) {
+	userID := uuid.New()
+	env := newTestEnv(t)
+
+	var created *models.DiaryEntry
+	env.Diary.HasWatched(userID, 550, "movie", time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC), true)
+	env.Diary.Creates(&created, false, false)
+
+	entry, _, err := env.DiaryService().LogEntry(userID, DiaryEntryInput{TMDBId: 550, MediaType: "movie", WatchedOn: "2026-02-14"})
+	require.NoError(t, err)
+	assert.True(t, entry.Rewatch, "logged before, so a rewatch")
+}
+
+func TestLogEntry_AllowsTomorrowUTC(t *testing.T) {
+	userID := uuid.New()
+	tomorrow := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	env := newTestEnv(t)
+
+	var created *models.DiaryEntry
+	env.Diary.HasWatched(userID, 550, "movie", tomorrow, false)
+	env.Diary.Creates(&created, false, false)
+
+	entry, _, err := env.DiaryService().LogEntry(userID, DiaryEntryInput{TMDBId: 550, MediaType: "movie", WatchedOn: "2026-03-02"})
+	require.NoError(t, err, "already the 2nd east of UTC")
+	assert.Equal(t, tomorrow, entry.WatchedOn)
+}
+
+func TestLogEntry_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		input DiaryEntryInput
+		field string
+	}{
+		"missing tmdb id":      {DiaryEntryInput{MediaType: "movie"}, "tmdb_id"},
+		"unknown media type":   {DiaryEntryInput{TMDBId: 550, MediaType: "person"}, "media_type"},
+		"malformed date":       {DiaryEntryInput{TMDBId: 550, MediaType: "movie", WatchedOn: "14/02/2026"}, "watched_on"},
+		"future date":          {DiaryEntryInput{TMDBId: 550, MediaType: "movie", WatchedOn: "2026-03-03"}, "watched_on"},
+		"zero rating":          {DiaryEntryInput{TMDBId: 550, MediaType: "movie", Rating: rating(0)}, "rating"},
+		"rating above five":    {DiaryEntryInput{TMDBId: 550, MediaType: "movie", Rating: rating(5.5)}, "rating"},
+		"not a half star":      {DiaryEntryInput{TMDBId: 550, MediaType: "movie", Rating: rating(3.7)}, "rating"},
+		"notes over the limit": {DiaryEntryInput{TMDBId: 550, MediaType: "movie", Notes: strings.Repeat("a", maxDiaryNoteLength+1)}, "notes"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+
+			_, _, err := env.DiaryService().LogEntry(uuid.New(), tt.input)
+
+			var verr *ValidationError
+			require.True(t, errors.As(err, &verr))
+			assert.Contains(t, verr.Fields, tt.field)
+			env.Diary.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUpdateEntry(t *testing.T) {
+	userID := uuid.New()
+	watchedOn := time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC)
+	str := func(s string) *string { return &s }
+	rewatch := true
+
+	tests := map[string]struct {
+		update DiaryEntryUpdate
+		check  func(t *testing.T, entry *models.DiaryEntry)
+	}{
+		"changes the given fields": {
+			update: DiaryEntryUpdate{WatchedOn: str("2026-02-15"), Rating: rating(5), Rewatch: &rewatch},
+			check: func(t *testing.T, entry *models.DiaryEntry) {
+				assert.Equal(t, watchedOn.AddDate(0, 0, 1), entry.WatchedOn)
+				assert.Equal(t, 5.0, *entry.Rating)
+				assert.True(t, entry.Rewatch)
+				assert.Equal(t, "first watch", entry.Notes)
+			},
+		},
+		"zero rating clears it": {
+			update: DiaryEntryUpdate{Rating: rating(0), Notes: str("")},
+			check: func(t *testing.T, entry *models.DiaryEntry) {
+				assert.Nil(t, entry.Rating)
+				assert.Empty(t, entry.Notes)
+				assert.Equal(t, watchedOn, entry.WatchedOn)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			entry := &models.DiaryEntry{ID: uuid.New(), UserID: userID, TMDBId: 550, WatchedOn: watchedOn, Rating: rating(3), Notes: "first watch"}
+			env := newTestEnv(t)
+			env.Diary.Finds(entry)
+			env.Diary.On("Update", entry).Return(nil)
+
+			updated, err := env.DiaryService().UpdateEntry(userID, entry.ID, tt.update)
+			require.NoError(t, err)
+			tt.check(t, updated)
+		})
+	}
+}
+
+func TestUpdateEntry_Invalid(t *testing.T) {
+	userID := uuid.New()
+	entry := &models.DiaryEntry{ID: uuid.New(), UserID: userID, TMDBId: 550, WatchedOn: time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC)}
+	watchedOn := "2027-01-01"
+	env := newTestEnv(t)
+	env.Diary.Finds(entry)
+
+	_, err := env.DiaryService().UpdateEntry(userID, entry.ID, DiaryEntryUpdate{WatchedOn: &watchedOn, Rating: rating(0.25)})
+
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.Contains(t, verr.Fields, "watched_on")
+	assert.Contains(t, verr.Fields, "rating")
+	env.Diary.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestUpdateEntry_NotFound(t *testing.T) {
+	userID, id := uuid.New(), uuid.New()
+	rewatch := true
+	env := newTestEnv(t)
+	env.Diary.NotFound(userID, id)
+
+	_, err := env.DiaryService().UpdateEntry(userID, id, DiaryEntryUpdate{Rewatch: &rewatch})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDeleteEntry(t *testing.T) {
+	tests := map[string]struct {
+		deleted int64
+		wantErr error
+	}{
+		"deleted":   {deleted: 1},
+		"not found": {deleted: 0, wantErr: ErrNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			userID, id := uuid.New(), uuid.New()
+			env := newTestEnv(t)
+			env.Diary.On("Delete", userID, id).Return(tt.deleted, nil)
+
+			err := env.DiaryService().DeleteEntry(userID, id)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
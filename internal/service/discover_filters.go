@@ -0,0 +1,227 @@
+package service
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+// Discover sort fields. Each sorts descending unless suffixed with ".asc",
+// except title, which sorts ascending unless suffixed with ".desc".
+const (
+	SortPopularity  = "popularity"
+	SortRating      = "rating"
+	SortVotes       = "votes"
+	SortReleaseDate = "release_date"
+	SortTitle       = "title"
+	SortRevenue     = "revenue"
+)
+
+// Bounds on the discover year filters.
+const (
+	minDiscoverYear = 1870
+	maxDiscoverYear = 2100
+)
+
+var languagePattern = regexp.MustCompile(`^[a-z]{2}$`)
+
+// DiscoverFilters narrow the /discover feed. Zero values are unset.
+type DiscoverFilters struct {
+	MediaType string
+
+	// Genres are slugs or TMDB genre IDs. They must all match, unless
+	// MatchAnyGenre is set.
+	Genres        []string
+	MatchAnyGenre bool
+
+	YearFrom   int
+	YearTo     int
+	MinRating  float64
+	MinVotes   int
+	MinRuntime int
+	MaxRuntime int
+
+	// Language is the ISO 639-1 code of the original language.
+	Language string
+
+	// Sort is one of the Sort constants, optionally suffixed with ".asc"
+	// or ".desc".
+	Sort string
+
+	// OnMyServices limits results to the user's streaming services in Region.
+	OnMyServices bool
+	Region       string
+
+	Page int
+}
+
+// DiscoverFiltered returns movies or TV shows matching filters. Invalid
+// filters, including contradictory ones, are reported as a ValidationError.
+func (s *MovieService) DiscoverFiltered(userID uuid.UUID, filters DiscoverFilters) ([]models.Movie, error) {
+	var genres map[string]int
+	if len(filters.Genres) > 0 {
+		genres = s.genreIndex(filters.MediaType)
+	}
+
+	params, err := filters.toParams(genres)
+	if err != nil {
+		return nil, err
+	}
+
+	if filters.OnMyServices {
+		params.WatchProviders, err = s.userProviderIDs(userID, params.WatchRegion)
+		if err != nil {
+			return nil, err
+		}
+		params.MonetizationTypes = watchableMonetization
+	}
+
+	movies, err := s.tmdb.Discover(filters.MediaType, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.enrichWithWatchlist(userID, movies)
+}
+
+// toParams validates the filters and maps them onto TMDB's discover params,
+// resolving genre slugs with genres.
+func (f DiscoverFilters) toParams(genres map[string]int) (tmdb.DiscoverParams, error) {
+	verr := &ValidationError{}
+	params := tmdb.DiscoverParams{
+		AnyGenre:       f.MatchAnyGenre,
+		YearFrom:       f.YearFrom,
+		YearTo:         f.YearTo,
+		MinVoteAverage: f.MinRating,
+		MinVoteCount:   f.MinVotes,
+		MinRuntime:     f.MinRuntime,
+		MaxRuntime:     f.MaxRuntime,
+		Page:           f.Page,
+	}
+
+	if f.MediaType != "movie" && f.MediaType != "tv" {
+		verr.add("media_type", "must be movie or tv")
+	}
+
+	for _, genre := range f.Genres {
+		id, ok := lookupGenre(genres, genre)
+		if !ok {
+			verr.add("genres", "unknown genre "+genre)
+
+			continue
+		}
+		params.Genres = append(params.Genres, id)
+	}
+
+	if f.MatchAnyGenre && len(f.Genres) == 0 {
+		verr.add("genre_match", "requires genres")
+	}
+
+	for field, year := range map[string]int{"year_from": f.YearFrom, "year_to": f.YearTo} {
+		if year != 0 && (year < minDiscoverYear || year > maxDiscoverYear) {
+			verr.add(field, "must be a year between "+strconv.Itoa(minDiscoverYear)+" and "+strconv.Itoa(maxDiscoverYear))
+		}
+	}
+
+	if f.YearFrom != 0 && f.YearTo != 0 && f.YearFrom > f.YearTo {
+		verr.add("year_from", "must not be after year_to")
+	}
+
+	if f.MinRating < 0 || f.MinRating > 10 {
+		verr.add("min_rating", "must be between 0 and 10")
+	}
+
+	if f.MinVotes < 0 {
+		verr.add("min_votes", "must not be negative")
+	}
+
+	if f.MinRuntime < 0 {
+		verr.add("runtime_min", "must not be negative")
+	}
+
+	if f.MaxRuntime < 0 {
+		verr.add("runtime_max", "must not be negative")
+	}
+
+	if f.MinRuntime > 0 && f.MaxRuntime > 0 && f.MinRuntime > f.MaxRuntime {
+		verr.add("runtime_min", "must not be greater than runtime_max")
+	}
+
+	if f.Language != "" {
+		params.OriginalLanguage = strings.ToLower(f.Language)
+		if !languagePattern.MatchString(params.OriginalLanguage) {
+			verr.add("language", "must be an ISO 639-1 language code")
+		}
+	}
+
+	if f.Sort != "" {
+		sortBy, msg := tmdbSort(f.MediaType, f.Sort)
+		if msg != "" {
+			verr.add("sort", msg)
+		}
+		params.SortBy = sortBy
+	}
+
+	if f.OnMyServices {
+		params.WatchRegion = strings.ToUpper(strings.TrimSpace(f.Region))
+		if params.WatchRegion == "" {
+			params.WatchRegion = defaultWatchRegion
+		} else if !regionPattern.MatchString(params.WatchRegion) {
+			verr.add("region", "must be an ISO 3166-1 alpha-2 country code")
+		}
+	} else if f.Region != "" {
+		verr.add("region", "only applies with services=mine")
+	}
+
+	return params, verr.errOrNil()
+}
+
+// tmdbSort maps a sort such as "rating" or "title.desc" onto TMDB's sort_by
+// for mediaType. It returns a message when the sort is not supported.
+func tmdbSort(mediaType string, sort string) (string, string) {
+	field, dir, hasDir := strings.Cut(sort, ".")
+	if hasDir && dir != "asc" && dir != "desc" {
+		return "", "direction must be asc or desc"
+	}
+
+	var tmdbField string
+	switch field {
+	case SortPopularity:
+		tmdbField = "popularity"
+	case SortRating:
+		tmdbField = "vote_average"
+	case SortVotes:
+		tmdbField = "vote_count"
+	case SortReleaseDate:
+		tmdbField = "primary_release_date"
+		if mediaType == "tv" {
+			tmdbField = "first_air_date"
+		}
+	case SortTitle:
+		tmdbField = "title"
+		if mediaType == "tv" {
+			tmdbField = "name"
+		}
+		if !hasDir {
+			dir = "asc"
+		}
+	case SortRevenue:
+		if mediaType == "tv" {
+			return "", "revenue is only available for movies"
+		}
+		tmdbField = "revenue"
+	default:
+		return "", "must be one of popularity, rating, votes, release_date, title, revenue"
+	}
+
+	if dir == "" {
+		dir = "desc"
+	}
+
+	return tmdbField + "." + dir, ""
+}
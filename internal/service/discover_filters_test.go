@@ -0,0 +1,136 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+func TestDiscoverFiltered(t *testing.T) {
+	tests := map[string]struct {
+		filters DiscoverFilters
+		params  tmdb.DiscoverParams
+	}{
+		"all genres": {
+			DiscoverFilters{MediaType: "movie", Genres: []string{"action", "878"}, Page: 1},
+			tmdb.DiscoverParams{Genres: []int{28, 878}, Page: 1},
+		},
+		"any tv genre": {
+			DiscoverFilters{MediaType: "tv", Genres: []string{"sci_fi", "comedy"}, MatchAnyGenre: true, Page: 1},
+			tmdb.DiscoverParams{Genres: []int{10765, 35}, AnyGenre: true, Page: 1},
+		},
+		"catalog slugs": {
+			DiscoverFilters{MediaType: "tv", Genres: []string{"drama", "Sci_Fi_And_Fantasy"}, Page: 1},
+			tmdb.DiscoverParams{Genres: []int{18, 10765}, Page: 1},
+		},
+		"ranges and language": {
+			DiscoverFilters{
+				MediaType: "movie", YearFrom: 1990, YearTo: 1999, MinRating: 7.5, MinVotes: 100,
+				MinRuntime: 80, MaxRuntime: 120, Language: "FR", Page: 2,
+			},
+			tmdb.DiscoverParams{
+				YearFrom: 1990, YearTo: 1999, MinVoteAverage: 7.5, MinVoteCount: 100,
+				MinRuntime: 80, MaxRuntime: 120, OriginalLanguage: "fr", Page: 2,
+			},
+		},
+		"sort rating": {
+			DiscoverFilters{MediaType: "movie", Sort: "rating"},
+			tmdb.DiscoverParams{SortBy: "vote_average.desc"},
+		},
+		"sort tv title": {
+			DiscoverFilters{MediaType: "tv", Sort: "title"},
+			tmdb.DiscoverParams{SortBy: "name.asc"},
+		},
+		"sort release date ascending": {
+			DiscoverFilters{MediaType: "tv", Sort: "release_date.asc"},
+			tmdb.DiscoverParams{SortBy: "first_air_date.asc"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			userID := uuid.New()
+			if len(tt.filters.Genres) > 0 {
+				genres := movieGenres
+				if tt.filters.MediaType == "tv" {
+					genres = tvGenres
+				}
+				env.TMDB.ReturnsGenreList(tt.filters.MediaType, genres)
+			}
+			env.TMDB.DiscoverReturns(tt.filters.MediaType, tt.params, []models.Movie{{ID: 550}})
+			env.Watchlist.SavesAmong(userID, []int{550})
+
+			movies, err := env.MovieService().DiscoverFiltered(userID, tt.filters)
+
+			require.NoError(t, err)
+			assert.Len(t, movies, 1)
+		})
+	}
+}
+
+func TestDiscoverFiltered_OnMyServices(t *testing.T) {
+	netflix := 8
+	userID := uuid.New()
+
+	env := newTestEnv(t)
+	env.TMDB.ReturnsGenreList("movie", movieGenres)
+	env.Users.FindsUser(userID, &models.User{ID: userID, StreamingServices: []models.StreamingService{
+		{Slug: "netflix", TMDBProviderID: &netflix},
+	}})
+	env.TMDB.DiscoverReturns("movie", tmdb.DiscoverParams{
+		Genres: []int{35}, WatchProviders: []int{8}, WatchRegion: "GB", MonetizationTypes: watchableMonetization, Page: 1,
+	}, []models.Movie{{ID: 550}})
+	env.Watchlist.SavesAmong(userID, []int{550})
+
+	movies, err := env.MovieService().DiscoverFiltered(userID, DiscoverFilters{
+		MediaType: "movie", Genres: []string{"comedy"}, OnMyServices: true, Region: "gb", Page: 1,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, movies, 1)
+}
+
+func TestDiscoverFiltered_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		filters DiscoverFilters
+		fields  []string
+	}{
+		"media type":              {DiscoverFilters{MediaType: "anime"}, []string{"media_type"}},
+		"unknown genre":           {DiscoverFilters{MediaType: "tv", Genres: []string{"horror"}}, []string{"genres"}},
+		"match without genre":     {DiscoverFilters{MediaType: "movie", MatchAnyGenre: true}, []string{"genre_match"}},
+		"year out of range":       {DiscoverFilters{MediaType: "movie", YearFrom: 99, YearTo: 3000}, []string{"year_from", "year_to"}},
+		"years reversed":          {DiscoverFilters{MediaType: "movie", YearFrom: 2000, YearTo: 1990}, []string{"year_from"}},
+		"rating":                  {DiscoverFilters{MediaType: "movie", MinRating: 11}, []string{"min_rating"}},
+		"negative votes":          {DiscoverFilters{MediaType: "movie", MinVotes: -1}, []string{"min_votes"}},
+		"runtime reversed":        {DiscoverFilters{MediaType: "movie", MinRuntime: 120, MaxRuntime: 90}, []string{"runtime_min"}},
+		"language":                {DiscoverFilters{MediaType: "movie", Language: "eng"}, []string{"language"}},
+		"unknown sort":            {DiscoverFilters{MediaType: "movie", Sort: "random"}, []string{"sort"}},
+		"sort direction":          {DiscoverFilters{MediaType: "movie", Sort: "rating.up"}, []string{"sort"}},
+		"tv revenue":              {DiscoverFilters{MediaType: "tv", Sort: "revenue"}, []string{"sort"}},
+		"region without services": {DiscoverFilters{MediaType: "movie", Region: "US"}, []string{"region"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			if len(tt.filters.Genres) > 0 {
+				env.TMDB.ReturnsGenreList(tt.filters.MediaType, tvGenres)
+			}
+
+			_, err := env.MovieService().DiscoverFiltered(uuid.New(), tt.filters)
+
+			var verr *ValidationError
+			require.ErrorAs(t, err, &verr)
+			for _, field := range tt.fields {
+				assert.Contains(t, verr.Fields, field)
+			}
+			assert.Len(t, verr.Fields, len(tt.fields))
+		})
+	}
+}
@@ -1,7 +1,11 @@
 // Package service implements business logic for the API.
 package service
 
-import "errors"
+import (
+	"errors"
+	"sort"
+	"strings"
+)
 
 // Sentinel errors returned by service methods.
 var (
@@ -10,4 +14,66 @@ var (
 	ErrInvalidToken  = errors.New("invalid token")
 	ErrMissingClaims = errors.New("missing required claims")
 	ErrUnknownGenre  = errors.New("unknown genre")
+	ErrTokenReused   = errors.New("refresh token reused")
+	ErrInvalidInput  = errors.New("invalid input")
+	ErrInvalidScope  = errors.New("invalid scope")
+
+	ErrUnknownProvider = errors.New("unknown identity provider")
+	ErrLastIdentity    = errors.New("cannot unlink the only identity")
+	ErrHandleTaken     = errors.New("handle already taken")
+	ErrAccountBanned   = errors.New("account is banned")
+	ErrProtectedUser   = errors.New("action not allowed on this user")
+
+	ErrNoStreamingServices = errors.New("no streaming services in region")
+
+	ErrForbidden       = errors.New("forbidden")
+	ErrVersionConflict = errors.New("version conflict")
+
+	ErrNotTVShow = errors.New("not a tv show")
+	ErrNotAired  = errors.New("episode has not aired")
+
+	// Device authorization polling outcomes (RFC 8628 section 3.5).
+	ErrAuthorizationPending = errors.New("authorization_pending")
+	ErrSlowDown             = errors.New("slow_down")
+	ErrExpiredToken         = errors.New("expired_token")
 )
+
+// ValidationError reports which request fields were rejected and why. It
+// matches ErrInvalidInput with errors.Is.
+type ValidationError struct {
+	Fields map[string]string
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for field, msg := range e.Fields {
+		parts = append(parts, field+": "+msg)
+	}
+	sort.Strings(parts)
+
+	return "invalid input: " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidInput
+}
+
+// add records a problem with a field, keeping the first one reported.
+func (e *ValidationError) add(field string, msg string) {
+	if e.Fields == nil {
+		e.Fields = map[string]string{}
+	}
+
+	if _, ok := e.Fields[field]; !ok {
+		e.Fields[field] = msg
+	}
+}
+
+// errOrNil returns e if any field was rejected.
+func (e *ValidationError) errOrNil() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+
+	return e
+}
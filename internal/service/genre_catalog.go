@@ -0,0 +1,141 @@
+package service
+
+import (
+	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// movieGenreAliases are the genre slugs clients used before the catalog was
+// fetched from TMDB. They keep resolving alongside the catalog's own slugs.
+var movieGenreAliases = map[string]int{
+	"action":    28,
+	"comedy":    35,
+	"horror":    27,
+	"romance":   10749,
+	"mystery":   9648,
+	"sci_fi":    878,
+	"western":   37,
+	"animation": 16,
+	"tv_movie":  10770,
+}
+
+// tvGenreAliases map movie genre slugs onto TMDB's TV genres, which merge
+// some of them (action and adventure, sci-fi and fantasy).
+var tvGenreAliases = map[string]int{
+	"action":      10759,
+	"adventure":   10759,
+	"animation":   16,
+	"comedy":      35,
+	"crime":       80,
+	"documentary": 99,
+	"drama":       18,
+	"family":      10751,
+	"kids":        10762,
+	"mystery":     9648,
+	"reality":     10764,
+	"sci_fi":      10765,
+	"fantasy":     10765,
+	"war":         10768,
+	"western":     37,
+}
+
+func genreAliases(mediaType string) map[string]int {
+	if mediaType == "tv" {
+		return tvGenreAliases
+	}
+
+	return movieGenreAliases
+}
+
+// GetGenres returns TMDB's genres for movies or TV shows with their slugs
+// and aliases, ordered by name.
+func (s *MovieService) GetGenres(mediaType string) ([]models.Genre, error) {
+	if mediaType != "movie" && mediaType != "tv" {
+		verr := &ValidationError{}
+		verr.add("media_type", "must be movie or tv")
+
+		return nil, verr
+	}
+
+	list, err := s.tmdb.GetGenres(mediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := genreAliases(mediaType)
+	genres := make([]models.Genre, 0, len(list))
+	for _, g := range list {
+		genre := models.Genre{ID: g.ID, Name: g.Name, Slug: genreSlug(g.Name), MediaType: mediaType}
+		for alias, id := range aliases {
+			if id == g.ID && alias != genre.Slug {
+				genre.Aliases = append(genre.Aliases, alias)
+			}
+		}
+		slices.Sort(genre.Aliases)
+		genres = append(genres, genre)
+	}
+
+	slices.SortFunc(genres, func(a, b models.Genre) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return genres, nil
+}
+
+// resolveGenre maps a genre slug, alias or numeric TMDB genre ID to a genre
+// ID of mediaType.
+func (s *MovieService) resolveGenre(mediaType string, genre string) (int, bool) {
+	if id, ok := lookupGenre(nil, genre); ok {
+		return id, true
+	}
+
+	return lookupGenre(s.genreIndex(mediaType), genre)
+}
+
+// genreIndex maps the catalog's slugs and the aliases of mediaType to genre
+// IDs. If the catalog cannot be fetched, only the aliases resolve.
+func (s *MovieService) genreIndex(mediaType string) map[string]int {
+	index := maps.Clone(genreAliases(mediaType))
+
+	list, err := s.tmdb.GetGenres(mediaType)
+	if err != nil {
+		log.Printf("Failed to fetch %s genres, using aliases only: %v", mediaType, err)
+
+		return index
+	}
+
+	for _, g := range list {
+		index[genreSlug(g.Name)] = g.ID
+	}
+
+	return index
+}
+
+// lookupGenre resolves a numeric genre ID or a slug in index.
+func lookupGenre(index map[string]int, genre string) (int, bool) {
+	if id, err := strconv.Atoi(genre); err == nil {
+		return id, id > 0
+	}
+
+	id, ok := index[strings.ToLower(genre)]
+
+	return id, ok
+}
+
+// genreSlug derives a slug from a genre name, so "Sci-Fi & Fantasy" becomes
+// "sci_fi_and_fantasy".
+func genreSlug(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "&", " and ")
+
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(words, "_")
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+func TestGetGenres(t *testing.T) {
+	env := newTestEnv(t)
+	env.TMDB.ReturnsGenreList("tv", []tmdb.Genre{
+		{ID: 10765, Name: "Sci-Fi & Fantasy"},
+		{ID: 10759, Name: "Action & Adventure"},
+		{ID: 18, Name: "Drama"},
+	})
+
+	genres, err := env.MovieService().GetGenres("tv")
+	require.NoError(t, err)
+	require.Len(t, genres, 3)
+
+	assert.Equal(t, "action_and_adventure", genres[0].Slug, "ordered by name")
+	assert.Equal(t, []string{"action", "adventure"}, genres[0].Aliases)
+	assert.Equal(t, "drama", genres[1].Slug)
+	assert.Empty(t, genres[1].Aliases, "an alias equal to the slug is not repeated")
+	assert.Equal(t, "sci_fi_and_fantasy", genres[2].Slug)
+	assert.Equal(t, []string{"fantasy", "sci_fi"}, genres[2].Aliases)
+	assert.Equal(t, "tv", genres[2].MediaType)
+}
+
+func TestGetGenres_Errors(t *testing.T) {
+	t.Run("invalid media type", func(t *testing.T) {
+		env := newTestEnv(t)
+
+		_, err := env.MovieService().GetGenres("anime")
+
+		assert.ErrorIs(t, err, ErrInvalidInput)
+	})
+
+	t.Run("upstream failure", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.TMDB.GenreListFails("movie", errors.New("tmdb down"))
+
+		_, err := env.MovieService().GetGenres("movie")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGenreSlug(t *testing.T) {
+	tests := map[string]string{
+		"Drama":              "drama",
+		"Science Fiction":    "science_fiction",
+		"TV Movie":           "tv_movie",
+		"Sci-Fi & Fantasy":   "sci_fi_and_fantasy",
+		"War & Politics":     "war_and_politics",
+		"Action & Adventure": "action_and_adventure",
+	}
+
+	for name, want := range tests {
+		assert.Equal(t, want, genreSlug(name), name)
+	}
+}
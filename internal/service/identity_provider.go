@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/milansax96/movie-terminal-api/config"
+)
+
+// ProviderGoogle is the name under which Google sign-in is registered.
+const ProviderGoogle = "google"
+
+// ExternalIdentity is the account an identity provider vouches for.
+type ExternalIdentity struct {
+	Subject string
+	Email   string
+	Name    string
+	Picture string
+}
+
+// IdentityProvider verifies access tokens issued by an external identity provider.
+type IdentityProvider interface {
+	// Name is the provider's key in URLs and in user_identities.provider.
+	Name() string
+	// UserInfo resolves an access token to the account it was issued for.
+	// It returns ErrInvalidToken when the provider rejects the token.
+	UserInfo(ctx context.Context, accessToken string) (*ExternalIdentity, error)
+}
+
+// OIDCProvider verifies access tokens against an OpenID Connect userinfo endpoint.
+type OIDCProvider struct {
+	name        string
+	userInfoURL string
+	client      *http.Client
+}
+
+// NewOIDCProvider creates a provider that calls the given userinfo endpoint.
+func NewOIDCProvider(name string, userInfoURL string) *OIDCProvider {
+	return &OIDCProvider{
+		name:        name,
+		userInfoURL: userInfoURL,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// NewGoogleProvider creates the Google provider. Google's userinfo endpoint is
+// standard OIDC, so it is an OIDCProvider under a fixed name.
+func NewGoogleProvider(userInfoURL string) *OIDCProvider {
+	return NewOIDCProvider(ProviderGoogle, userInfoURL)
+}
+
+// Name returns the provider name.
+func (p *OIDCProvider) Name() string {
+	return p.name
+}
+
+type oidcUserInfo struct {
+	Sub           string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+}
+
+// UserInfo calls the userinfo endpoint with the access token.
+func (p *OIDCProvider) UserInfo(ctx context.Context, accessToken string) (_ *ExternalIdentity, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", p.userInfoURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("calling userinfo endpoint: %w", err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrInvalidToken
+	}
+
+	var info oidcUserInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("decoding userinfo: %w", err)
+	}
+
+	// An address the provider has not verified cannot identify an account.
+	email := info.Email
+	if info.EmailVerified != nil && !*info.EmailVerified {
+		email = ""
+	}
+
+	return &ExternalIdentity{Subject: info.Sub, Email: email, Name: info.Name, Picture: info.Picture}, nil
+}
+
+// DiscoverUserInfoURL reads the userinfo endpoint from an issuer's OpenID
+// Connect discovery document.
+func DiscoverUserInfoURL(ctx context.Context, issuer string) (_ string, err error) {
+	url := strings.TrimRight(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("creating request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetching discovery document: %w", err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetching discovery document: status %d", resp.StatusCode)
+	}
+
+	var doc struct {
+		UserInfoEndpoint string `json:"userinfo_endpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", fmt.Errorf("decoding discovery document: %w", err)
+	}
+
+	if doc.UserInfoEndpoint == "" {
+		return "", fmt.Errorf("issuer %s has no userinfo_endpoint", issuer)
+	}
+
+	return doc.UserInfoEndpoint, nil
+}
+
+// IdentityProvidersFromConfig builds Google plus the optional generic OIDC
+// provider. The OIDC userinfo URL is discovered from the issuer unless it is
+// configured explicitly.
+func IdentityProvidersFromConfig(ctx context.Context, cfg *config.Config) ([]IdentityProvider, error) {
+	providers := []IdentityProvider{NewGoogleProvider(cfg.GoogleUserInfoURL)}
+
+	if cfg.OIDCProviderName == "" {
+		return providers, nil
+	}
+
+	userInfoURL := cfg.OIDCUserInfoURL
+	if userInfoURL == "" {
+		if cfg.OIDCIssuer == "" {
+			return nil, fmt.Errorf("OIDC provider %q needs OIDC_ISSUER or OIDC_USERINFO_URL", cfg.OIDCProviderName)
+		}
+
+		discovered, err := DiscoverUserInfoURL(ctx, cfg.OIDCIssuer)
+		if err != nil {
+			return nil, err
+		}
+		userInfoURL = discovered
+	}
+
+	return append(providers, NewOIDCProvider(cfg.OIDCProviderName, userInfoURL)), nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/config"
+)
+
+func TestOIDCProvider_UserInfo(t *testing.T) {
+	unverified := false
+	idp := newFakeIdP(t, map[string]oidcUserInfo{
+		"good":       {Sub: "sub-1", Email: "a@example.com", Name: "A"},
+		"unverified": {Sub: "sub-2", Email: "b@example.com", EmailVerified: &unverified},
+	})
+	p := NewOIDCProvider("acme", idp.URL)
+
+	info, err := p.UserInfo(context.Background(), "good")
+	require.NoError(t, err)
+	assert.Equal(t, &ExternalIdentity{Subject: "sub-1", Email: "a@example.com", Name: "A"}, info)
+
+	info, err = p.UserInfo(context.Background(), "unverified")
+	require.NoError(t, err)
+	assert.Empty(t, info.Email)
+
+	_, err = p.UserInfo(context.Background(), "bad")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestIdentityProvidersFromConfig(t *testing.T) {
+	issuer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"userinfo_endpoint": "https://idp.test/userinfo"})
+	}))
+	t.Cleanup(issuer.Close)
+
+	tests := map[string]struct {
+		cfg     config.Config
+		names   []string
+		userURL string
+		wantErr bool
+	}{
+		"google only":      {config.Config{}, []string{"google"}, "", false},
+		"explicit url":     {config.Config{OIDCProviderName: "acme", OIDCUserInfoURL: "https://acme.test/me"}, []string{"google", "acme"}, "https://acme.test/me", false},
+		"discovered url":   {config.Config{OIDCProviderName: "acme", OIDCIssuer: issuer.URL + "/"}, []string{"google", "acme"}, "https://idp.test/userinfo", false},
+		"missing settings": {config.Config{OIDCProviderName: "acme"}, nil, "", true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			providers, err := IdentityProvidersFromConfig(context.Background(), &tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, len(providers))
+			for i, p := range providers {
+				names[i] = p.Name()
+			}
+			assert.Equal(t, tt.names, names)
+
+			if tt.userURL != "" {
+				assert.Equal(t, tt.userURL, providers[len(providers)-1].(*OIDCProvider).userInfoURL)
+			}
+		})
+	}
+}
@@ -2,29 +2,85 @@ package service
 
 import (
 	"context"
-	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/milansax96/movie-terminal-api/internal/middleware"
 	"github.com/milansax96/movie-terminal-api/internal/models"
 	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
 )
 
 // AuthServiceInterface defines the contract for authentication operations.
 type AuthServiceInterface interface {
-	GoogleLogin(ctx context.Context, idToken string) (*AuthResult, error)
+	Providers() []string
+	Login(ctx context.Context, provider string, accessToken string, client ClientInfo) (*AuthResult, error)
+	Refresh(ctx context.Context, refreshToken string, client ClientInfo) (*AuthResult, error)
+	Logout(ctx context.Context, refreshToken string) error
+	RequestDeviceCode(ctx context.Context) (*DeviceCode, error)
+	ApproveDevice(ctx context.Context, userCode string, accessToken string) error
+	PollDeviceToken(ctx context.Context, deviceCode string, client ClientInfo) (*AuthResult, error)
+	IsSessionActive(sessionID string) (bool, error)
+	ListSessions(userID uuid.UUID, currentSessionID string) ([]models.Session, error)
+	RevokeSession(userID uuid.UUID, sessionID uuid.UUID) error
+	ListIdentities(userID uuid.UUID) ([]models.UserIdentity, error)
+	LinkIdentity(ctx context.Context, userID uuid.UUID, provider string, accessToken string) (*models.UserIdentity, error)
+	UnlinkIdentity(userID uuid.UUID, identityID uuid.UUID) error
+}
+
+// TokenServiceInterface defines the contract for personal access token operations.
+type TokenServiceInterface interface {
+	CreateToken(userID uuid.UUID, name string, scopes []string, expiresInDays int) (*models.PersonalAccessToken, error)
+	ListTokens(userID uuid.UUID) ([]models.PersonalAccessToken, error)
+	RevokeToken(userID uuid.UUID, tokenID uuid.UUID) error
+	ValidatePersonalToken(raw string) (*middleware.TokenPrincipal, error)
 }
 
 // UserServiceInterface defines the contract for user profile operations.
 type UserServiceInterface interface {
 	GetProfile(userID uuid.UUID) (*models.User, error)
+	UpdateProfile(userID uuid.UUID, update ProfileUpdate) (*models.User, error)
 	UpdateStreamingServices(userID uuid.UUID, serviceIDs []int) error
 }
 
+// AccountServiceInterface defines the contract for data export and account deletion.
+type AccountServiceInterface interface {
+	Export(userID uuid.UUID) (*AccountExport, error)
+	RequestDeletion(userID uuid.UUID) (time.Time, error)
+	CancelDeletion(userID uuid.UUID) error
+}
+
+// AdminServiceInterface defines the contract for user moderation by administrators.
+type AdminServiceInterface interface {
+	SearchUsers(query string) ([]models.User, error)
+	GetUser(userID uuid.UUID) (*AdminUserView, error)
+	Ban(adminID uuid.UUID, userID uuid.UUID, reason string) error
+	Unban(adminID uuid.UUID, userID uuid.UUID) error
+	RevokeSessions(adminID uuid.UUID, userID uuid.UUID) (int64, error)
+	SetRole(adminID uuid.UUID, userID uuid.UUID, role string) (*models.User, error)
+}
+
+// CatalogServiceInterface defines the contract for managing the streaming service catalog.
+type CatalogServiceInterface interface {
+	ListServices() ([]models.StreamingService, error)
+	CreateService(input StreamingServiceInput) (*models.StreamingService, error)
+	UpdateService(id int, input StreamingServiceInput) (*models.StreamingService, error)
+	DeleteService(id int) error
+}
+
+// AvailabilityServiceInterface defines the contract for watchlist streaming availability.
+type AvailabilityServiceInterface interface {
+	GetAvailability(userID uuid.UUID, region string) ([]WatchlistAvailability, error)
+}
+
 // MovieServiceInterface defines the contract for movie and watchlist operations.
 type MovieServiceInterface interface {
 	// These now return our clean Domain Model and take userID for watchlist enrichment
 	Discover(userID uuid.UUID, genre string, page int) ([]models.Movie, error)
+	DiscoverOnMyServices(userID uuid.UUID, mediaType string, region string, page int) ([]models.Movie, error)
+	DiscoverFiltered(userID uuid.UUID, filters DiscoverFilters) ([]models.Movie, error)
+	DiscoverTV(userID uuid.UUID, category string, page int) ([]models.Movie, error)
+	GetGenres(mediaType string) ([]models.Genre, error)
 	DiscoverAll(userID uuid.UUID) ([]models.Movie, error)
 	Search(userID uuid.UUID, query string, page int) ([]models.Movie, error)
 
@@ -33,16 +89,71 @@ type MovieServiceInterface interface {
 	GetDetail(mediaType string, id int) (*tmdb.MovieDetail, error)
 	GetVideos(mediaType string, id int) ([]tmdb.Video, error)
 	GetCredits(mediaType string, id int) (*tmdb.CreditsResponse, error)
-	GetProviders(mediaType string, id int) (json.RawMessage, error)
+	GetSimilar(userID uuid.UUID, mediaType string, id int, page int) ([]models.Movie, error)
+	GetRecommendations(userID uuid.UUID, mediaType string, id int, page int) ([]models.Movie, error)
+	GetProviders(userID uuid.UUID, mediaType string, id int, region string) (*models.WatchProviders, error)
 
 	// Watchlist operations
 	// Note: Use models.Movie as the request body for Adding
 	AddToWatchlist(userID uuid.UUID, movie models.Movie) (*models.Watchlist, error)
-	GetWatchlist(userID uuid.UUID) ([]models.Watchlist, error)
+	GetWatchlist(userID uuid.UUID, filters WatchlistFilters) (*WatchlistPage, error)
 	RemoveFromWatchlist(userID uuid.UUID, movieID int) error
 	CheckWatchlist(userID uuid.UUID, movieID int) (bool, error)
 }
 
+// TVServiceInterface defines the contract for TV show, season and episode details.
+type TVServiceInterface interface {
+	GetShow(userID uuid.UUID, id int) (*models.TVShow, error)
+	GetSeason(id int, seasonNumber int) (*models.Season, error)
+	GetEpisode(id int, seasonNumber int, episodeNumber int) (*models.Episode, error)
+}
+
+// PersonServiceInterface defines the contract for person details and credits.
+type PersonServiceInterface interface {
+	GetPerson(id int) (*models.Person, error)
+	GetCredits(id int) (*models.PersonCredits, error)
+	GetImages(id int) ([]models.PersonImage, error)
+}
+
+// RecommendationServiceInterface defines the contract for the personal
+// recommendations feed.
+type RecommendationServiceInterface interface {
+	Recommend(userID uuid.UUID) ([]Recommendation, error)
+}
+
+// ProgressServiceInterface defines the contract for TV episode progress.
+type ProgressServiceInterface interface {
+	GetProgress(userID uuid.UUID, showID int) (*ShowProgress, error)
+	MarkEpisode(userID uuid.UUID, showID int, seasonNumber int, episodeNumber int) error
+	MarkSeason(userID uuid.UUID, showID int, seasonNumber int) (int, error)
+	UnmarkEpisode(userID uuid.UUID, showID int, seasonNumber int, episodeNumber int) error
+	UnmarkSeason(userID uuid.UUID, showID int, seasonNumber int) error
+	UpNext(userID uuid.UUID) ([]UpNextEntry, error)
+}
+
+// DiaryServiceInterface defines the contract for the viewing diary.
+type DiaryServiceInterface interface {
+	ListEntries(userID uuid.UUID) ([]models.DiaryEntry, error)
+	LogEntry(userID uuid.UUID, input DiaryEntryInput) (*models.DiaryEntry, bool, error)
+	UpdateEntry(userID uuid.UUID, id uuid.UUID, update DiaryEntryUpdate) (*models.DiaryEntry, error)
+	DeleteEntry(userID uuid.UUID, id uuid.UUID) error
+}
+
+// ListServiceInterface defines the contract for custom and shared lists.
+type ListServiceInterface interface {
+	GetLists(userID uuid.UUID) ([]models.List, error)
+	CreateList(userID uuid.UUID, input ListInput) (*models.List, error)
+	GetList(viewerID uuid.UUID, id uuid.UUID) (*models.List, error)
+	UpdateList(userID uuid.UUID, id uuid.UUID, update ListUpdate) (*models.List, error)
+	DeleteList(userID uuid.UUID, id uuid.UUID) error
+	AddItem(userID uuid.UUID, listID uuid.UUID, input ListItemInput) (*models.ListItem, error)
+	RemoveItem(userID uuid.UUID, listID uuid.UUID, itemID uuid.UUID) error
+	ReorderItems(userID uuid.UUID, listID uuid.UUID, version int, itemIDs []uuid.UUID) (*models.List, error)
+	SetMember(ownerID uuid.UUID, listID uuid.UUID, memberID uuid.UUID, role string) (*models.ListMember, error)
+	RemoveMember(userID uuid.UUID, listID uuid.UUID, memberID uuid.UUID) error
+	History(userID uuid.UUID, listID uuid.UUID) ([]models.ListChange, error)
+}
+
 // SocialServiceInterface defines the contract for social/friend operations.
 type SocialServiceInterface interface {
 	GetFriends(userID uuid.UUID) ([]models.Friendship, error)
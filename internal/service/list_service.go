@@ -0,0 +1,456 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+)
+
+// Bounds on custom lists.
+const (
+	maxListTitleLength       = 100
+	maxListDescriptionLength = 1000
+	listHistoryLimit         = 100
+)
+
+// listAccess is what a user may do with a list, from least to most.
+type listAccess int
+
+const (
+	listNoAccess listAccess = iota
+	listCanView
+	listCanEdit
+	listIsOwner
+)
+
+// ListInput creates a custom list. Visibility defaults to private.
+type ListInput struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+}
+
+// ListUpdate holds the list fields to change; nil fields are left as they
+// are.
+type ListUpdate struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Visibility  *string `json:"visibility"`
+}
+
+// ListItemInput adds a title to a custom list.
+type ListItemInput struct {
+	TMDBId     int    `json:"tmdb_id"`
+	MediaType  string `json:"media_type"`
+	Title      string `json:"title"`
+	PosterPath string `json:"poster_path"`
+}
+
+// ListService manages user-created lists and sharing them with friends. The
+// watchlist stays the user's default list and is handled by MovieService.
+type ListService struct {
+	listRepo   repository.ListRepository
+	friendRepo repository.FriendshipRepository
+}
+
+// NewListService creates a new ListService.
+func NewListService(listRepo repository.ListRepository, friendRepo repository.FriendshipRepository) *ListService {
+	return &ListService{listRepo: listRepo, friendRepo: friendRepo}
+}
+
+// GetLists returns the lists the user owns or was invited to, with their
+// item counts.
+func (s *ListService) GetLists(userID uuid.UUID) ([]models.List, error) {
+	return s.listRepo.ListByUserID(userID)
+}
+
+// CreateList creates an empty list for the user.
+func (s *ListService) CreateList(userID uuid.UUID, input ListInput) (*models.List, error) {
+	verr := &ValidationError{}
+	list := &models.List{
+		UserID:      userID,
+		Title:       listTitle(verr, input.Title),
+		Description: listDescription(verr, input.Description),
+		Visibility:  models.ListPrivate,
+	}
+
+	if input.Visibility != "" {
+		list.Visibility = listVisibility(verr, input.Visibility)
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	if err := s.listRepo.Create(list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// GetList returns a list with its items and members if the viewer may see
+// it. Lists the viewer can't see are reported as not found.
+func (s *ListService) GetList(viewerID uuid.UUID, id uuid.UUID) (*models.List, error) {
+	return s.accessibleList(viewerID, id, listCanView)
+}
+
+// UpdateList edits the details of one of the user's lists.
+func (s *ListService) UpdateList(userID uuid.UUID, id uuid.UUID, update ListUpdate) (*models.List, error) {
+	list, err := s.accessibleList(userID, id, listIsOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	verr := &ValidationError{}
+
+	if update.Title != nil {
+		list.Title = listTitle(verr, *update.Title)
+	}
+
+	if update.Description != nil {
+		list.Description = listDescription(verr, *update.Description)
+	}
+
+	if update.Visibility != nil {
+		list.Visibility = listVisibility(verr, *update.Visibility)
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	change := &models.ListChange{ListID: list.ID, UserID: userID, Action: models.ListChangeUpdated}
+	if err := s.listRepo.Update(list, change); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// DeleteList removes one of the user's lists and its items.
+func (s *ListService) DeleteList(userID uuid.UUID, id uuid.UUID) error {
+	deleted, err := s.listRepo.Delete(userID, id)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// AddItem appends a title to the end of a list the user owns or edits.
+func (s *ListService) AddItem(userID uuid.UUID, listID uuid.UUID, input ListItemInput) (*models.ListItem, error) {
+	if _, err := s.accessibleList(userID, listID, listCanEdit); err != nil {
+		return nil, err
+	}
+
+	verr := &ValidationError{}
+
+	if input.TMDBId <= 0 {
+		verr.add("tmdb_id", "is required")
+	}
+
+	if input.MediaType != "movie" && input.MediaType != "tv" {
+		verr.add("media_type", "must be movie or tv")
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	item := &models.ListItem{
+		ListID:     listID,
+		TMDBId:     input.TMDBId,
+		MediaType:  input.MediaType,
+		Title:      input.Title,
+		PosterPath: input.PosterPath,
+		AddedBy:    &userID,
+		AddedAt:    time.Now(),
+	}
+
+	change := &models.ListChange{
+		ListID: listID, UserID: userID, Action: models.ListChangeItemAdded, TMDBId: input.TMDBId, MediaType: input.MediaType,
+	}
+	err := s.listRepo.AddItem(item, change)
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, ErrAlreadyExists
+	} else if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// RemoveItem takes an item off a list the user owns or edits.
+func (s *ListService) RemoveItem(userID uuid.UUID, listID uuid.UUID, itemID uuid.UUID) error {
+	list, err := s.accessibleList(userID, listID, listCanEdit)
+	if err != nil {
+		return err
+	}
+
+	i := slices.IndexFunc(list.Items, func(item models.ListItem) bool { return item.ID == itemID })
+	if i < 0 {
+		return ErrNotFound
+	}
+
+	item := list.Items[i]
+	change := &models.ListChange{
+		ListID: listID, UserID: userID, Action: models.ListChangeItemRemoved, TMDBId: item.TMDBId, MediaType: item.MediaType,
+	}
+	removed, err := s.listRepo.RemoveItem(listID, itemID, change)
+	if err != nil {
+		return err
+	}
+
+	if removed == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ReorderItems puts the items of a list the user owns or edits in the given
+// order. itemIDs must name every item on the list exactly once, and version
+// must be the list's current version so concurrent edits aren't lost.
+func (s *ListService) ReorderItems(userID uuid.UUID, listID uuid.UUID, version int, itemIDs []uuid.UUID) (*models.List, error) {
+	list, err := s.accessibleList(userID, listID, listCanEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	if list.Version != version {
+		return nil, ErrVersionConflict
+	}
+
+	unordered := make(map[uuid.UUID]bool, len(list.Items))
+	for _, item := range list.Items {
+		unordered[item.ID] = true
+	}
+
+	complete := len(itemIDs) == len(list.Items)
+	for _, id := range itemIDs {
+		if !unordered[id] {
+			complete = false
+
+			break
+		}
+		delete(unordered, id)
+	}
+
+	if !complete {
+		verr := &ValidationError{}
+		verr.add("item_ids", "must list every item on the list exactly once")
+
+		return nil, verr
+	}
+
+	change := &models.ListChange{ListID: listID, UserID: userID, Action: models.ListChangeReordered}
+	reordered, err := s.listRepo.Reorder(listID, version, itemIDs, change)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reordered {
+		return nil, ErrVersionConflict
+	}
+
+	return s.findList(listID)
+}
+
+// SetMember shares one of the user's lists with an accepted friend as an
+// editor or viewer, or changes the role they already have.
+func (s *ListService) SetMember(ownerID uuid.UUID, listID uuid.UUID, memberID uuid.UUID, role string) (*models.ListMember, error) {
+	if _, err := s.accessibleList(ownerID, listID, listIsOwner); err != nil {
+		return nil, err
+	}
+
+	verr := &ValidationError{}
+
+	if !slices.Contains(models.ListRoles, role) {
+		verr.add("role", "must be one of "+strings.Join(models.ListRoles, ", "))
+	}
+
+	if memberID == ownerID {
+		verr.add("user_id", "must not be the list's owner")
+	} else {
+		friends, err := s.areFriends(ownerID, memberID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !friends {
+			verr.add("user_id", "must be an accepted friend")
+		}
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	member := &models.ListMember{ListID: listID, UserID: memberID, Role: role}
+	change := &models.ListChange{ListID: listID, UserID: ownerID, Action: models.ListChangeMemberSet, MemberID: &memberID, Role: role}
+
+	if err := s.listRepo.SetMember(member, change); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// RemoveMember stops sharing a list with a member. The owner can remove
+// anyone; members can only remove themselves.
+func (s *ListService) RemoveMember(userID uuid.UUID, listID uuid.UUID, memberID uuid.UUID) error {
+	required := listIsOwner
+	if userID == memberID {
+		required = listCanView
+	}
+
+	if _, err := s.accessibleList(userID, listID, required); err != nil {
+		return err
+	}
+
+	change := &models.ListChange{ListID: listID, UserID: userID, Action: models.ListChangeMemberRemoved, MemberID: &memberID}
+	removed, err := s.listRepo.RemoveMember(listID, memberID, change)
+	if err != nil {
+		return err
+	}
+
+	if removed == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// History returns the most recent changes to a list the user owns or was
+// invited to.
+func (s *ListService) History(userID uuid.UUID, listID uuid.UUID) ([]models.ListChange, error) {
+	list, err := s.findList(listID)
+	if err != nil {
+		return nil, err
+	}
+
+	if list.UserID != userID && memberRole(list, userID) == "" {
+		return nil, ErrNotFound
+	}
+
+	return s.listRepo.History(listID, listHistoryLimit)
+}
+
+func (s *ListService) findList(id uuid.UUID) (*models.List, error) {
+	list, err := s.listRepo.Find(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// accessibleList returns the list if the user has at least the required
+// access to it. Lists the user can't see are reported as not found and lists
+// they can only see as forbidden.
+func (s *ListService) accessibleList(userID uuid.UUID, id uuid.UUID, required listAccess) (*models.List, error) {
+	list, err := s.findList(id)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := s.access(userID, list)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case access == listNoAccess:
+		return nil, ErrNotFound
+	case access < required:
+		return nil, ErrForbidden
+	}
+
+	return list, nil
+}
+
+func (s *ListService) access(userID uuid.UUID, list *models.List) (listAccess, error) {
+	switch {
+	case list.UserID == userID:
+		return listIsOwner, nil
+	case memberRole(list, userID) == models.ListRoleEditor:
+		return listCanEdit, nil
+	case memberRole(list, userID) == models.ListRoleViewer, list.Visibility == models.ListPublic:
+		return listCanView, nil
+	case list.Visibility == models.ListFriends:
+		friends, err := s.areFriends(userID, list.UserID)
+		if err != nil || !friends {
+			return listNoAccess, err
+		}
+
+		return listCanView, nil
+	}
+
+	return listNoAccess, nil
+}
+
+func memberRole(list *models.List, userID uuid.UUID) string {
+	for _, member := range list.Members {
+		if member.UserID == userID {
+			return member.Role
+		}
+	}
+
+	return ""
+}
+
+func (s *ListService) areFriends(userID uuid.UUID, otherID uuid.UUID) (bool, error) {
+	friendships, err := s.friendRepo.GetAcceptedFriendships(userID)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.ContainsFunc(friendships, func(f models.Friendship) bool {
+		return f.UserID == otherID || f.FriendID == otherID
+	}), nil
+}
+
+func listTitle(verr *ValidationError, title string) string {
+	title = strings.TrimSpace(title)
+	switch {
+	case title == "":
+		verr.add("title", "is required")
+	case utf8.RuneCountInString(title) > maxListTitleLength:
+		verr.add("title", fmt.Sprintf("must be at most %d characters", maxListTitleLength))
+	}
+
+	return title
+}
+
+func listDescription(verr *ValidationError, description string) string {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > maxListDescriptionLength {
+		verr.add("description", fmt.Sprintf("must be at most %d characters", maxListDescriptionLength))
+	}
+
+	return description
+}
+
+func listVisibility(verr *ValidationError, visibility string) string {
+	if !slices.Contains(models.ListVisibilities, visibility) {
+		verr.add("visibility", "must be one of "+strings.Join(models.ListVisibilities, ", "))
+	}
+
+	return visibility
+}
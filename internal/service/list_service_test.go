@@ -0,0 +1,486 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+func testList(ownerID uuid.UUID, visibility string, tmdbIDs ...int) *models.List {
+	list := &models.List{ID: uuid.New(), UserID: ownerID, Title: "Halloween 2026", Visibility: visibility, Version: 1}
+	for i, tmdbID := range tmdbIDs {
+		list.Items = append(list.Items, models.ListItem{ID: uuid.New(), ListID: list.ID, TMDBId: tmdbID, MediaType: "movie", Position: i + 1})
+	}
+
+	return list
+}
+
+// itemIDs returns the IDs of the list's items for the given titles, in order.
+func itemIDs(list *models.List, tmdbIDs ...int) []uuid.UUID {
+	ids := make([]uuid.UUID, len(tmdbIDs))
+	for i, tmdbID := range tmdbIDs {
+		for _, item := range list.Items {
+			if item.TMDBId == tmdbID {
+				ids[i] = item.ID
+			}
+		}
+	}
+
+	return ids
+}
+
+func TestCreateList(t *testing.T) {
+	userID := uuid.New()
+	env := newTestEnv(t)
+	env.Lists.On("Create", mock.AnythingOfType("*models.List")).Return(nil)
+
+	list, err := env.ListService().CreateList(userID, ListInput{Title: " Halloween 2026 ", Description: "Spooky season"})
+	require.NoError(t, err)
+	assert.Equal(t, userID, list.UserID)
+	assert.Equal(t, "Halloween 2026", list.Title)
+	assert.Equal(t, models.ListPrivate, list.Visibility)
+}
+
+func TestCreateList_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		input ListInput
+		field string
+	}{
+		"missing title":        {ListInput{Title: "  "}, "title"},
+		"title too long":       {ListInput{Title: strings.Repeat("a", maxListTitleLength+1)}, "title"},
+		"description too long": {ListInput{Title: "Criterion to-do", Description: strings.Repeat("a", maxListDescriptionLength+1)}, "description"},
+		"unknown visibility":   {ListInput{Title: "Criterion to-do", Visibility: "secret"}, "visibility"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+
+			_, err := env.ListService().CreateList(uuid.New(), tt.input)
+
+			var verr *ValidationError
+			require.True(t, errors.As(err, &verr))
+			assert.Contains(t, verr.Fields, tt.field)
+		})
+	}
+}
+
+func TestGetList(t *testing.T) {
+	ownerID, viewerID := uuid.New(), uuid.New()
+
+	tests := map[string]struct {
+		viewerID   uuid.UUID
+		visibility string
+		setup      func(env *TestEnv)
+		wantErr    error
+	}{
+		"owner sees private list":         {ownerID, models.ListPrivate, func(_ *TestEnv) {}, nil},
+		"anyone sees public list":         {viewerID, models.ListPublic, func(_ *TestEnv) {}, nil},
+		"stranger can't see private list": {viewerID, models.ListPrivate, func(_ *TestEnv) {}, ErrNotFound},
+		"friend sees friends list": {viewerID, models.ListFriends, func(env *TestEnv) {
+			env.Friends.ReturnsFriendships(viewerID, []models.Friendship{{UserID: ownerID, FriendID: viewerID, Status: "accepted"}})
+		}, nil},
+		"stranger can't see friends list": {viewerID, models.ListFriends, func(env *TestEnv) {
+			env.Friends.ReturnsFriendships(viewerID, nil)
+		}, ErrNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := testList(ownerID, tt.visibility, 948)
+			env := newTestEnv(t)
+			env.Lists.Finds(list)
+			tt.setup(env)
+
+			got, err := env.ListService().GetList(tt.viewerID, list.ID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, got.Items, 1)
+		})
+	}
+}
+
+func TestUpdateList(t *testing.T) {
+	userID := uuid.New()
+	list := testList(userID, models.ListPrivate)
+	title, visibility := "Halloween 2026 marathon", models.ListPublic
+
+	env := newTestEnv(t)
+	env.Lists.Finds(list)
+	env.Lists.On("Update", list, mock.MatchedBy(func(c *models.ListChange) bool {
+		return c.UserID == userID && c.Action == models.ListChangeUpdated
+	})).Return(nil)
+
+	updated, err := env.ListService().UpdateList(userID, list.ID, ListUpdate{Title: &title, Visibility: &visibility})
+	require.NoError(t, err)
+	assert.Equal(t, title, updated.Title)
+	assert.Equal(t, models.ListPublic, updated.Visibility)
+}
+
+func TestUpdateList_OtherUsersList(t *testing.T) {
+	tests := map[string]struct {
+		visibility string
+		wantErr    error
+	}{
+		"private list": {models.ListPrivate, ErrNotFound},
+		"public list":  {models.ListPublic, ErrForbidden},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := testList(uuid.New(), tt.visibility)
+			title := "Mine now"
+
+			env := newTestEnv(t)
+			env.Lists.Finds(list)
+
+			_, err := env.ListService().UpdateList(uuid.New(), list.ID, ListUpdate{Title: &title})
+			assert.ErrorIs(t, err, tt.wantErr)
+			env.Lists.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestDeleteList(t *testing.T) {
+	tests := map[string]struct {
+		deleted int64
+		wantErr error
+	}{
+		"deleted":   {deleted: 1},
+		"not found": {deleted: 0, wantErr: ErrNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			userID, id := uuid.New(), uuid.New()
+			env := newTestEnv(t)
+			env.Lists.On("Delete", userID, id).Return(tt.deleted, nil)
+
+			err := env.ListService().DeleteList(userID, id)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAddListItem(t *testing.T) {
+	userID := uuid.New()
+	input := ListItemInput{TMDBId: 948, MediaType: "movie", Title: "Halloween"}
+
+	tests := map[string]struct {
+		repoErr error
+		wantErr error
+	}{
+		"added":          {},
+		"already listed": {repoErr: gorm.ErrDuplicatedKey, wantErr: ErrAlreadyExists},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := testList(userID, models.ListPrivate)
+			env := newTestEnv(t)
+			env.Lists.Finds(list)
+			env.Lists.On("AddItem", mock.AnythingOfType("*models.ListItem"), mock.MatchedBy(func(c *models.ListChange) bool {
+				return c.Action == models.ListChangeItemAdded && c.TMDBId == 948
+			})).Return(tt.repoErr)
+
+			item, err := env.ListService().AddItem(userID, list.ID, input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, list.ID, item.ListID)
+			assert.Equal(t, 948, item.TMDBId)
+			assert.Equal(t, &userID, item.AddedBy)
+		})
+	}
+}
+
+func TestAddListItem_Invalid(t *testing.T) {
+	userID := uuid.New()
+	list := testList(userID, models.ListPrivate)
+	env := newTestEnv(t)
+	env.Lists.Finds(list)
+
+	_, err := env.ListService().AddItem(userID, list.ID, ListItemInput{MediaType: "person"})
+
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.Contains(t, verr.Fields, "tmdb_id")
+	assert.Contains(t, verr.Fields, "media_type")
+}
+
+func TestRemoveListItem(t *testing.T) {
+	userID := uuid.New()
+	list := testList(userID, models.ListPrivate, 948)
+	list.Items = append(list.Items, models.ListItem{ID: uuid.New(), ListID: list.ID, TMDBId: 948, MediaType: "tv", Position: 2})
+	show := list.Items[1]
+	env := newTestEnv(t)
+	env.Lists.Finds(list)
+	env.Lists.On("RemoveItem", list.ID, show.ID, mock.MatchedBy(func(change *models.ListChange) bool {
+		return change.TMDBId == 948 && change.MediaType == "tv"
+	})).Return(int64(1), nil)
+
+	require.NoError(t, env.ListService().RemoveItem(userID, list.ID, show.ID))
+	assert.ErrorIs(t, env.ListService().RemoveItem(userID, list.ID, uuid.New()), ErrNotFound)
+}
+
+func TestAddListItem_SameIDOtherMediaType(t *testing.T) {
+	userID := uuid.New()
+	list := testList(userID, models.ListPrivate, 1399)
+	env := newTestEnv(t)
+	env.Lists.Finds(list)
+	env.Lists.On("AddItem", mock.AnythingOfType("*models.ListItem"), mock.MatchedBy(func(change *models.ListChange) bool {
+		return change.TMDBId == 1399 && change.MediaType == "tv"
+	})).Return(nil)
+
+	item, err := env.ListService().AddItem(userID, list.ID, ListItemInput{TMDBId: 1399, MediaType: "tv"})
+	require.NoError(t, err)
+	assert.Equal(t, "tv", item.MediaType)
+}
+
+func TestReorderListItems(t *testing.T) {
+	userID := uuid.New()
+	list := testList(userID, models.ListPrivate, 948, 694, 10331)
+	env := newTestEnv(t)
+	env.Lists.Finds(list)
+	order := itemIDs(list, 10331, 948, 694)
+	env.Lists.On("Reorder", list.ID, 1, order, mock.AnythingOfType("*models.ListChange")).Return(true, nil)
+
+	_, err := env.ListService().ReorderItems(userID, list.ID, 1, order)
+	require.NoError(t, err)
+}
+
+func TestReorderListItems_StaleVersion(t *testing.T) {
+	userID := uuid.New()
+
+	tests := map[string]struct {
+		version int
+		setup   func(env *TestEnv, list *models.List)
+	}{
+		"behind the loaded list": {version: 1, setup: func(_ *TestEnv, list *models.List) {
+			list.Version = 2
+		}},
+		"changed before the write": {version: 1, setup: func(env *TestEnv, list *models.List) {
+			env.Lists.On("Reorder", list.ID, 1, itemIDs(list, 694, 948), mock.AnythingOfType("*models.ListChange")).Return(false, nil)
+		}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := testList(userID, models.ListPrivate, 948, 694)
+			env := newTestEnv(t)
+			env.Lists.Finds(list)
+			tt.setup(env, list)
+
+			_, err := env.ListService().ReorderItems(userID, list.ID, tt.version, itemIDs(list, 694, 948))
+			assert.ErrorIs(t, err, ErrVersionConflict)
+		})
+	}
+}
+
+func TestReorderListItems_Mismatch(t *testing.T) {
+	userID := uuid.New()
+
+	tests := map[string][]int{
+		"missing item":   {948, 694},
+		"duplicate item": {948, 948, 694},
+		"unknown item":   {948, 694, 550},
+	}
+
+	for name, tmdbIDs := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := testList(userID, models.ListPrivate, 948, 694, 10331)
+			env := newTestEnv(t)
+			env.Lists.Finds(list)
+
+			order := itemIDs(list, tmdbIDs...)
+			for i, tmdbID := range tmdbIDs {
+				if tmdbID == 550 {
+					order[i] = uuid.New()
+				}
+			}
+
+			_, err := env.ListService().ReorderItems(userID, list.ID, 1, order)
+
+			var verr *ValidationError
+			require.True(t, errors.As(err, &verr))
+			assert.Contains(t, verr.Fields, "item_ids")
+			env.Lists.AssertNotCalled(t, "Reorder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestReorderListItems_NotFound(t *testing.T) {
+	id := uuid.New()
+	env := newTestEnv(t)
+	env.Lists.NotFound(id)
+
+	_, err := env.ListService().ReorderItems(uuid.New(), id, 1, []uuid.UUID{uuid.New()})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestListAccessByRole(t *testing.T) {
+	ownerID, memberID := uuid.New(), uuid.New()
+
+	tests := map[string]struct {
+		role    string
+		wantErr error
+	}{
+		"editor can add":       {role: models.ListRoleEditor},
+		"viewer can't add":     {role: models.ListRoleViewer, wantErr: ErrForbidden},
+		"non-member can't see": {role: "", wantErr: ErrNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := testList(ownerID, models.ListPrivate)
+			if tt.role != "" {
+				list.Members = []models.ListMember{{ListID: list.ID, UserID: memberID, Role: tt.role}}
+			}
+
+			env := newTestEnv(t)
+			env.Lists.Finds(list)
+			if tt.wantErr == nil {
+				env.Lists.On("AddItem", mock.AnythingOfType("*models.ListItem"), mock.AnythingOfType("*models.ListChange")).Return(nil)
+			}
+
+			item, err := env.ListService().AddItem(memberID, list.ID, ListItemInput{TMDBId: 948, MediaType: "movie"})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, &memberID, item.AddedBy, "items are attributed to the editor")
+		})
+	}
+}
+
+func TestSetListMember(t *testing.T) {
+	ownerID, friendID := uuid.New(), uuid.New()
+	list := testList(ownerID, models.ListPrivate)
+
+	env := newTestEnv(t)
+	env.Lists.Finds(list)
+	env.Friends.ReturnsFriendships(ownerID, []models.Friendship{{UserID: friendID, FriendID: ownerID, Status: "accepted"}})
+	env.Lists.On("SetMember", mock.AnythingOfType("*models.ListMember"), mock.MatchedBy(func(c *models.ListChange) bool {
+		return c.Action == models.ListChangeMemberSet && *c.MemberID == friendID && c.Role == models.ListRoleEditor
+	})).Return(nil)
+
+	member, err := env.ListService().SetMember(ownerID, list.ID, friendID, models.ListRoleEditor)
+	require.NoError(t, err)
+	assert.Equal(t, friendID, member.UserID)
+	assert.Equal(t, models.ListRoleEditor, member.Role)
+}
+
+func TestSetListMember_Invalid(t *testing.T) {
+	ownerID, strangerID := uuid.New(), uuid.New()
+
+	tests := map[string]struct {
+		memberID uuid.UUID
+		role     string
+		setup    func(env *TestEnv)
+		field    string
+	}{
+		"not a friend": {strangerID, models.ListRoleViewer, func(env *TestEnv) {
+			env.Friends.ReturnsFriendships(ownerID, nil)
+		}, "user_id"},
+		"owner":        {ownerID, models.ListRoleViewer, func(_ *TestEnv) {}, "user_id"},
+		"unknown role": {ownerID, "admin", func(_ *TestEnv) {}, "role"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := testList(ownerID, models.ListPrivate)
+			env := newTestEnv(t)
+			env.Lists.Finds(list)
+			tt.setup(env)
+
+			_, err := env.ListService().SetMember(ownerID, list.ID, tt.memberID, tt.role)
+
+			var verr *ValidationError
+			require.True(t, errors.As(err, &verr))
+			assert.Contains(t, verr.Fields, tt.field)
+		})
+	}
+}
+
+func TestSetListMember_OnlyOwner(t *testing.T) {
+	ownerID, editorID := uuid.New(), uuid.New()
+	list := testList(ownerID, models.ListPrivate)
+	list.Members = []models.ListMember{{ListID: list.ID, UserID: editorID, Role: models.ListRoleEditor}}
+
+	env := newTestEnv(t)
+	env.Lists.Finds(list)
+
+	_, err := env.ListService().SetMember(editorID, list.ID, uuid.New(), models.ListRoleEditor)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestRemoveListMember(t *testing.T) {
+	ownerID, viewerID, editorID := uuid.New(), uuid.New(), uuid.New()
+
+	tests := map[string]struct {
+		userID   uuid.UUID
+		memberID uuid.UUID
+		wantErr  error
+	}{
+		"owner removes member":      {userID: ownerID, memberID: editorID},
+		"member leaves":             {userID: viewerID, memberID: viewerID},
+		"member can't remove other": {userID: editorID, memberID: viewerID, wantErr: ErrForbidden},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := testList(ownerID, models.ListPrivate)
+			list.Members = []models.ListMember{
+				{ListID: list.ID, UserID: viewerID, Role: models.ListRoleViewer},
+				{ListID: list.ID, UserID: editorID, Role: models.ListRoleEditor},
+			}
+
+			env := newTestEnv(t)
+			env.Lists.Finds(list)
+			if tt.wantErr == nil {
+				env.Lists.On("RemoveMember", list.ID, tt.memberID, mock.AnythingOfType("*models.ListChange")).Return(int64(1), nil)
+			}
+
+			err := env.ListService().RemoveMember(tt.userID, list.ID, tt.memberID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestListHistory(t *testing.T) {
+	ownerID, memberID := uuid.New(), uuid.New()
+	list := testList(ownerID, models.ListPublic)
+	list.Members = []models.ListMember{{ListID: list.ID, UserID: memberID, Role: models.ListRoleViewer}}
+	changes := []models.ListChange{{ListID: list.ID, UserID: ownerID, Action: models.ListChangeReordered}}
+
+	env := newTestEnv(t)
+	env.Lists.Finds(list)
+	env.Lists.On("History", list.ID, listHistoryLimit).Return(changes, nil)
+
+	got, err := env.ListService().History(memberID, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, changes, got)
+
+	_, err = env.ListService().History(uuid.New(), list.ID)
+	assert.ErrorIs(t, err, ErrNotFound, "public viewers don't see the history")
+}
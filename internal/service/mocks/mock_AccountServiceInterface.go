@@ -0,0 +1,199 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	service "github.com/milansax96/movie-terminal-api/internal/service"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockAccountServiceInterface is an autogenerated mock type for the AccountServiceInterface type
+type MockAccountServiceInterface struct {
+	mock.Mock
+}
+
+type MockAccountServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAccountServiceInterface) EXPECT() *MockAccountServiceInterface_Expecter {
+	return &MockAccountServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CancelDeletion provides a mock function with given fields: userID
+func (_m *MockAccountServiceInterface) CancelDeletion(userID uuid.UUID) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelDeletion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAccountServiceInterface_CancelDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDeletion'
+type MockAccountServiceInterface_CancelDeletion_Call struct {
+	*mock.Call
+}
+
+// CancelDeletion is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockAccountServiceInterface_Expecter) CancelDeletion(userID interface{}) *MockAccountServiceInterface_CancelDeletion_Call {
+	return &MockAccountServiceInterface_CancelDeletion_Call{Call: _e.mock.On("CancelDeletion", userID)}
+}
+
+func (_c *MockAccountServiceInterface_CancelDeletion_Call) Run(run func(userID uuid.UUID)) *MockAccountServiceInterface_CancelDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAccountServiceInterface_CancelDeletion_Call) Return(_a0 error) *MockAccountServiceInterface_CancelDeletion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAccountServiceInterface_CancelDeletion_Call) RunAndReturn(run func(uuid.UUID) error) *MockAccountServiceInterface_CancelDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: userID
+func (_m *MockAccountServiceInterface) Export(userID uuid.UUID) (*service.AccountExport, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 *service.AccountExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*service.AccountExport, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *service.AccountExport); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.AccountExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAccountServiceInterface_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockAccountServiceInterface_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockAccountServiceInterface_Expecter) Export(userID interface{}) *MockAccountServiceInterface_Export_Call {
+	return &MockAccountServiceInterface_Export_Call{Call: _e.mock.On("Export", userID)}
+}
+
+func (_c *MockAccountServiceInterface_Export_Call) Run(run func(userID uuid.UUID)) *MockAccountServiceInterface_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAccountServiceInterface_Export_Call) Return(_a0 *service.AccountExport, _a1 error) *MockAccountServiceInterface_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAccountServiceInterface_Export_Call) RunAndReturn(run func(uuid.UUID) (*service.AccountExport, error)) *MockAccountServiceInterface_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestDeletion provides a mock function with given fields: userID
+func (_m *MockAccountServiceInterface) RequestDeletion(userID uuid.UUID) (time.Time, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestDeletion")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (time.Time, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) time.Time); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAccountServiceInterface_RequestDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestDeletion'
+type MockAccountServiceInterface_RequestDeletion_Call struct {
+	*mock.Call
+}
+
+// RequestDeletion is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockAccountServiceInterface_Expecter) RequestDeletion(userID interface{}) *MockAccountServiceInterface_RequestDeletion_Call {
+	return &MockAccountServiceInterface_RequestDeletion_Call{Call: _e.mock.On("RequestDeletion", userID)}
+}
+
+func (_c *MockAccountServiceInterface_RequestDeletion_Call) Run(run func(userID uuid.UUID)) *MockAccountServiceInterface_RequestDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAccountServiceInterface_RequestDeletion_Call) Return(_a0 time.Time, _a1 error) *MockAccountServiceInterface_RequestDeletion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAccountServiceInterface_RequestDeletion_Call) RunAndReturn(run func(uuid.UUID) (time.Time, error)) *MockAccountServiceInterface_RequestDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAccountServiceInterface creates a new instance of MockAccountServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAccountServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAccountServiceInterface {
+	mock := &MockAccountServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
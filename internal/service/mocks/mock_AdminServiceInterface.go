@@ -0,0 +1,366 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	service "github.com/milansax96/movie-terminal-api/internal/service"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockAdminServiceInterface is an autogenerated mock type for the AdminServiceInterface type
+type MockAdminServiceInterface struct {
+	mock.Mock
+}
+
+type MockAdminServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAdminServiceInterface) EXPECT() *MockAdminServiceInterface_Expecter {
+	return &MockAdminServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// Ban provides a mock function with given fields: adminID, userID, reason
+func (_m *MockAdminServiceInterface) Ban(adminID uuid.UUID, userID uuid.UUID, reason string) error {
+	ret := _m.Called(adminID, userID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ban")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(adminID, userID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAdminServiceInterface_Ban_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ban'
+type MockAdminServiceInterface_Ban_Call struct {
+	*mock.Call
+}
+
+// Ban is a helper method to define mock.On call
+//   - adminID uuid.UUID
+//   - userID uuid.UUID
+//   - reason string
+func (_e *MockAdminServiceInterface_Expecter) Ban(adminID interface{}, userID interface{}, reason interface{}) *MockAdminServiceInterface_Ban_Call {
+	return &MockAdminServiceInterface_Ban_Call{Call: _e.mock.On("Ban", adminID, userID, reason)}
+}
+
+func (_c *MockAdminServiceInterface_Ban_Call) Run(run func(adminID uuid.UUID, userID uuid.UUID, reason string)) *MockAdminServiceInterface_Ban_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_Ban_Call) Return(_a0 error) *MockAdminServiceInterface_Ban_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_Ban_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, string) error) *MockAdminServiceInterface_Ban_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: userID
+func (_m *MockAdminServiceInterface) GetUser(userID uuid.UUID) (*service.AdminUserView, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *service.AdminUserView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*service.AdminUserView, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *service.AdminUserView); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.AdminUserView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminServiceInterface_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockAdminServiceInterface_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockAdminServiceInterface_Expecter) GetUser(userID interface{}) *MockAdminServiceInterface_GetUser_Call {
+	return &MockAdminServiceInterface_GetUser_Call{Call: _e.mock.On("GetUser", userID)}
+}
+
+func (_c *MockAdminServiceInterface_GetUser_Call) Run(run func(userID uuid.UUID)) *MockAdminServiceInterface_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_GetUser_Call) Return(_a0 *service.AdminUserView, _a1 error) *MockAdminServiceInterface_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_GetUser_Call) RunAndReturn(run func(uuid.UUID) (*service.AdminUserView, error)) *MockAdminServiceInterface_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSessions provides a mock function with given fields: adminID, userID
+func (_m *MockAdminServiceInterface) RevokeSessions(adminID uuid.UUID, userID uuid.UUID) (int64, error) {
+	ret := _m.Called(adminID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSessions")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) (int64, error)); ok {
+		return rf(adminID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) int64); ok {
+		r0 = rf(adminID, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(adminID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminServiceInterface_RevokeSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSessions'
+type MockAdminServiceInterface_RevokeSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeSessions is a helper method to define mock.On call
+//   - adminID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockAdminServiceInterface_Expecter) RevokeSessions(adminID interface{}, userID interface{}) *MockAdminServiceInterface_RevokeSessions_Call {
+	return &MockAdminServiceInterface_RevokeSessions_Call{Call: _e.mock.On("RevokeSessions", adminID, userID)}
+}
+
+func (_c *MockAdminServiceInterface_RevokeSessions_Call) Run(run func(adminID uuid.UUID, userID uuid.UUID)) *MockAdminServiceInterface_RevokeSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_RevokeSessions_Call) Return(_a0 int64, _a1 error) *MockAdminServiceInterface_RevokeSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_RevokeSessions_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) (int64, error)) *MockAdminServiceInterface_RevokeSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUsers provides a mock function with given fields: query
+func (_m *MockAdminServiceInterface) SearchUsers(query string) ([]models.User, error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUsers")
+	}
+
+	var r0 []models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.User, error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.User); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminServiceInterface_SearchUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUsers'
+type MockAdminServiceInterface_SearchUsers_Call struct {
+	*mock.Call
+}
+
+// SearchUsers is a helper method to define mock.On call
+//   - query string
+func (_e *MockAdminServiceInterface_Expecter) SearchUsers(query interface{}) *MockAdminServiceInterface_SearchUsers_Call {
+	return &MockAdminServiceInterface_SearchUsers_Call{Call: _e.mock.On("SearchUsers", query)}
+}
+
+func (_c *MockAdminServiceInterface_SearchUsers_Call) Run(run func(query string)) *MockAdminServiceInterface_SearchUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_SearchUsers_Call) Return(_a0 []models.User, _a1 error) *MockAdminServiceInterface_SearchUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_SearchUsers_Call) RunAndReturn(run func(string) ([]models.User, error)) *MockAdminServiceInterface_SearchUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRole provides a mock function with given fields: adminID, userID, role
+func (_m *MockAdminServiceInterface) SetRole(adminID uuid.UUID, userID uuid.UUID, role string) (*models.User, error) {
+	ret := _m.Called(adminID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRole")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, string) (*models.User, error)); ok {
+		return rf(adminID, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, string) *models.User); ok {
+		r0 = rf(adminID, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(adminID, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminServiceInterface_SetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRole'
+type MockAdminServiceInterface_SetRole_Call struct {
+	*mock.Call
+}
+
+// SetRole is a helper method to define mock.On call
+//   - adminID uuid.UUID
+//   - userID uuid.UUID
+//   - role string
+func (_e *MockAdminServiceInterface_Expecter) SetRole(adminID interface{}, userID interface{}, role interface{}) *MockAdminServiceInterface_SetRole_Call {
+	return &MockAdminServiceInterface_SetRole_Call{Call: _e.mock.On("SetRole", adminID, userID, role)}
+}
+
+func (_c *MockAdminServiceInterface_SetRole_Call) Run(run func(adminID uuid.UUID, userID uuid.UUID, role string)) *MockAdminServiceInterface_SetRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_SetRole_Call) Return(_a0 *models.User, _a1 error) *MockAdminServiceInterface_SetRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_SetRole_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, string) (*models.User, error)) *MockAdminServiceInterface_SetRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unban provides a mock function with given fields: adminID, userID
+func (_m *MockAdminServiceInterface) Unban(adminID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(adminID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unban")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(adminID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAdminServiceInterface_Unban_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unban'
+type MockAdminServiceInterface_Unban_Call struct {
+	*mock.Call
+}
+
+// Unban is a helper method to define mock.On call
+//   - adminID uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockAdminServiceInterface_Expecter) Unban(adminID interface{}, userID interface{}) *MockAdminServiceInterface_Unban_Call {
+	return &MockAdminServiceInterface_Unban_Call{Call: _e.mock.On("Unban", adminID, userID)}
+}
+
+func (_c *MockAdminServiceInterface_Unban_Call) Run(run func(adminID uuid.UUID, userID uuid.UUID)) *MockAdminServiceInterface_Unban_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_Unban_Call) Return(_a0 error) *MockAdminServiceInterface_Unban_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAdminServiceInterface_Unban_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) error) *MockAdminServiceInterface_Unban_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAdminServiceInterface creates a new instance of MockAdminServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAdminServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAdminServiceInterface {
+	mock := &MockAdminServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
-	service "github.com/milansax96/movie-terminal-api/internal/service"
+	models "github.com/milansax96/movie-terminal-api/internal/models"
 	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/milansax96/movie-terminal-api/internal/service"
+
+	uuid "github.com/google/uuid"
 )
 
 // MockAuthServiceInterface is an autogenerated mock type for the AuthServiceInterface type
@@ -22,29 +26,311 @@ func (_m *MockAuthServiceInterface) EXPECT() *MockAuthServiceInterface_Expecter
 	return &MockAuthServiceInterface_Expecter{mock: &_m.Mock}
 }
 
-// GoogleLogin provides a mock function with given fields: ctx, idToken
-func (_m *MockAuthServiceInterface) GoogleLogin(ctx context.Context, idToken string) (*service.AuthResult, error) {
-	ret := _m.Called(ctx, idToken)
+// ApproveDevice provides a mock function with given fields: ctx, userCode, accessToken
+func (_m *MockAuthServiceInterface) ApproveDevice(ctx context.Context, userCode string, accessToken string) error {
+	ret := _m.Called(ctx, userCode, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveDevice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userCode, accessToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthServiceInterface_ApproveDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveDevice'
+type MockAuthServiceInterface_ApproveDevice_Call struct {
+	*mock.Call
+}
+
+// ApproveDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userCode string
+//   - accessToken string
+func (_e *MockAuthServiceInterface_Expecter) ApproveDevice(ctx interface{}, userCode interface{}, accessToken interface{}) *MockAuthServiceInterface_ApproveDevice_Call {
+	return &MockAuthServiceInterface_ApproveDevice_Call{Call: _e.mock.On("ApproveDevice", ctx, userCode, accessToken)}
+}
+
+func (_c *MockAuthServiceInterface_ApproveDevice_Call) Run(run func(ctx context.Context, userCode string, accessToken string)) *MockAuthServiceInterface_ApproveDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ApproveDevice_Call) Return(_a0 error) *MockAuthServiceInterface_ApproveDevice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ApproveDevice_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAuthServiceInterface_ApproveDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsSessionActive provides a mock function with given fields: sessionID
+func (_m *MockAuthServiceInterface) IsSessionActive(sessionID string) (bool, error) {
+	ret := _m.Called(sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsSessionActive")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(sessionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_IsSessionActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsSessionActive'
+type MockAuthServiceInterface_IsSessionActive_Call struct {
+	*mock.Call
+}
+
+// IsSessionActive is a helper method to define mock.On call
+//   - sessionID string
+func (_e *MockAuthServiceInterface_Expecter) IsSessionActive(sessionID interface{}) *MockAuthServiceInterface_IsSessionActive_Call {
+	return &MockAuthServiceInterface_IsSessionActive_Call{Call: _e.mock.On("IsSessionActive", sessionID)}
+}
+
+func (_c *MockAuthServiceInterface_IsSessionActive_Call) Run(run func(sessionID string)) *MockAuthServiceInterface_IsSessionActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_IsSessionActive_Call) Return(_a0 bool, _a1 error) *MockAuthServiceInterface_IsSessionActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_IsSessionActive_Call) RunAndReturn(run func(string) (bool, error)) *MockAuthServiceInterface_IsSessionActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkIdentity provides a mock function with given fields: ctx, userID, provider, accessToken
+func (_m *MockAuthServiceInterface) LinkIdentity(ctx context.Context, userID uuid.UUID, provider string, accessToken string) (*models.UserIdentity, error) {
+	ret := _m.Called(ctx, userID, provider, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkIdentity")
+	}
+
+	var r0 *models.UserIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (*models.UserIdentity, error)); ok {
+		return rf(ctx, userID, provider, accessToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) *models.UserIdentity); ok {
+		r0 = rf(ctx, userID, provider, accessToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = rf(ctx, userID, provider, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_LinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkIdentity'
+type MockAuthServiceInterface_LinkIdentity_Call struct {
+	*mock.Call
+}
+
+// LinkIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - provider string
+//   - accessToken string
+func (_e *MockAuthServiceInterface_Expecter) LinkIdentity(ctx interface{}, userID interface{}, provider interface{}, accessToken interface{}) *MockAuthServiceInterface_LinkIdentity_Call {
+	return &MockAuthServiceInterface_LinkIdentity_Call{Call: _e.mock.On("LinkIdentity", ctx, userID, provider, accessToken)}
+}
+
+func (_c *MockAuthServiceInterface_LinkIdentity_Call) Run(run func(ctx context.Context, userID uuid.UUID, provider string, accessToken string)) *MockAuthServiceInterface_LinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_LinkIdentity_Call) Return(_a0 *models.UserIdentity, _a1 error) *MockAuthServiceInterface_LinkIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_LinkIdentity_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) (*models.UserIdentity, error)) *MockAuthServiceInterface_LinkIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdentities provides a mock function with given fields: userID
+func (_m *MockAuthServiceInterface) ListIdentities(userID uuid.UUID) ([]models.UserIdentity, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdentities")
+	}
+
+	var r0 []models.UserIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.UserIdentity, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.UserIdentity); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.UserIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_ListIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdentities'
+type MockAuthServiceInterface_ListIdentities_Call struct {
+	*mock.Call
+}
+
+// ListIdentities is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockAuthServiceInterface_Expecter) ListIdentities(userID interface{}) *MockAuthServiceInterface_ListIdentities_Call {
+	return &MockAuthServiceInterface_ListIdentities_Call{Call: _e.mock.On("ListIdentities", userID)}
+}
+
+func (_c *MockAuthServiceInterface_ListIdentities_Call) Run(run func(userID uuid.UUID)) *MockAuthServiceInterface_ListIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ListIdentities_Call) Return(_a0 []models.UserIdentity, _a1 error) *MockAuthServiceInterface_ListIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ListIdentities_Call) RunAndReturn(run func(uuid.UUID) ([]models.UserIdentity, error)) *MockAuthServiceInterface_ListIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function with given fields: userID, currentSessionID
+func (_m *MockAuthServiceInterface) ListSessions(userID uuid.UUID, currentSessionID string) ([]models.Session, error) {
+	ret := _m.Called(userID, currentSessionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GoogleLogin")
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []models.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) ([]models.Session, error)); ok {
+		return rf(userID, currentSessionID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) []models.Session); ok {
+		r0 = rf(userID, currentSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(userID, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockAuthServiceInterface_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - currentSessionID string
+func (_e *MockAuthServiceInterface_Expecter) ListSessions(userID interface{}, currentSessionID interface{}) *MockAuthServiceInterface_ListSessions_Call {
+	return &MockAuthServiceInterface_ListSessions_Call{Call: _e.mock.On("ListSessions", userID, currentSessionID)}
+}
+
+func (_c *MockAuthServiceInterface_ListSessions_Call) Run(run func(userID uuid.UUID, currentSessionID string)) *MockAuthServiceInterface_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ListSessions_Call) Return(_a0 []models.Session, _a1 error) *MockAuthServiceInterface_ListSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ListSessions_Call) RunAndReturn(run func(uuid.UUID, string) ([]models.Session, error)) *MockAuthServiceInterface_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, provider, accessToken, client
+func (_m *MockAuthServiceInterface) Login(ctx context.Context, provider string, accessToken string, client service.ClientInfo) (*service.AuthResult, error) {
+	ret := _m.Called(ctx, provider, accessToken, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
 	}
 
 	var r0 *service.AuthResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*service.AuthResult, error)); ok {
-		return rf(ctx, idToken)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, service.ClientInfo) (*service.AuthResult, error)); ok {
+		return rf(ctx, provider, accessToken, client)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *service.AuthResult); ok {
-		r0 = rf(ctx, idToken)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, service.ClientInfo) *service.AuthResult); ok {
+		r0 = rf(ctx, provider, accessToken, client)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*service.AuthResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, idToken)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, service.ClientInfo) error); ok {
+		r1 = rf(ctx, provider, accessToken, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,31 +338,399 @@ func (_m *MockAuthServiceInterface) GoogleLogin(ctx context.Context, idToken str
 	return r0, r1
 }
 
-// MockAuthServiceInterface_GoogleLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoogleLogin'
-type MockAuthServiceInterface_GoogleLogin_Call struct {
+// MockAuthServiceInterface_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuthServiceInterface_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - accessToken string
+//   - client service.ClientInfo
+func (_e *MockAuthServiceInterface_Expecter) Login(ctx interface{}, provider interface{}, accessToken interface{}, client interface{}) *MockAuthServiceInterface_Login_Call {
+	return &MockAuthServiceInterface_Login_Call{Call: _e.mock.On("Login", ctx, provider, accessToken, client)}
+}
+
+func (_c *MockAuthServiceInterface_Login_Call) Run(run func(ctx context.Context, provider string, accessToken string, client service.ClientInfo)) *MockAuthServiceInterface_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(service.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_Login_Call) Return(_a0 *service.AuthResult, _a1 error) *MockAuthServiceInterface_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_Login_Call) RunAndReturn(run func(context.Context, string, string, service.ClientInfo) (*service.AuthResult, error)) *MockAuthServiceInterface_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, refreshToken
+func (_m *MockAuthServiceInterface) Logout(ctx context.Context, refreshToken string) error {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthServiceInterface_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthServiceInterface_Logout_Call struct {
 	*mock.Call
 }
 
-// GoogleLogin is a helper method to define mock.On call
+// Logout is a helper method to define mock.On call
 //   - ctx context.Context
-//   - idToken string
-func (_e *MockAuthServiceInterface_Expecter) GoogleLogin(ctx interface{}, idToken interface{}) *MockAuthServiceInterface_GoogleLogin_Call {
-	return &MockAuthServiceInterface_GoogleLogin_Call{Call: _e.mock.On("GoogleLogin", ctx, idToken)}
+//   - refreshToken string
+func (_e *MockAuthServiceInterface_Expecter) Logout(ctx interface{}, refreshToken interface{}) *MockAuthServiceInterface_Logout_Call {
+	return &MockAuthServiceInterface_Logout_Call{Call: _e.mock.On("Logout", ctx, refreshToken)}
 }
 
-func (_c *MockAuthServiceInterface_GoogleLogin_Call) Run(run func(ctx context.Context, idToken string)) *MockAuthServiceInterface_GoogleLogin_Call {
+func (_c *MockAuthServiceInterface_Logout_Call) Run(run func(ctx context.Context, refreshToken string)) *MockAuthServiceInterface_Logout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_GoogleLogin_Call) Return(_a0 *service.AuthResult, _a1 error) *MockAuthServiceInterface_GoogleLogin_Call {
+func (_c *MockAuthServiceInterface_Logout_Call) Return(_a0 error) *MockAuthServiceInterface_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_Logout_Call) RunAndReturn(run func(context.Context, string) error) *MockAuthServiceInterface_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PollDeviceToken provides a mock function with given fields: ctx, deviceCode, client
+func (_m *MockAuthServiceInterface) PollDeviceToken(ctx context.Context, deviceCode string, client service.ClientInfo) (*service.AuthResult, error) {
+	ret := _m.Called(ctx, deviceCode, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PollDeviceToken")
+	}
+
+	var r0 *service.AuthResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, service.ClientInfo) (*service.AuthResult, error)); ok {
+		return rf(ctx, deviceCode, client)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, service.ClientInfo) *service.AuthResult); ok {
+		r0 = rf(ctx, deviceCode, client)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.AuthResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, service.ClientInfo) error); ok {
+		r1 = rf(ctx, deviceCode, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_PollDeviceToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PollDeviceToken'
+type MockAuthServiceInterface_PollDeviceToken_Call struct {
+	*mock.Call
+}
+
+// PollDeviceToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCode string
+//   - client service.ClientInfo
+func (_e *MockAuthServiceInterface_Expecter) PollDeviceToken(ctx interface{}, deviceCode interface{}, client interface{}) *MockAuthServiceInterface_PollDeviceToken_Call {
+	return &MockAuthServiceInterface_PollDeviceToken_Call{Call: _e.mock.On("PollDeviceToken", ctx, deviceCode, client)}
+}
+
+func (_c *MockAuthServiceInterface_PollDeviceToken_Call) Run(run func(ctx context.Context, deviceCode string, client service.ClientInfo)) *MockAuthServiceInterface_PollDeviceToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(service.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_PollDeviceToken_Call) Return(_a0 *service.AuthResult, _a1 error) *MockAuthServiceInterface_PollDeviceToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_PollDeviceToken_Call) RunAndReturn(run func(context.Context, string, service.ClientInfo) (*service.AuthResult, error)) *MockAuthServiceInterface_PollDeviceToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Providers provides a mock function with no fields
+func (_m *MockAuthServiceInterface) Providers() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Providers")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// MockAuthServiceInterface_Providers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Providers'
+type MockAuthServiceInterface_Providers_Call struct {
+	*mock.Call
+}
+
+// Providers is a helper method to define mock.On call
+func (_e *MockAuthServiceInterface_Expecter) Providers() *MockAuthServiceInterface_Providers_Call {
+	return &MockAuthServiceInterface_Providers_Call{Call: _e.mock.On("Providers")}
+}
+
+func (_c *MockAuthServiceInterface_Providers_Call) Run(run func()) *MockAuthServiceInterface_Providers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_Providers_Call) Return(_a0 []string) *MockAuthServiceInterface_Providers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_Providers_Call) RunAndReturn(run func() []string) *MockAuthServiceInterface_Providers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken, client
+func (_m *MockAuthServiceInterface) Refresh(ctx context.Context, refreshToken string, client service.ClientInfo) (*service.AuthResult, error) {
+	ret := _m.Called(ctx, refreshToken, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *service.AuthResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, service.ClientInfo) (*service.AuthResult, error)); ok {
+		return rf(ctx, refreshToken, client)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, service.ClientInfo) *service.AuthResult); ok {
+		r0 = rf(ctx, refreshToken, client)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.AuthResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, service.ClientInfo) error); ok {
+		r1 = rf(ctx, refreshToken, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockAuthServiceInterface_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+//   - client service.ClientInfo
+func (_e *MockAuthServiceInterface_Expecter) Refresh(ctx interface{}, refreshToken interface{}, client interface{}) *MockAuthServiceInterface_Refresh_Call {
+	return &MockAuthServiceInterface_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken, client)}
+}
+
+func (_c *MockAuthServiceInterface_Refresh_Call) Run(run func(ctx context.Context, refreshToken string, client service.ClientInfo)) *MockAuthServiceInterface_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(service.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_Refresh_Call) Return(_a0 *service.AuthResult, _a1 error) *MockAuthServiceInterface_Refresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_Refresh_Call) RunAndReturn(run func(context.Context, string, service.ClientInfo) (*service.AuthResult, error)) *MockAuthServiceInterface_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestDeviceCode provides a mock function with given fields: ctx
+func (_m *MockAuthServiceInterface) RequestDeviceCode(ctx context.Context) (*service.DeviceCode, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestDeviceCode")
+	}
+
+	var r0 *service.DeviceCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*service.DeviceCode, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *service.DeviceCode); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.DeviceCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_RequestDeviceCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestDeviceCode'
+type MockAuthServiceInterface_RequestDeviceCode_Call struct {
+	*mock.Call
+}
+
+// RequestDeviceCode is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthServiceInterface_Expecter) RequestDeviceCode(ctx interface{}) *MockAuthServiceInterface_RequestDeviceCode_Call {
+	return &MockAuthServiceInterface_RequestDeviceCode_Call{Call: _e.mock.On("RequestDeviceCode", ctx)}
+}
+
+func (_c *MockAuthServiceInterface_RequestDeviceCode_Call) Run(run func(ctx context.Context)) *MockAuthServiceInterface_RequestDeviceCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_RequestDeviceCode_Call) Return(_a0 *service.DeviceCode, _a1 error) *MockAuthServiceInterface_RequestDeviceCode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_GoogleLogin_Call) RunAndReturn(run func(context.Context, string) (*service.AuthResult, error)) *MockAuthServiceInterface_GoogleLogin_Call {
+func (_c *MockAuthServiceInterface_RequestDeviceCode_Call) RunAndReturn(run func(context.Context) (*service.DeviceCode, error)) *MockAuthServiceInterface_RequestDeviceCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: userID, sessionID
+func (_m *MockAuthServiceInterface) RevokeSession(userID uuid.UUID, sessionID uuid.UUID) error {
+	ret := _m.Called(userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthServiceInterface_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockAuthServiceInterface_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - sessionID uuid.UUID
+func (_e *MockAuthServiceInterface_Expecter) RevokeSession(userID interface{}, sessionID interface{}) *MockAuthServiceInterface_RevokeSession_Call {
+	return &MockAuthServiceInterface_RevokeSession_Call{Call: _e.mock.On("RevokeSession", userID, sessionID)}
+}
+
+func (_c *MockAuthServiceInterface_RevokeSession_Call) Run(run func(userID uuid.UUID, sessionID uuid.UUID)) *MockAuthServiceInterface_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_RevokeSession_Call) Return(_a0 error) *MockAuthServiceInterface_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_RevokeSession_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) error) *MockAuthServiceInterface_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlinkIdentity provides a mock function with given fields: userID, identityID
+func (_m *MockAuthServiceInterface) UnlinkIdentity(userID uuid.UUID, identityID uuid.UUID) error {
+	ret := _m.Called(userID, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlinkIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(userID, identityID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthServiceInterface_UnlinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkIdentity'
+type MockAuthServiceInterface_UnlinkIdentity_Call struct {
+	*mock.Call
+}
+
+// UnlinkIdentity is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - identityID uuid.UUID
+func (_e *MockAuthServiceInterface_Expecter) UnlinkIdentity(userID interface{}, identityID interface{}) *MockAuthServiceInterface_UnlinkIdentity_Call {
+	return &MockAuthServiceInterface_UnlinkIdentity_Call{Call: _e.mock.On("UnlinkIdentity", userID, identityID)}
+}
+
+func (_c *MockAuthServiceInterface_UnlinkIdentity_Call) Run(run func(userID uuid.UUID, identityID uuid.UUID)) *MockAuthServiceInterface_UnlinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_UnlinkIdentity_Call) Return(_a0 error) *MockAuthServiceInterface_UnlinkIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_UnlinkIdentity_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) error) *MockAuthServiceInterface_UnlinkIdentity_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	uuid "github.com/google/uuid"
+	service "github.com/milansax96/movie-terminal-api/internal/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAvailabilityServiceInterface is an autogenerated mock type for the AvailabilityServiceInterface type
+type MockAvailabilityServiceInterface struct {
+	mock.Mock
+}
+
+type MockAvailabilityServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAvailabilityServiceInterface) EXPECT() *MockAvailabilityServiceInterface_Expecter {
+	return &MockAvailabilityServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetAvailability provides a mock function with given fields: userID, region
+func (_m *MockAvailabilityServiceInterface) GetAvailability(userID uuid.UUID, region string) ([]service.WatchlistAvailability, error) {
+	ret := _m.Called(userID, region)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailability")
+	}
+
+	var r0 []service.WatchlistAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) ([]service.WatchlistAvailability, error)); ok {
+		return rf(userID, region)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) []service.WatchlistAvailability); ok {
+		r0 = rf(userID, region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.WatchlistAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string) error); ok {
+		r1 = rf(userID, region)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAvailabilityServiceInterface_GetAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailability'
+type MockAvailabilityServiceInterface_GetAvailability_Call struct {
+	*mock.Call
+}
+
+// GetAvailability is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - region string
+func (_e *MockAvailabilityServiceInterface_Expecter) GetAvailability(userID interface{}, region interface{}) *MockAvailabilityServiceInterface_GetAvailability_Call {
+	return &MockAvailabilityServiceInterface_GetAvailability_Call{Call: _e.mock.On("GetAvailability", userID, region)}
+}
+
+func (_c *MockAvailabilityServiceInterface_GetAvailability_Call) Run(run func(userID uuid.UUID, region string)) *MockAvailabilityServiceInterface_GetAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAvailabilityServiceInterface_GetAvailability_Call) Return(_a0 []service.WatchlistAvailability, _a1 error) *MockAvailabilityServiceInterface_GetAvailability_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAvailabilityServiceInterface_GetAvailability_Call) RunAndReturn(run func(uuid.UUID, string) ([]service.WatchlistAvailability, error)) *MockAvailabilityServiceInterface_GetAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAvailabilityServiceInterface creates a new instance of MockAvailabilityServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAvailabilityServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAvailabilityServiceInterface {
+	mock := &MockAvailabilityServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
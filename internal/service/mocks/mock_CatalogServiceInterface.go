@@ -0,0 +1,256 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	service "github.com/milansax96/movie-terminal-api/internal/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCatalogServiceInterface is an autogenerated mock type for the CatalogServiceInterface type
+type MockCatalogServiceInterface struct {
+	mock.Mock
+}
+
+type MockCatalogServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCatalogServiceInterface) EXPECT() *MockCatalogServiceInterface_Expecter {
+	return &MockCatalogServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateService provides a mock function with given fields: input
+func (_m *MockCatalogServiceInterface) CreateService(input service.StreamingServiceInput) (*models.StreamingService, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateService")
+	}
+
+	var r0 *models.StreamingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func(service.StreamingServiceInput) (*models.StreamingService, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(service.StreamingServiceInput) *models.StreamingService); ok {
+		r0 = rf(input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StreamingService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(service.StreamingServiceInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCatalogServiceInterface_CreateService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateService'
+type MockCatalogServiceInterface_CreateService_Call struct {
+	*mock.Call
+}
+
+// CreateService is a helper method to define mock.On call
+//   - input service.StreamingServiceInput
+func (_e *MockCatalogServiceInterface_Expecter) CreateService(input interface{}) *MockCatalogServiceInterface_CreateService_Call {
+	return &MockCatalogServiceInterface_CreateService_Call{Call: _e.mock.On("CreateService", input)}
+}
+
+func (_c *MockCatalogServiceInterface_CreateService_Call) Run(run func(input service.StreamingServiceInput)) *MockCatalogServiceInterface_CreateService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.StreamingServiceInput))
+	})
+	return _c
+}
+
+func (_c *MockCatalogServiceInterface_CreateService_Call) Return(_a0 *models.StreamingService, _a1 error) *MockCatalogServiceInterface_CreateService_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCatalogServiceInterface_CreateService_Call) RunAndReturn(run func(service.StreamingServiceInput) (*models.StreamingService, error)) *MockCatalogServiceInterface_CreateService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteService provides a mock function with given fields: id
+func (_m *MockCatalogServiceInterface) DeleteService(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteService")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCatalogServiceInterface_DeleteService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteService'
+type MockCatalogServiceInterface_DeleteService_Call struct {
+	*mock.Call
+}
+
+// DeleteService is a helper method to define mock.On call
+//   - id int
+func (_e *MockCatalogServiceInterface_Expecter) DeleteService(id interface{}) *MockCatalogServiceInterface_DeleteService_Call {
+	return &MockCatalogServiceInterface_DeleteService_Call{Call: _e.mock.On("DeleteService", id)}
+}
+
+func (_c *MockCatalogServiceInterface_DeleteService_Call) Run(run func(id int)) *MockCatalogServiceInterface_DeleteService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockCatalogServiceInterface_DeleteService_Call) Return(_a0 error) *MockCatalogServiceInterface_DeleteService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCatalogServiceInterface_DeleteService_Call) RunAndReturn(run func(int) error) *MockCatalogServiceInterface_DeleteService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListServices provides a mock function with no fields
+func (_m *MockCatalogServiceInterface) ListServices() ([]models.StreamingService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServices")
+	}
+
+	var r0 []models.StreamingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.StreamingService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.StreamingService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StreamingService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCatalogServiceInterface_ListServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServices'
+type MockCatalogServiceInterface_ListServices_Call struct {
+	*mock.Call
+}
+
+// ListServices is a helper method to define mock.On call
+func (_e *MockCatalogServiceInterface_Expecter) ListServices() *MockCatalogServiceInterface_ListServices_Call {
+	return &MockCatalogServiceInterface_ListServices_Call{Call: _e.mock.On("ListServices")}
+}
+
+func (_c *MockCatalogServiceInterface_ListServices_Call) Run(run func()) *MockCatalogServiceInterface_ListServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCatalogServiceInterface_ListServices_Call) Return(_a0 []models.StreamingService, _a1 error) *MockCatalogServiceInterface_ListServices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCatalogServiceInterface_ListServices_Call) RunAndReturn(run func() ([]models.StreamingService, error)) *MockCatalogServiceInterface_ListServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateService provides a mock function with given fields: id, input
+func (_m *MockCatalogServiceInterface) UpdateService(id int, input service.StreamingServiceInput) (*models.StreamingService, error) {
+	ret := _m.Called(id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateService")
+	}
+
+	var r0 *models.StreamingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, service.StreamingServiceInput) (*models.StreamingService, error)); ok {
+		return rf(id, input)
+	}
+	if rf, ok := ret.Get(0).(func(int, service.StreamingServiceInput) *models.StreamingService); ok {
+		r0 = rf(id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StreamingService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, service.StreamingServiceInput) error); ok {
+		r1 = rf(id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCatalogServiceInterface_UpdateService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateService'
+type MockCatalogServiceInterface_UpdateService_Call struct {
+	*mock.Call
+}
+
+// UpdateService is a helper method to define mock.On call
+//   - id int
+//   - input service.StreamingServiceInput
+func (_e *MockCatalogServiceInterface_Expecter) UpdateService(id interface{}, input interface{}) *MockCatalogServiceInterface_UpdateService_Call {
+	return &MockCatalogServiceInterface_UpdateService_Call{Call: _e.mock.On("UpdateService", id, input)}
+}
+
+func (_c *MockCatalogServiceInterface_UpdateService_Call) Run(run func(id int, input service.StreamingServiceInput)) *MockCatalogServiceInterface_UpdateService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(service.StreamingServiceInput))
+	})
+	return _c
+}
+
+func (_c *MockCatalogServiceInterface_UpdateService_Call) Return(_a0 *models.StreamingService, _a1 error) *MockCatalogServiceInterface_UpdateService_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCatalogServiceInterface_UpdateService_Call) RunAndReturn(run func(int, service.StreamingServiceInput) (*models.StreamingService, error)) *MockCatalogServiceInterface_UpdateService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCatalogServiceInterface creates a new instance of MockCatalogServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCatalogServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCatalogServiceInterface {
+	mock := &MockCatalogServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,269 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	service "github.com/milansax96/movie-terminal-api/internal/service"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDiaryServiceInterface is an autogenerated mock type for the DiaryServiceInterface type
+type MockDiaryServiceInterface struct {
+	mock.Mock
+}
+
+type MockDiaryServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDiaryServiceInterface) EXPECT() *MockDiaryServiceInterface_Expecter {
+	return &MockDiaryServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// DeleteEntry provides a mock function with given fields: userID, id
+func (_m *MockDiaryServiceInterface) DeleteEntry(userID uuid.UUID, id uuid.UUID) error {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDiaryServiceInterface_DeleteEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEntry'
+type MockDiaryServiceInterface_DeleteEntry_Call struct {
+	*mock.Call
+}
+
+// DeleteEntry is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockDiaryServiceInterface_Expecter) DeleteEntry(userID interface{}, id interface{}) *MockDiaryServiceInterface_DeleteEntry_Call {
+	return &MockDiaryServiceInterface_DeleteEntry_Call{Call: _e.mock.On("DeleteEntry", userID, id)}
+}
+
+func (_c *MockDiaryServiceInterface_DeleteEntry_Call) Run(run func(userID uuid.UUID, id uuid.UUID)) *MockDiaryServiceInterface_DeleteEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDiaryServiceInterface_DeleteEntry_Call) Return(_a0 error) *MockDiaryServiceInterface_DeleteEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDiaryServiceInterface_DeleteEntry_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) error) *MockDiaryServiceInterface_DeleteEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntries provides a mock function with given fields: userID
+func (_m *MockDiaryServiceInterface) ListEntries(userID uuid.UUID) ([]models.DiaryEntry, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntries")
+	}
+
+	var r0 []models.DiaryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.DiaryEntry, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.DiaryEntry); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DiaryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDiaryServiceInterface_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type MockDiaryServiceInterface_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockDiaryServiceInterface_Expecter) ListEntries(userID interface{}) *MockDiaryServiceInterface_ListEntries_Call {
+	return &MockDiaryServiceInterface_ListEntries_Call{Call: _e.mock.On("ListEntries", userID)}
+}
+
+func (_c *MockDiaryServiceInterface_ListEntries_Call) Run(run func(userID uuid.UUID)) *MockDiaryServiceInterface_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDiaryServiceInterface_ListEntries_Call) Return(_a0 []models.DiaryEntry, _a1 error) *MockDiaryServiceInterface_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDiaryServiceInterface_ListEntries_Call) RunAndReturn(run func(uuid.UUID) ([]models.DiaryEntry, error)) *MockDiaryServiceInterface_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogEntry provides a mock function with given fields: userID, input
+func (_m *MockDiaryServiceInterface) LogEntry(userID uuid.UUID, input service.DiaryEntryInput) (*models.DiaryEntry, bool, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogEntry")
+	}
+
+	var r0 *models.DiaryEntry
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.DiaryEntryInput) (*models.DiaryEntry, bool, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.DiaryEntryInput) *models.DiaryEntry); ok {
+		r0 = rf(userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DiaryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, service.DiaryEntryInput) bool); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(uuid.UUID, service.DiaryEntryInput) error); ok {
+		r2 = rf(userID, input)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDiaryServiceInterface_LogEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogEntry'
+type MockDiaryServiceInterface_LogEntry_Call struct {
+	*mock.Call
+}
+
+// LogEntry is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - input service.DiaryEntryInput
+func (_e *MockDiaryServiceInterface_Expecter) LogEntry(userID interface{}, input interface{}) *MockDiaryServiceInterface_LogEntry_Call {
+	return &MockDiaryServiceInterface_LogEntry_Call{Call: _e.mock.On("LogEntry", userID, input)}
+}
+
+func (_c *MockDiaryServiceInterface_LogEntry_Call) Run(run func(userID uuid.UUID, input service.DiaryEntryInput)) *MockDiaryServiceInterface_LogEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(service.DiaryEntryInput))
+	})
+	return _c
+}
+
+func (_c *MockDiaryServiceInterface_LogEntry_Call) Return(_a0 *models.DiaryEntry, _a1 bool, _a2 error) *MockDiaryServiceInterface_LogEntry_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDiaryServiceInterface_LogEntry_Call) RunAndReturn(run func(uuid.UUID, service.DiaryEntryInput) (*models.DiaryEntry, bool, error)) *MockDiaryServiceInterface_LogEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEntry provides a mock function with given fields: userID, id, update
+func (_m *MockDiaryServiceInterface) UpdateEntry(userID uuid.UUID, id uuid.UUID, update service.DiaryEntryUpdate) (*models.DiaryEntry, error) {
+	ret := _m.Called(userID, id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEntry")
+	}
+
+	var r0 *models.DiaryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, service.DiaryEntryUpdate) (*models.DiaryEntry, error)); ok {
+		return rf(userID, id, update)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, service.DiaryEntryUpdate) *models.DiaryEntry); ok {
+		r0 = rf(userID, id, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DiaryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, service.DiaryEntryUpdate) error); ok {
+		r1 = rf(userID, id, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDiaryServiceInterface_UpdateEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEntry'
+type MockDiaryServiceInterface_UpdateEntry_Call struct {
+	*mock.Call
+}
+
+// UpdateEntry is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - id uuid.UUID
+//   - update service.DiaryEntryUpdate
+func (_e *MockDiaryServiceInterface_Expecter) UpdateEntry(userID interface{}, id interface{}, update interface{}) *MockDiaryServiceInterface_UpdateEntry_Call {
+	return &MockDiaryServiceInterface_UpdateEntry_Call{Call: _e.mock.On("UpdateEntry", userID, id, update)}
+}
+
+func (_c *MockDiaryServiceInterface_UpdateEntry_Call) Run(run func(userID uuid.UUID, id uuid.UUID, update service.DiaryEntryUpdate)) *MockDiaryServiceInterface_UpdateEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(service.DiaryEntryUpdate))
+	})
+	return _c
+}
+
+func (_c *MockDiaryServiceInterface_UpdateEntry_Call) Return(_a0 *models.DiaryEntry, _a1 error) *MockDiaryServiceInterface_UpdateEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDiaryServiceInterface_UpdateEntry_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, service.DiaryEntryUpdate) (*models.DiaryEntry, error)) *MockDiaryServiceInterface_UpdateEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDiaryServiceInterface creates a new instance of MockDiaryServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDiaryServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDiaryServiceInterface {
+	mock := &MockDiaryServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,658 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	service "github.com/milansax96/movie-terminal-api/internal/service"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockListServiceInterface is an autogenerated mock type for the ListServiceInterface type
+type MockListServiceInterface struct {
+	mock.Mock
+}
+
+type MockListServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListServiceInterface) EXPECT() *MockListServiceInterface_Expecter {
+	return &MockListServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddItem provides a mock function with given fields: userID, listID, input
+func (_m *MockListServiceInterface) AddItem(userID uuid.UUID, listID uuid.UUID, input service.ListItemInput) (*models.ListItem, error) {
+	ret := _m.Called(userID, listID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddItem")
+	}
+
+	var r0 *models.ListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, service.ListItemInput) (*models.ListItem, error)); ok {
+		return rf(userID, listID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, service.ListItemInput) *models.ListItem); ok {
+		r0 = rf(userID, listID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ListItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, service.ListItemInput) error); ok {
+		r1 = rf(userID, listID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListServiceInterface_AddItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddItem'
+type MockListServiceInterface_AddItem_Call struct {
+	*mock.Call
+}
+
+// AddItem is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - listID uuid.UUID
+//   - input service.ListItemInput
+func (_e *MockListServiceInterface_Expecter) AddItem(userID interface{}, listID interface{}, input interface{}) *MockListServiceInterface_AddItem_Call {
+	return &MockListServiceInterface_AddItem_Call{Call: _e.mock.On("AddItem", userID, listID, input)}
+}
+
+func (_c *MockListServiceInterface_AddItem_Call) Run(run func(userID uuid.UUID, listID uuid.UUID, input service.ListItemInput)) *MockListServiceInterface_AddItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(service.ListItemInput))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_AddItem_Call) Return(_a0 *models.ListItem, _a1 error) *MockListServiceInterface_AddItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListServiceInterface_AddItem_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, service.ListItemInput) (*models.ListItem, error)) *MockListServiceInterface_AddItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateList provides a mock function with given fields: userID, input
+func (_m *MockListServiceInterface) CreateList(userID uuid.UUID, input service.ListInput) (*models.List, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateList")
+	}
+
+	var r0 *models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.ListInput) (*models.List, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.ListInput) *models.List); ok {
+		r0 = rf(userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, service.ListInput) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListServiceInterface_CreateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateList'
+type MockListServiceInterface_CreateList_Call struct {
+	*mock.Call
+}
+
+// CreateList is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - input service.ListInput
+func (_e *MockListServiceInterface_Expecter) CreateList(userID interface{}, input interface{}) *MockListServiceInterface_CreateList_Call {
+	return &MockListServiceInterface_CreateList_Call{Call: _e.mock.On("CreateList", userID, input)}
+}
+
+func (_c *MockListServiceInterface_CreateList_Call) Run(run func(userID uuid.UUID, input service.ListInput)) *MockListServiceInterface_CreateList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(service.ListInput))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_CreateList_Call) Return(_a0 *models.List, _a1 error) *MockListServiceInterface_CreateList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListServiceInterface_CreateList_Call) RunAndReturn(run func(uuid.UUID, service.ListInput) (*models.List, error)) *MockListServiceInterface_CreateList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteList provides a mock function with given fields: userID, id
+func (_m *MockListServiceInterface) DeleteList(userID uuid.UUID, id uuid.UUID) error {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListServiceInterface_DeleteList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteList'
+type MockListServiceInterface_DeleteList_Call struct {
+	*mock.Call
+}
+
+// DeleteList is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockListServiceInterface_Expecter) DeleteList(userID interface{}, id interface{}) *MockListServiceInterface_DeleteList_Call {
+	return &MockListServiceInterface_DeleteList_Call{Call: _e.mock.On("DeleteList", userID, id)}
+}
+
+func (_c *MockListServiceInterface_DeleteList_Call) Run(run func(userID uuid.UUID, id uuid.UUID)) *MockListServiceInterface_DeleteList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_DeleteList_Call) Return(_a0 error) *MockListServiceInterface_DeleteList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListServiceInterface_DeleteList_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) error) *MockListServiceInterface_DeleteList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetList provides a mock function with given fields: viewerID, id
+func (_m *MockListServiceInterface) GetList(viewerID uuid.UUID, id uuid.UUID) (*models.List, error) {
+	ret := _m.Called(viewerID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetList")
+	}
+
+	var r0 *models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) (*models.List, error)); ok {
+		return rf(viewerID, id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) *models.List); ok {
+		r0 = rf(viewerID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(viewerID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListServiceInterface_GetList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetList'
+type MockListServiceInterface_GetList_Call struct {
+	*mock.Call
+}
+
+// GetList is a helper method to define mock.On call
+//   - viewerID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockListServiceInterface_Expecter) GetList(viewerID interface{}, id interface{}) *MockListServiceInterface_GetList_Call {
+	return &MockListServiceInterface_GetList_Call{Call: _e.mock.On("GetList", viewerID, id)}
+}
+
+func (_c *MockListServiceInterface_GetList_Call) Run(run func(viewerID uuid.UUID, id uuid.UUID)) *MockListServiceInterface_GetList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_GetList_Call) Return(_a0 *models.List, _a1 error) *MockListServiceInterface_GetList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListServiceInterface_GetList_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) (*models.List, error)) *MockListServiceInterface_GetList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLists provides a mock function with given fields: userID
+func (_m *MockListServiceInterface) GetLists(userID uuid.UUID) ([]models.List, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLists")
+	}
+
+	var r0 []models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.List, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.List); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListServiceInterface_GetLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLists'
+type MockListServiceInterface_GetLists_Call struct {
+	*mock.Call
+}
+
+// GetLists is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockListServiceInterface_Expecter) GetLists(userID interface{}) *MockListServiceInterface_GetLists_Call {
+	return &MockListServiceInterface_GetLists_Call{Call: _e.mock.On("GetLists", userID)}
+}
+
+func (_c *MockListServiceInterface_GetLists_Call) Run(run func(userID uuid.UUID)) *MockListServiceInterface_GetLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_GetLists_Call) Return(_a0 []models.List, _a1 error) *MockListServiceInterface_GetLists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListServiceInterface_GetLists_Call) RunAndReturn(run func(uuid.UUID) ([]models.List, error)) *MockListServiceInterface_GetLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// History provides a mock function with given fields: userID, listID
+func (_m *MockListServiceInterface) History(userID uuid.UUID, listID uuid.UUID) ([]models.ListChange, error) {
+	ret := _m.Called(userID, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []models.ListChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) ([]models.ListChange, error)); ok {
+		return rf(userID, listID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) []models.ListChange); ok {
+		r0 = rf(userID, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ListChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(userID, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListServiceInterface_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type MockListServiceInterface_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - listID uuid.UUID
+func (_e *MockListServiceInterface_Expecter) History(userID interface{}, listID interface{}) *MockListServiceInterface_History_Call {
+	return &MockListServiceInterface_History_Call{Call: _e.mock.On("History", userID, listID)}
+}
+
+func (_c *MockListServiceInterface_History_Call) Run(run func(userID uuid.UUID, listID uuid.UUID)) *MockListServiceInterface_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_History_Call) Return(_a0 []models.ListChange, _a1 error) *MockListServiceInterface_History_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListServiceInterface_History_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) ([]models.ListChange, error)) *MockListServiceInterface_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveItem provides a mock function with given fields: userID, listID, itemID
+func (_m *MockListServiceInterface) RemoveItem(userID uuid.UUID, listID uuid.UUID, itemID uuid.UUID) error {
+	ret := _m.Called(userID, listID, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(userID, listID, itemID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListServiceInterface_RemoveItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveItem'
+type MockListServiceInterface_RemoveItem_Call struct {
+	*mock.Call
+}
+
+// RemoveItem is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - listID uuid.UUID
+//   - itemID uuid.UUID
+func (_e *MockListServiceInterface_Expecter) RemoveItem(userID interface{}, listID interface{}, itemID interface{}) *MockListServiceInterface_RemoveItem_Call {
+	return &MockListServiceInterface_RemoveItem_Call{Call: _e.mock.On("RemoveItem", userID, listID, itemID)}
+}
+
+func (_c *MockListServiceInterface_RemoveItem_Call) Run(run func(userID uuid.UUID, listID uuid.UUID, itemID uuid.UUID)) *MockListServiceInterface_RemoveItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_RemoveItem_Call) Return(_a0 error) *MockListServiceInterface_RemoveItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListServiceInterface_RemoveItem_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, uuid.UUID) error) *MockListServiceInterface_RemoveItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: userID, listID, memberID
+func (_m *MockListServiceInterface) RemoveMember(userID uuid.UUID, listID uuid.UUID, memberID uuid.UUID) error {
+	ret := _m.Called(userID, listID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(userID, listID, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockListServiceInterface_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockListServiceInterface_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - listID uuid.UUID
+//   - memberID uuid.UUID
+func (_e *MockListServiceInterface_Expecter) RemoveMember(userID interface{}, listID interface{}, memberID interface{}) *MockListServiceInterface_RemoveMember_Call {
+	return &MockListServiceInterface_RemoveMember_Call{Call: _e.mock.On("RemoveMember", userID, listID, memberID)}
+}
+
+func (_c *MockListServiceInterface_RemoveMember_Call) Run(run func(userID uuid.UUID, listID uuid.UUID, memberID uuid.UUID)) *MockListServiceInterface_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_RemoveMember_Call) Return(_a0 error) *MockListServiceInterface_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockListServiceInterface_RemoveMember_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, uuid.UUID) error) *MockListServiceInterface_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderItems provides a mock function with given fields: userID, listID, version, itemIDs
+func (_m *MockListServiceInterface) ReorderItems(userID uuid.UUID, listID uuid.UUID, version int, itemIDs []uuid.UUID) (*models.List, error) {
+	ret := _m.Called(userID, listID, version, itemIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderItems")
+	}
+
+	var r0 *models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int, []uuid.UUID) (*models.List, error)); ok {
+		return rf(userID, listID, version, itemIDs)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int, []uuid.UUID) *models.List); ok {
+		r0 = rf(userID, listID, version, itemIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, int, []uuid.UUID) error); ok {
+		r1 = rf(userID, listID, version, itemIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListServiceInterface_ReorderItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderItems'
+type MockListServiceInterface_ReorderItems_Call struct {
+	*mock.Call
+}
+
+// ReorderItems is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - listID uuid.UUID
+//   - version int
+//   - itemIDs []uuid.UUID
+func (_e *MockListServiceInterface_Expecter) ReorderItems(userID interface{}, listID interface{}, version interface{}, itemIDs interface{}) *MockListServiceInterface_ReorderItems_Call {
+	return &MockListServiceInterface_ReorderItems_Call{Call: _e.mock.On("ReorderItems", userID, listID, version, itemIDs)}
+}
+
+func (_c *MockListServiceInterface_ReorderItems_Call) Run(run func(userID uuid.UUID, listID uuid.UUID, version int, itemIDs []uuid.UUID)) *MockListServiceInterface_ReorderItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(int), args[3].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_ReorderItems_Call) Return(_a0 *models.List, _a1 error) *MockListServiceInterface_ReorderItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListServiceInterface_ReorderItems_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, int, []uuid.UUID) (*models.List, error)) *MockListServiceInterface_ReorderItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMember provides a mock function with given fields: ownerID, listID, memberID, role
+func (_m *MockListServiceInterface) SetMember(ownerID uuid.UUID, listID uuid.UUID, memberID uuid.UUID, role string) (*models.ListMember, error) {
+	ret := _m.Called(ownerID, listID, memberID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMember")
+	}
+
+	var r0 *models.ListMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, uuid.UUID, string) (*models.ListMember, error)); ok {
+		return rf(ownerID, listID, memberID, role)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, uuid.UUID, string) *models.ListMember); ok {
+		r0 = rf(ownerID, listID, memberID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ListMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ownerID, listID, memberID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListServiceInterface_SetMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMember'
+type MockListServiceInterface_SetMember_Call struct {
+	*mock.Call
+}
+
+// SetMember is a helper method to define mock.On call
+//   - ownerID uuid.UUID
+//   - listID uuid.UUID
+//   - memberID uuid.UUID
+//   - role string
+func (_e *MockListServiceInterface_Expecter) SetMember(ownerID interface{}, listID interface{}, memberID interface{}, role interface{}) *MockListServiceInterface_SetMember_Call {
+	return &MockListServiceInterface_SetMember_Call{Call: _e.mock.On("SetMember", ownerID, listID, memberID, role)}
+}
+
+func (_c *MockListServiceInterface_SetMember_Call) Run(run func(ownerID uuid.UUID, listID uuid.UUID, memberID uuid.UUID, role string)) *MockListServiceInterface_SetMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_SetMember_Call) Return(_a0 *models.ListMember, _a1 error) *MockListServiceInterface_SetMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListServiceInterface_SetMember_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, uuid.UUID, string) (*models.ListMember, error)) *MockListServiceInterface_SetMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateList provides a mock function with given fields: userID, id, update
+func (_m *MockListServiceInterface) UpdateList(userID uuid.UUID, id uuid.UUID, update service.ListUpdate) (*models.List, error) {
+	ret := _m.Called(userID, id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateList")
+	}
+
+	var r0 *models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, service.ListUpdate) (*models.List, error)); ok {
+		return rf(userID, id, update)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, service.ListUpdate) *models.List); ok {
+		r0 = rf(userID, id, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, service.ListUpdate) error); ok {
+		r1 = rf(userID, id, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListServiceInterface_UpdateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateList'
+type MockListServiceInterface_UpdateList_Call struct {
+	*mock.Call
+}
+
+// UpdateList is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - id uuid.UUID
+//   - update service.ListUpdate
+func (_e *MockListServiceInterface_Expecter) UpdateList(userID interface{}, id interface{}, update interface{}) *MockListServiceInterface_UpdateList_Call {
+	return &MockListServiceInterface_UpdateList_Call{Call: _e.mock.On("UpdateList", userID, id, update)}
+}
+
+func (_c *MockListServiceInterface_UpdateList_Call) Run(run func(userID uuid.UUID, id uuid.UUID, update service.ListUpdate)) *MockListServiceInterface_UpdateList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID), args[2].(service.ListUpdate))
+	})
+	return _c
+}
+
+func (_c *MockListServiceInterface_UpdateList_Call) Return(_a0 *models.List, _a1 error) *MockListServiceInterface_UpdateList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListServiceInterface_UpdateList_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID, service.ListUpdate) (*models.List, error)) *MockListServiceInterface_UpdateList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListServiceInterface creates a new instance of MockListServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListServiceInterface {
+	mock := &MockListServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
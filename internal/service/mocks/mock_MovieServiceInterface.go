@@ -3,13 +3,13 @@
 package mocks
 
 import (
-	json "encoding/json"
-
 	models "github.com/milansax96/movie-terminal-api/internal/models"
+	service "github.com/milansax96/movie-terminal-api/internal/service"
 	mock "github.com/stretchr/testify/mock"
 
-	uuid "github.com/google/uuid"
 	tmdb "github.com/milansax96/movie-terminal-api/pkg/tmdb"
+
+	uuid "github.com/google/uuid"
 )
 
 // MockMovieServiceInterface is an autogenerated mock type for the MovieServiceInterface type
@@ -259,6 +259,186 @@ func (_c *MockMovieServiceInterface_DiscoverAll_Call) RunAndReturn(run func(uuid
 	return _c
 }
 
+// DiscoverFiltered provides a mock function with given fields: userID, filters
+func (_m *MockMovieServiceInterface) DiscoverFiltered(userID uuid.UUID, filters service.DiscoverFilters) ([]models.Movie, error) {
+	ret := _m.Called(userID, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscoverFiltered")
+	}
+
+	var r0 []models.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.DiscoverFilters) ([]models.Movie, error)); ok {
+		return rf(userID, filters)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.DiscoverFilters) []models.Movie); ok {
+		r0 = rf(userID, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, service.DiscoverFilters) error); ok {
+		r1 = rf(userID, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieServiceInterface_DiscoverFiltered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscoverFiltered'
+type MockMovieServiceInterface_DiscoverFiltered_Call struct {
+	*mock.Call
+}
+
+// DiscoverFiltered is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - filters service.DiscoverFilters
+func (_e *MockMovieServiceInterface_Expecter) DiscoverFiltered(userID interface{}, filters interface{}) *MockMovieServiceInterface_DiscoverFiltered_Call {
+	return &MockMovieServiceInterface_DiscoverFiltered_Call{Call: _e.mock.On("DiscoverFiltered", userID, filters)}
+}
+
+func (_c *MockMovieServiceInterface_DiscoverFiltered_Call) Run(run func(userID uuid.UUID, filters service.DiscoverFilters)) *MockMovieServiceInterface_DiscoverFiltered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(service.DiscoverFilters))
+	})
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_DiscoverFiltered_Call) Return(_a0 []models.Movie, _a1 error) *MockMovieServiceInterface_DiscoverFiltered_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_DiscoverFiltered_Call) RunAndReturn(run func(uuid.UUID, service.DiscoverFilters) ([]models.Movie, error)) *MockMovieServiceInterface_DiscoverFiltered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiscoverOnMyServices provides a mock function with given fields: userID, mediaType, region, page
+func (_m *MockMovieServiceInterface) DiscoverOnMyServices(userID uuid.UUID, mediaType string, region string, page int) ([]models.Movie, error) {
+	ret := _m.Called(userID, mediaType, region, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscoverOnMyServices")
+	}
+
+	var r0 []models.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, string, int) ([]models.Movie, error)); ok {
+		return rf(userID, mediaType, region, page)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, string, int) []models.Movie); ok {
+		r0 = rf(userID, mediaType, region, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, string, int) error); ok {
+		r1 = rf(userID, mediaType, region, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieServiceInterface_DiscoverOnMyServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscoverOnMyServices'
+type MockMovieServiceInterface_DiscoverOnMyServices_Call struct {
+	*mock.Call
+}
+
+// DiscoverOnMyServices is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - mediaType string
+//   - region string
+//   - page int
+func (_e *MockMovieServiceInterface_Expecter) DiscoverOnMyServices(userID interface{}, mediaType interface{}, region interface{}, page interface{}) *MockMovieServiceInterface_DiscoverOnMyServices_Call {
+	return &MockMovieServiceInterface_DiscoverOnMyServices_Call{Call: _e.mock.On("DiscoverOnMyServices", userID, mediaType, region, page)}
+}
+
+func (_c *MockMovieServiceInterface_DiscoverOnMyServices_Call) Run(run func(userID uuid.UUID, mediaType string, region string, page int)) *MockMovieServiceInterface_DiscoverOnMyServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_DiscoverOnMyServices_Call) Return(_a0 []models.Movie, _a1 error) *MockMovieServiceInterface_DiscoverOnMyServices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_DiscoverOnMyServices_Call) RunAndReturn(run func(uuid.UUID, string, string, int) ([]models.Movie, error)) *MockMovieServiceInterface_DiscoverOnMyServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiscoverTV provides a mock function with given fields: userID, category, page
+func (_m *MockMovieServiceInterface) DiscoverTV(userID uuid.UUID, category string, page int) ([]models.Movie, error) {
+	ret := _m.Called(userID, category, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscoverTV")
+	}
+
+	var r0 []models.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int) ([]models.Movie, error)); ok {
+		return rf(userID, category, page)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int) []models.Movie); ok {
+		r0 = rf(userID, category, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int) error); ok {
+		r1 = rf(userID, category, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieServiceInterface_DiscoverTV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscoverTV'
+type MockMovieServiceInterface_DiscoverTV_Call struct {
+	*mock.Call
+}
+
+// DiscoverTV is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - category string
+//   - page int
+func (_e *MockMovieServiceInterface_Expecter) DiscoverTV(userID interface{}, category interface{}, page interface{}) *MockMovieServiceInterface_DiscoverTV_Call {
+	return &MockMovieServiceInterface_DiscoverTV_Call{Call: _e.mock.On("DiscoverTV", userID, category, page)}
+}
+
+func (_c *MockMovieServiceInterface_DiscoverTV_Call) Run(run func(userID uuid.UUID, category string, page int)) *MockMovieServiceInterface_DiscoverTV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_DiscoverTV_Call) Return(_a0 []models.Movie, _a1 error) *MockMovieServiceInterface_DiscoverTV_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_DiscoverTV_Call) RunAndReturn(run func(uuid.UUID, string, int) ([]models.Movie, error)) *MockMovieServiceInterface_DiscoverTV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCredits provides a mock function with given fields: mediaType, id
 func (_m *MockMovieServiceInterface) GetCredits(mediaType string, id int) (*tmdb.CreditsResponse, error) {
 	ret := _m.Called(mediaType, id)
@@ -377,29 +557,87 @@ func (_c *MockMovieServiceInterface_GetDetail_Call) RunAndReturn(run func(string
 	return _c
 }
 
-// GetProviders provides a mock function with given fields: mediaType, id
-func (_m *MockMovieServiceInterface) GetProviders(mediaType string, id int) (json.RawMessage, error) {
-	ret := _m.Called(mediaType, id)
+// GetGenres provides a mock function with given fields: mediaType
+func (_m *MockMovieServiceInterface) GetGenres(mediaType string) ([]models.Genre, error) {
+	ret := _m.Called(mediaType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGenres")
+	}
+
+	var r0 []models.Genre
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Genre, error)); ok {
+		return rf(mediaType)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Genre); ok {
+		r0 = rf(mediaType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Genre)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(mediaType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieServiceInterface_GetGenres_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGenres'
+type MockMovieServiceInterface_GetGenres_Call struct {
+	*mock.Call
+}
+
+// GetGenres is a helper method to define mock.On call
+//   - mediaType string
+func (_e *MockMovieServiceInterface_Expecter) GetGenres(mediaType interface{}) *MockMovieServiceInterface_GetGenres_Call {
+	return &MockMovieServiceInterface_GetGenres_Call{Call: _e.mock.On("GetGenres", mediaType)}
+}
+
+func (_c *MockMovieServiceInterface_GetGenres_Call) Run(run func(mediaType string)) *MockMovieServiceInterface_GetGenres_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_GetGenres_Call) Return(_a0 []models.Genre, _a1 error) *MockMovieServiceInterface_GetGenres_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_GetGenres_Call) RunAndReturn(run func(string) ([]models.Genre, error)) *MockMovieServiceInterface_GetGenres_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProviders provides a mock function with given fields: userID, mediaType, id, region
+func (_m *MockMovieServiceInterface) GetProviders(userID uuid.UUID, mediaType string, id int, region string) (*models.WatchProviders, error) {
+	ret := _m.Called(userID, mediaType, id, region)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProviders")
 	}
 
-	var r0 json.RawMessage
+	var r0 *models.WatchProviders
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int) (json.RawMessage, error)); ok {
-		return rf(mediaType, id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, string) (*models.WatchProviders, error)); ok {
+		return rf(userID, mediaType, id, region)
 	}
-	if rf, ok := ret.Get(0).(func(string, int) json.RawMessage); ok {
-		r0 = rf(mediaType, id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, string) *models.WatchProviders); ok {
+		r0 = rf(userID, mediaType, id, region)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(json.RawMessage)
+			r0 = ret.Get(0).(*models.WatchProviders)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int) error); ok {
-		r1 = rf(mediaType, id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int, string) error); ok {
+		r1 = rf(userID, mediaType, id, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -413,25 +651,149 @@ type MockMovieServiceInterface_GetProviders_Call struct {
 }
 
 // GetProviders is a helper method to define mock.On call
+//   - userID uuid.UUID
 //   - mediaType string
 //   - id int
-func (_e *MockMovieServiceInterface_Expecter) GetProviders(mediaType interface{}, id interface{}) *MockMovieServiceInterface_GetProviders_Call {
-	return &MockMovieServiceInterface_GetProviders_Call{Call: _e.mock.On("GetProviders", mediaType, id)}
+//   - region string
+func (_e *MockMovieServiceInterface_Expecter) GetProviders(userID interface{}, mediaType interface{}, id interface{}, region interface{}) *MockMovieServiceInterface_GetProviders_Call {
+	return &MockMovieServiceInterface_GetProviders_Call{Call: _e.mock.On("GetProviders", userID, mediaType, id, region)}
 }
 
-func (_c *MockMovieServiceInterface_GetProviders_Call) Run(run func(mediaType string, id int)) *MockMovieServiceInterface_GetProviders_Call {
+func (_c *MockMovieServiceInterface_GetProviders_Call) Run(run func(userID uuid.UUID, mediaType string, id int, region string)) *MockMovieServiceInterface_GetProviders_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int))
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockMovieServiceInterface_GetProviders_Call) Return(_a0 json.RawMessage, _a1 error) *MockMovieServiceInterface_GetProviders_Call {
+func (_c *MockMovieServiceInterface_GetProviders_Call) Return(_a0 *models.WatchProviders, _a1 error) *MockMovieServiceInterface_GetProviders_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMovieServiceInterface_GetProviders_Call) RunAndReturn(run func(string, int) (json.RawMessage, error)) *MockMovieServiceInterface_GetProviders_Call {
+func (_c *MockMovieServiceInterface_GetProviders_Call) RunAndReturn(run func(uuid.UUID, string, int, string) (*models.WatchProviders, error)) *MockMovieServiceInterface_GetProviders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecommendations provides a mock function with given fields: userID, mediaType, id, page
+func (_m *MockMovieServiceInterface) GetRecommendations(userID uuid.UUID, mediaType string, id int, page int) ([]models.Movie, error) {
+	ret := _m.Called(userID, mediaType, id, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecommendations")
+	}
+
+	var r0 []models.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, int) ([]models.Movie, error)); ok {
+		return rf(userID, mediaType, id, page)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, int) []models.Movie); ok {
+		r0 = rf(userID, mediaType, id, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int, int) error); ok {
+		r1 = rf(userID, mediaType, id, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieServiceInterface_GetRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecommendations'
+type MockMovieServiceInterface_GetRecommendations_Call struct {
+	*mock.Call
+}
+
+// GetRecommendations is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - mediaType string
+//   - id int
+//   - page int
+func (_e *MockMovieServiceInterface_Expecter) GetRecommendations(userID interface{}, mediaType interface{}, id interface{}, page interface{}) *MockMovieServiceInterface_GetRecommendations_Call {
+	return &MockMovieServiceInterface_GetRecommendations_Call{Call: _e.mock.On("GetRecommendations", userID, mediaType, id, page)}
+}
+
+func (_c *MockMovieServiceInterface_GetRecommendations_Call) Run(run func(userID uuid.UUID, mediaType string, id int, page int)) *MockMovieServiceInterface_GetRecommendations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_GetRecommendations_Call) Return(_a0 []models.Movie, _a1 error) *MockMovieServiceInterface_GetRecommendations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_GetRecommendations_Call) RunAndReturn(run func(uuid.UUID, string, int, int) ([]models.Movie, error)) *MockMovieServiceInterface_GetRecommendations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSimilar provides a mock function with given fields: userID, mediaType, id, page
+func (_m *MockMovieServiceInterface) GetSimilar(userID uuid.UUID, mediaType string, id int, page int) ([]models.Movie, error) {
+	ret := _m.Called(userID, mediaType, id, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSimilar")
+	}
+
+	var r0 []models.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, int) ([]models.Movie, error)); ok {
+		return rf(userID, mediaType, id, page)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, int, int) []models.Movie); ok {
+		r0 = rf(userID, mediaType, id, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, int, int) error); ok {
+		r1 = rf(userID, mediaType, id, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieServiceInterface_GetSimilar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimilar'
+type MockMovieServiceInterface_GetSimilar_Call struct {
+	*mock.Call
+}
+
+// GetSimilar is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - mediaType string
+//   - id int
+//   - page int
+func (_e *MockMovieServiceInterface_Expecter) GetSimilar(userID interface{}, mediaType interface{}, id interface{}, page interface{}) *MockMovieServiceInterface_GetSimilar_Call {
+	return &MockMovieServiceInterface_GetSimilar_Call{Call: _e.mock.On("GetSimilar", userID, mediaType, id, page)}
+}
+
+func (_c *MockMovieServiceInterface_GetSimilar_Call) Run(run func(userID uuid.UUID, mediaType string, id int, page int)) *MockMovieServiceInterface_GetSimilar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_GetSimilar_Call) Return(_a0 []models.Movie, _a1 error) *MockMovieServiceInterface_GetSimilar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieServiceInterface_GetSimilar_Call) RunAndReturn(run func(uuid.UUID, string, int, int) ([]models.Movie, error)) *MockMovieServiceInterface_GetSimilar_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -495,29 +857,29 @@ func (_c *MockMovieServiceInterface_GetVideos_Call) RunAndReturn(run func(string
 	return _c
 }
 
-// GetWatchlist provides a mock function with given fields: userID
-func (_m *MockMovieServiceInterface) GetWatchlist(userID uuid.UUID) ([]models.Watchlist, error) {
-	ret := _m.Called(userID)
+// GetWatchlist provides a mock function with given fields: userID, filters
+func (_m *MockMovieServiceInterface) GetWatchlist(userID uuid.UUID, filters service.WatchlistFilters) (*service.WatchlistPage, error) {
+	ret := _m.Called(userID, filters)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetWatchlist")
 	}
 
-	var r0 []models.Watchlist
+	var r0 *service.WatchlistPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.Watchlist, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.WatchlistFilters) (*service.WatchlistPage, error)); ok {
+		return rf(userID, filters)
 	}
-	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.Watchlist); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.WatchlistFilters) *service.WatchlistPage); ok {
+		r0 = rf(userID, filters)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Watchlist)
+			r0 = ret.Get(0).(*service.WatchlistPage)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, service.WatchlistFilters) error); ok {
+		r1 = rf(userID, filters)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -532,23 +894,24 @@ type MockMovieServiceInterface_GetWatchlist_Call struct {
 
 // GetWatchlist is a helper method to define mock.On call
 //   - userID uuid.UUID
-func (_e *MockMovieServiceInterface_Expecter) GetWatchlist(userID interface{}) *MockMovieServiceInterface_GetWatchlist_Call {
-	return &MockMovieServiceInterface_GetWatchlist_Call{Call: _e.mock.On("GetWatchlist", userID)}
+//   - filters service.WatchlistFilters
+func (_e *MockMovieServiceInterface_Expecter) GetWatchlist(userID interface{}, filters interface{}) *MockMovieServiceInterface_GetWatchlist_Call {
+	return &MockMovieServiceInterface_GetWatchlist_Call{Call: _e.mock.On("GetWatchlist", userID, filters)}
 }
 
-func (_c *MockMovieServiceInterface_GetWatchlist_Call) Run(run func(userID uuid.UUID)) *MockMovieServiceInterface_GetWatchlist_Call {
+func (_c *MockMovieServiceInterface_GetWatchlist_Call) Run(run func(userID uuid.UUID, filters service.WatchlistFilters)) *MockMovieServiceInterface_GetWatchlist_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uuid.UUID))
+		run(args[0].(uuid.UUID), args[1].(service.WatchlistFilters))
 	})
 	return _c
 }
 
-func (_c *MockMovieServiceInterface_GetWatchlist_Call) Return(_a0 []models.Watchlist, _a1 error) *MockMovieServiceInterface_GetWatchlist_Call {
+func (_c *MockMovieServiceInterface_GetWatchlist_Call) Return(_a0 *service.WatchlistPage, _a1 error) *MockMovieServiceInterface_GetWatchlist_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMovieServiceInterface_GetWatchlist_Call) RunAndReturn(run func(uuid.UUID) ([]models.Watchlist, error)) *MockMovieServiceInterface_GetWatchlist_Call {
+func (_c *MockMovieServiceInterface_GetWatchlist_Call) RunAndReturn(run func(uuid.UUID, service.WatchlistFilters) (*service.WatchlistPage, error)) *MockMovieServiceInterface_GetWatchlist_Call {
 	_c.Call.Return(run)
 	return _c
 }
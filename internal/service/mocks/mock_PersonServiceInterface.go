@@ -0,0 +1,209 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPersonServiceInterface is an autogenerated mock type for the PersonServiceInterface type
+type MockPersonServiceInterface struct {
+	mock.Mock
+}
+
+type MockPersonServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPersonServiceInterface) EXPECT() *MockPersonServiceInterface_Expecter {
+	return &MockPersonServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetCredits provides a mock function with given fields: id
+func (_m *MockPersonServiceInterface) GetCredits(id int) (*models.PersonCredits, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredits")
+	}
+
+	var r0 *models.PersonCredits
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.PersonCredits, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.PersonCredits); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PersonCredits)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPersonServiceInterface_GetCredits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredits'
+type MockPersonServiceInterface_GetCredits_Call struct {
+	*mock.Call
+}
+
+// GetCredits is a helper method to define mock.On call
+//   - id int
+func (_e *MockPersonServiceInterface_Expecter) GetCredits(id interface{}) *MockPersonServiceInterface_GetCredits_Call {
+	return &MockPersonServiceInterface_GetCredits_Call{Call: _e.mock.On("GetCredits", id)}
+}
+
+func (_c *MockPersonServiceInterface_GetCredits_Call) Run(run func(id int)) *MockPersonServiceInterface_GetCredits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockPersonServiceInterface_GetCredits_Call) Return(_a0 *models.PersonCredits, _a1 error) *MockPersonServiceInterface_GetCredits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPersonServiceInterface_GetCredits_Call) RunAndReturn(run func(int) (*models.PersonCredits, error)) *MockPersonServiceInterface_GetCredits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImages provides a mock function with given fields: id
+func (_m *MockPersonServiceInterface) GetImages(id int) ([]models.PersonImage, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImages")
+	}
+
+	var r0 []models.PersonImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.PersonImage, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.PersonImage); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PersonImage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPersonServiceInterface_GetImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImages'
+type MockPersonServiceInterface_GetImages_Call struct {
+	*mock.Call
+}
+
+// GetImages is a helper method to define mock.On call
+//   - id int
+func (_e *MockPersonServiceInterface_Expecter) GetImages(id interface{}) *MockPersonServiceInterface_GetImages_Call {
+	return &MockPersonServiceInterface_GetImages_Call{Call: _e.mock.On("GetImages", id)}
+}
+
+func (_c *MockPersonServiceInterface_GetImages_Call) Run(run func(id int)) *MockPersonServiceInterface_GetImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockPersonServiceInterface_GetImages_Call) Return(_a0 []models.PersonImage, _a1 error) *MockPersonServiceInterface_GetImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPersonServiceInterface_GetImages_Call) RunAndReturn(run func(int) ([]models.PersonImage, error)) *MockPersonServiceInterface_GetImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPerson provides a mock function with given fields: id
+func (_m *MockPersonServiceInterface) GetPerson(id int) (*models.Person, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPerson")
+	}
+
+	var r0 *models.Person
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Person, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Person); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Person)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPersonServiceInterface_GetPerson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPerson'
+type MockPersonServiceInterface_GetPerson_Call struct {
+	*mock.Call
+}
+
+// GetPerson is a helper method to define mock.On call
+//   - id int
+func (_e *MockPersonServiceInterface_Expecter) GetPerson(id interface{}) *MockPersonServiceInterface_GetPerson_Call {
+	return &MockPersonServiceInterface_GetPerson_Call{Call: _e.mock.On("GetPerson", id)}
+}
+
+func (_c *MockPersonServiceInterface_GetPerson_Call) Run(run func(id int)) *MockPersonServiceInterface_GetPerson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockPersonServiceInterface_GetPerson_Call) Return(_a0 *models.Person, _a1 error) *MockPersonServiceInterface_GetPerson_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPersonServiceInterface_GetPerson_Call) RunAndReturn(run func(int) (*models.Person, error)) *MockPersonServiceInterface_GetPerson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPersonServiceInterface creates a new instance of MockPersonServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPersonServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPersonServiceInterface {
+	mock := &MockPersonServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
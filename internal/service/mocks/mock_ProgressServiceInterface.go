@@ -0,0 +1,357 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	uuid "github.com/google/uuid"
+	service "github.com/milansax96/movie-terminal-api/internal/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProgressServiceInterface is an autogenerated mock type for the ProgressServiceInterface type
+type MockProgressServiceInterface struct {
+	mock.Mock
+}
+
+type MockProgressServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProgressServiceInterface) EXPECT() *MockProgressServiceInterface_Expecter {
+	return &MockProgressServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetProgress provides a mock function with given fields: userID, showID
+func (_m *MockProgressServiceInterface) GetProgress(userID uuid.UUID, showID int) (*service.ShowProgress, error) {
+	ret := _m.Called(userID, showID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgress")
+	}
+
+	var r0 *service.ShowProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) (*service.ShowProgress, error)); ok {
+		return rf(userID, showID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) *service.ShowProgress); ok {
+		r0 = rf(userID, showID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.ShowProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int) error); ok {
+		r1 = rf(userID, showID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressServiceInterface_GetProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProgress'
+type MockProgressServiceInterface_GetProgress_Call struct {
+	*mock.Call
+}
+
+// GetProgress is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - showID int
+func (_e *MockProgressServiceInterface_Expecter) GetProgress(userID interface{}, showID interface{}) *MockProgressServiceInterface_GetProgress_Call {
+	return &MockProgressServiceInterface_GetProgress_Call{Call: _e.mock.On("GetProgress", userID, showID)}
+}
+
+func (_c *MockProgressServiceInterface_GetProgress_Call) Run(run func(userID uuid.UUID, showID int)) *MockProgressServiceInterface_GetProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_GetProgress_Call) Return(_a0 *service.ShowProgress, _a1 error) *MockProgressServiceInterface_GetProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_GetProgress_Call) RunAndReturn(run func(uuid.UUID, int) (*service.ShowProgress, error)) *MockProgressServiceInterface_GetProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEpisode provides a mock function with given fields: userID, showID, seasonNumber, episodeNumber
+func (_m *MockProgressServiceInterface) MarkEpisode(userID uuid.UUID, showID int, seasonNumber int, episodeNumber int) error {
+	ret := _m.Called(userID, showID, seasonNumber, episodeNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEpisode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, int) error); ok {
+		r0 = rf(userID, showID, seasonNumber, episodeNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProgressServiceInterface_MarkEpisode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEpisode'
+type MockProgressServiceInterface_MarkEpisode_Call struct {
+	*mock.Call
+}
+
+// MarkEpisode is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - showID int
+//   - seasonNumber int
+//   - episodeNumber int
+func (_e *MockProgressServiceInterface_Expecter) MarkEpisode(userID interface{}, showID interface{}, seasonNumber interface{}, episodeNumber interface{}) *MockProgressServiceInterface_MarkEpisode_Call {
+	return &MockProgressServiceInterface_MarkEpisode_Call{Call: _e.mock.On("MarkEpisode", userID, showID, seasonNumber, episodeNumber)}
+}
+
+func (_c *MockProgressServiceInterface_MarkEpisode_Call) Run(run func(userID uuid.UUID, showID int, seasonNumber int, episodeNumber int)) *MockProgressServiceInterface_MarkEpisode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_MarkEpisode_Call) Return(_a0 error) *MockProgressServiceInterface_MarkEpisode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_MarkEpisode_Call) RunAndReturn(run func(uuid.UUID, int, int, int) error) *MockProgressServiceInterface_MarkEpisode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSeason provides a mock function with given fields: userID, showID, seasonNumber
+func (_m *MockProgressServiceInterface) MarkSeason(userID uuid.UUID, showID int, seasonNumber int) (int, error) {
+	ret := _m.Called(userID, showID, seasonNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSeason")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int) (int, error)); ok {
+		return rf(userID, showID, seasonNumber)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int) int); ok {
+		r0 = rf(userID, showID, seasonNumber)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int, int) error); ok {
+		r1 = rf(userID, showID, seasonNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressServiceInterface_MarkSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSeason'
+type MockProgressServiceInterface_MarkSeason_Call struct {
+	*mock.Call
+}
+
+// MarkSeason is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - showID int
+//   - seasonNumber int
+func (_e *MockProgressServiceInterface_Expecter) MarkSeason(userID interface{}, showID interface{}, seasonNumber interface{}) *MockProgressServiceInterface_MarkSeason_Call {
+	return &MockProgressServiceInterface_MarkSeason_Call{Call: _e.mock.On("MarkSeason", userID, showID, seasonNumber)}
+}
+
+func (_c *MockProgressServiceInterface_MarkSeason_Call) Run(run func(userID uuid.UUID, showID int, seasonNumber int)) *MockProgressServiceInterface_MarkSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_MarkSeason_Call) Return(_a0 int, _a1 error) *MockProgressServiceInterface_MarkSeason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_MarkSeason_Call) RunAndReturn(run func(uuid.UUID, int, int) (int, error)) *MockProgressServiceInterface_MarkSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnmarkEpisode provides a mock function with given fields: userID, showID, seasonNumber, episodeNumber
+func (_m *MockProgressServiceInterface) UnmarkEpisode(userID uuid.UUID, showID int, seasonNumber int, episodeNumber int) error {
+	ret := _m.Called(userID, showID, seasonNumber, episodeNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmarkEpisode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, int) error); ok {
+		r0 = rf(userID, showID, seasonNumber, episodeNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProgressServiceInterface_UnmarkEpisode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnmarkEpisode'
+type MockProgressServiceInterface_UnmarkEpisode_Call struct {
+	*mock.Call
+}
+
+// UnmarkEpisode is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - showID int
+//   - seasonNumber int
+//   - episodeNumber int
+func (_e *MockProgressServiceInterface_Expecter) UnmarkEpisode(userID interface{}, showID interface{}, seasonNumber interface{}, episodeNumber interface{}) *MockProgressServiceInterface_UnmarkEpisode_Call {
+	return &MockProgressServiceInterface_UnmarkEpisode_Call{Call: _e.mock.On("UnmarkEpisode", userID, showID, seasonNumber, episodeNumber)}
+}
+
+func (_c *MockProgressServiceInterface_UnmarkEpisode_Call) Run(run func(userID uuid.UUID, showID int, seasonNumber int, episodeNumber int)) *MockProgressServiceInterface_UnmarkEpisode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_UnmarkEpisode_Call) Return(_a0 error) *MockProgressServiceInterface_UnmarkEpisode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_UnmarkEpisode_Call) RunAndReturn(run func(uuid.UUID, int, int, int) error) *MockProgressServiceInterface_UnmarkEpisode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnmarkSeason provides a mock function with given fields: userID, showID, seasonNumber
+func (_m *MockProgressServiceInterface) UnmarkSeason(userID uuid.UUID, showID int, seasonNumber int) error {
+	ret := _m.Called(userID, showID, seasonNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmarkSeason")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int) error); ok {
+		r0 = rf(userID, showID, seasonNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProgressServiceInterface_UnmarkSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnmarkSeason'
+type MockProgressServiceInterface_UnmarkSeason_Call struct {
+	*mock.Call
+}
+
+// UnmarkSeason is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - showID int
+//   - seasonNumber int
+func (_e *MockProgressServiceInterface_Expecter) UnmarkSeason(userID interface{}, showID interface{}, seasonNumber interface{}) *MockProgressServiceInterface_UnmarkSeason_Call {
+	return &MockProgressServiceInterface_UnmarkSeason_Call{Call: _e.mock.On("UnmarkSeason", userID, showID, seasonNumber)}
+}
+
+func (_c *MockProgressServiceInterface_UnmarkSeason_Call) Run(run func(userID uuid.UUID, showID int, seasonNumber int)) *MockProgressServiceInterface_UnmarkSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_UnmarkSeason_Call) Return(_a0 error) *MockProgressServiceInterface_UnmarkSeason_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_UnmarkSeason_Call) RunAndReturn(run func(uuid.UUID, int, int) error) *MockProgressServiceInterface_UnmarkSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpNext provides a mock function with given fields: userID
+func (_m *MockProgressServiceInterface) UpNext(userID uuid.UUID) ([]service.UpNextEntry, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpNext")
+	}
+
+	var r0 []service.UpNextEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]service.UpNextEntry, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []service.UpNextEntry); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.UpNextEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressServiceInterface_UpNext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpNext'
+type MockProgressServiceInterface_UpNext_Call struct {
+	*mock.Call
+}
+
+// UpNext is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockProgressServiceInterface_Expecter) UpNext(userID interface{}) *MockProgressServiceInterface_UpNext_Call {
+	return &MockProgressServiceInterface_UpNext_Call{Call: _e.mock.On("UpNext", userID)}
+}
+
+func (_c *MockProgressServiceInterface_UpNext_Call) Run(run func(userID uuid.UUID)) *MockProgressServiceInterface_UpNext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_UpNext_Call) Return(_a0 []service.UpNextEntry, _a1 error) *MockProgressServiceInterface_UpNext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressServiceInterface_UpNext_Call) RunAndReturn(run func(uuid.UUID) ([]service.UpNextEntry, error)) *MockProgressServiceInterface_UpNext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProgressServiceInterface creates a new instance of MockProgressServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProgressServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProgressServiceInterface {
+	mock := &MockProgressServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	uuid "github.com/google/uuid"
+	service "github.com/milansax96/movie-terminal-api/internal/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRecommendationServiceInterface is an autogenerated mock type for the RecommendationServiceInterface type
+type MockRecommendationServiceInterface struct {
+	mock.Mock
+}
+
+type MockRecommendationServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecommendationServiceInterface) EXPECT() *MockRecommendationServiceInterface_Expecter {
+	return &MockRecommendationServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// Recommend provides a mock function with given fields: userID
+func (_m *MockRecommendationServiceInterface) Recommend(userID uuid.UUID) ([]service.Recommendation, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recommend")
+	}
+
+	var r0 []service.Recommendation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]service.Recommendation, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []service.Recommendation); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.Recommendation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRecommendationServiceInterface_Recommend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recommend'
+type MockRecommendationServiceInterface_Recommend_Call struct {
+	*mock.Call
+}
+
+// Recommend is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockRecommendationServiceInterface_Expecter) Recommend(userID interface{}) *MockRecommendationServiceInterface_Recommend_Call {
+	return &MockRecommendationServiceInterface_Recommend_Call{Call: _e.mock.On("Recommend", userID)}
+}
+
+func (_c *MockRecommendationServiceInterface_Recommend_Call) Run(run func(userID uuid.UUID)) *MockRecommendationServiceInterface_Recommend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRecommendationServiceInterface_Recommend_Call) Return(_a0 []service.Recommendation, _a1 error) *MockRecommendationServiceInterface_Recommend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRecommendationServiceInterface_Recommend_Call) RunAndReturn(run func(uuid.UUID) ([]service.Recommendation, error)) *MockRecommendationServiceInterface_Recommend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRecommendationServiceInterface creates a new instance of MockRecommendationServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecommendationServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecommendationServiceInterface {
+	mock := &MockRecommendationServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,215 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockTVServiceInterface is an autogenerated mock type for the TVServiceInterface type
+type MockTVServiceInterface struct {
+	mock.Mock
+}
+
+type MockTVServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTVServiceInterface) EXPECT() *MockTVServiceInterface_Expecter {
+	return &MockTVServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetEpisode provides a mock function with given fields: id, seasonNumber, episodeNumber
+func (_m *MockTVServiceInterface) GetEpisode(id int, seasonNumber int, episodeNumber int) (*models.Episode, error) {
+	ret := _m.Called(id, seasonNumber, episodeNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEpisode")
+	}
+
+	var r0 *models.Episode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, int) (*models.Episode, error)); ok {
+		return rf(id, seasonNumber, episodeNumber)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, int) *models.Episode); ok {
+		r0 = rf(id, seasonNumber, episodeNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Episode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = rf(id, seasonNumber, episodeNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTVServiceInterface_GetEpisode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEpisode'
+type MockTVServiceInterface_GetEpisode_Call struct {
+	*mock.Call
+}
+
+// GetEpisode is a helper method to define mock.On call
+//   - id int
+//   - seasonNumber int
+//   - episodeNumber int
+func (_e *MockTVServiceInterface_Expecter) GetEpisode(id interface{}, seasonNumber interface{}, episodeNumber interface{}) *MockTVServiceInterface_GetEpisode_Call {
+	return &MockTVServiceInterface_GetEpisode_Call{Call: _e.mock.On("GetEpisode", id, seasonNumber, episodeNumber)}
+}
+
+func (_c *MockTVServiceInterface_GetEpisode_Call) Run(run func(id int, seasonNumber int, episodeNumber int)) *MockTVServiceInterface_GetEpisode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockTVServiceInterface_GetEpisode_Call) Return(_a0 *models.Episode, _a1 error) *MockTVServiceInterface_GetEpisode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTVServiceInterface_GetEpisode_Call) RunAndReturn(run func(int, int, int) (*models.Episode, error)) *MockTVServiceInterface_GetEpisode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeason provides a mock function with given fields: id, seasonNumber
+func (_m *MockTVServiceInterface) GetSeason(id int, seasonNumber int) (*models.Season, error) {
+	ret := _m.Called(id, seasonNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeason")
+	}
+
+	var r0 *models.Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) (*models.Season, error)); ok {
+		return rf(id, seasonNumber)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) *models.Season); ok {
+		r0 = rf(id, seasonNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Season)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(id, seasonNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTVServiceInterface_GetSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeason'
+type MockTVServiceInterface_GetSeason_Call struct {
+	*mock.Call
+}
+
+// GetSeason is a helper method to define mock.On call
+//   - id int
+//   - seasonNumber int
+func (_e *MockTVServiceInterface_Expecter) GetSeason(id interface{}, seasonNumber interface{}) *MockTVServiceInterface_GetSeason_Call {
+	return &MockTVServiceInterface_GetSeason_Call{Call: _e.mock.On("GetSeason", id, seasonNumber)}
+}
+
+func (_c *MockTVServiceInterface_GetSeason_Call) Run(run func(id int, seasonNumber int)) *MockTVServiceInterface_GetSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockTVServiceInterface_GetSeason_Call) Return(_a0 *models.Season, _a1 error) *MockTVServiceInterface_GetSeason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTVServiceInterface_GetSeason_Call) RunAndReturn(run func(int, int) (*models.Season, error)) *MockTVServiceInterface_GetSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShow provides a mock function with given fields: userID, id
+func (_m *MockTVServiceInterface) GetShow(userID uuid.UUID, id int) (*models.TVShow, error) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShow")
+	}
+
+	var r0 *models.TVShow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) (*models.TVShow, error)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) *models.TVShow); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TVShow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int) error); ok {
+		r1 = rf(userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTVServiceInterface_GetShow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShow'
+type MockTVServiceInterface_GetShow_Call struct {
+	*mock.Call
+}
+
+// GetShow is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - id int
+func (_e *MockTVServiceInterface_Expecter) GetShow(userID interface{}, id interface{}) *MockTVServiceInterface_GetShow_Call {
+	return &MockTVServiceInterface_GetShow_Call{Call: _e.mock.On("GetShow", userID, id)}
+}
+
+func (_c *MockTVServiceInterface_GetShow_Call) Run(run func(userID uuid.UUID, id int)) *MockTVServiceInterface_GetShow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockTVServiceInterface_GetShow_Call) Return(_a0 *models.TVShow, _a1 error) *MockTVServiceInterface_GetShow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTVServiceInterface_GetShow_Call) RunAndReturn(run func(uuid.UUID, int) (*models.TVShow, error)) *MockTVServiceInterface_GetShow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTVServiceInterface creates a new instance of MockTVServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTVServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTVServiceInterface {
+	mock := &MockTVServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,263 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	middleware "github.com/milansax96/movie-terminal-api/internal/middleware"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/milansax96/movie-terminal-api/internal/models"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockTokenServiceInterface is an autogenerated mock type for the TokenServiceInterface type
+type MockTokenServiceInterface struct {
+	mock.Mock
+}
+
+type MockTokenServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenServiceInterface) EXPECT() *MockTokenServiceInterface_Expecter {
+	return &MockTokenServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateToken provides a mock function with given fields: userID, name, scopes, expiresInDays
+func (_m *MockTokenServiceInterface) CreateToken(userID uuid.UUID, name string, scopes []string, expiresInDays int) (*models.PersonalAccessToken, error) {
+	ret := _m.Called(userID, name, scopes, expiresInDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateToken")
+	}
+
+	var r0 *models.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, []string, int) (*models.PersonalAccessToken, error)); ok {
+		return rf(userID, name, scopes, expiresInDays)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, []string, int) *models.PersonalAccessToken); ok {
+		r0 = rf(userID, name, scopes, expiresInDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, []string, int) error); ok {
+		r1 = rf(userID, name, scopes, expiresInDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenServiceInterface_CreateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateToken'
+type MockTokenServiceInterface_CreateToken_Call struct {
+	*mock.Call
+}
+
+// CreateToken is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - name string
+//   - scopes []string
+//   - expiresInDays int
+func (_e *MockTokenServiceInterface_Expecter) CreateToken(userID interface{}, name interface{}, scopes interface{}, expiresInDays interface{}) *MockTokenServiceInterface_CreateToken_Call {
+	return &MockTokenServiceInterface_CreateToken_Call{Call: _e.mock.On("CreateToken", userID, name, scopes, expiresInDays)}
+}
+
+func (_c *MockTokenServiceInterface_CreateToken_Call) Run(run func(userID uuid.UUID, name string, scopes []string, expiresInDays int)) *MockTokenServiceInterface_CreateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string), args[2].([]string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_CreateToken_Call) Return(_a0 *models.PersonalAccessToken, _a1 error) *MockTokenServiceInterface_CreateToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_CreateToken_Call) RunAndReturn(run func(uuid.UUID, string, []string, int) (*models.PersonalAccessToken, error)) *MockTokenServiceInterface_CreateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTokens provides a mock function with given fields: userID
+func (_m *MockTokenServiceInterface) ListTokens(userID uuid.UUID) ([]models.PersonalAccessToken, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTokens")
+	}
+
+	var r0 []models.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]models.PersonalAccessToken, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []models.PersonalAccessToken); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenServiceInterface_ListTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTokens'
+type MockTokenServiceInterface_ListTokens_Call struct {
+	*mock.Call
+}
+
+// ListTokens is a helper method to define mock.On call
+//   - userID uuid.UUID
+func (_e *MockTokenServiceInterface_Expecter) ListTokens(userID interface{}) *MockTokenServiceInterface_ListTokens_Call {
+	return &MockTokenServiceInterface_ListTokens_Call{Call: _e.mock.On("ListTokens", userID)}
+}
+
+func (_c *MockTokenServiceInterface_ListTokens_Call) Run(run func(userID uuid.UUID)) *MockTokenServiceInterface_ListTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_ListTokens_Call) Return(_a0 []models.PersonalAccessToken, _a1 error) *MockTokenServiceInterface_ListTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_ListTokens_Call) RunAndReturn(run func(uuid.UUID) ([]models.PersonalAccessToken, error)) *MockTokenServiceInterface_ListTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function with given fields: userID, tokenID
+func (_m *MockTokenServiceInterface) RevokeToken(userID uuid.UUID, tokenID uuid.UUID) error {
+	ret := _m.Called(userID, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(userID, tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenServiceInterface_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type MockTokenServiceInterface_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - tokenID uuid.UUID
+func (_e *MockTokenServiceInterface_Expecter) RevokeToken(userID interface{}, tokenID interface{}) *MockTokenServiceInterface_RevokeToken_Call {
+	return &MockTokenServiceInterface_RevokeToken_Call{Call: _e.mock.On("RevokeToken", userID, tokenID)}
+}
+
+func (_c *MockTokenServiceInterface_RevokeToken_Call) Run(run func(userID uuid.UUID, tokenID uuid.UUID)) *MockTokenServiceInterface_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_RevokeToken_Call) Return(_a0 error) *MockTokenServiceInterface_RevokeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_RevokeToken_Call) RunAndReturn(run func(uuid.UUID, uuid.UUID) error) *MockTokenServiceInterface_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidatePersonalToken provides a mock function with given fields: raw
+func (_m *MockTokenServiceInterface) ValidatePersonalToken(raw string) (*middleware.TokenPrincipal, error) {
+	ret := _m.Called(raw)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidatePersonalToken")
+	}
+
+	var r0 *middleware.TokenPrincipal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*middleware.TokenPrincipal, error)); ok {
+		return rf(raw)
+	}
+	if rf, ok := ret.Get(0).(func(string) *middleware.TokenPrincipal); ok {
+		r0 = rf(raw)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*middleware.TokenPrincipal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(raw)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenServiceInterface_ValidatePersonalToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidatePersonalToken'
+type MockTokenServiceInterface_ValidatePersonalToken_Call struct {
+	*mock.Call
+}
+
+// ValidatePersonalToken is a helper method to define mock.On call
+//   - raw string
+func (_e *MockTokenServiceInterface_Expecter) ValidatePersonalToken(raw interface{}) *MockTokenServiceInterface_ValidatePersonalToken_Call {
+	return &MockTokenServiceInterface_ValidatePersonalToken_Call{Call: _e.mock.On("ValidatePersonalToken", raw)}
+}
+
+func (_c *MockTokenServiceInterface_ValidatePersonalToken_Call) Run(run func(raw string)) *MockTokenServiceInterface_ValidatePersonalToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_ValidatePersonalToken_Call) Return(_a0 *middleware.TokenPrincipal, _a1 error) *MockTokenServiceInterface_ValidatePersonalToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenServiceInterface_ValidatePersonalToken_Call) RunAndReturn(run func(string) (*middleware.TokenPrincipal, error)) *MockTokenServiceInterface_ValidatePersonalToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenServiceInterface creates a new instance of MockTokenServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenServiceInterface {
+	mock := &MockTokenServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	models "github.com/milansax96/movie-terminal-api/internal/models"
+	service "github.com/milansax96/movie-terminal-api/internal/service"
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -80,6 +81,65 @@ func (_c *MockUserServiceInterface_GetProfile_Call) RunAndReturn(run func(uuid.U
 	return _c
 }
 
+// UpdateProfile provides a mock function with given fields: userID, update
+func (_m *MockUserServiceInterface) UpdateProfile(userID uuid.UUID, update service.ProfileUpdate) (*models.User, error) {
+	ret := _m.Called(userID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.ProfileUpdate) (*models.User, error)); ok {
+		return rf(userID, update)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, service.ProfileUpdate) *models.User); ok {
+		r0 = rf(userID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, service.ProfileUpdate) error); ok {
+		r1 = rf(userID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserServiceInterface_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockUserServiceInterface_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - update service.ProfileUpdate
+func (_e *MockUserServiceInterface_Expecter) UpdateProfile(userID interface{}, update interface{}) *MockUserServiceInterface_UpdateProfile_Call {
+	return &MockUserServiceInterface_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", userID, update)}
+}
+
+func (_c *MockUserServiceInterface_UpdateProfile_Call) Run(run func(userID uuid.UUID, update service.ProfileUpdate)) *MockUserServiceInterface_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(service.ProfileUpdate))
+	})
+	return _c
+}
+
+func (_c *MockUserServiceInterface_UpdateProfile_Call) Return(_a0 *models.User, _a1 error) *MockUserServiceInterface_UpdateProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserServiceInterface_UpdateProfile_Call) RunAndReturn(run func(uuid.UUID, service.ProfileUpdate) (*models.User, error)) *MockUserServiceInterface_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStreamingServices provides a mock function with given fields: userID, serviceIDs
 func (_m *MockUserServiceInterface) UpdateStreamingServices(userID uuid.UUID, serviceIDs []int) error {
 	ret := _m.Called(userID, serviceIDs)
@@ -1,48 +1,63 @@
 package service
 
 import (
-	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 
 	"github.com/milansax96/movie-terminal-api/internal/models"
 	"github.com/milansax96/movie-terminal-api/internal/repository"
 	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
 )
 
-var genreMap = map[string]int{
-	"action":    28,
-	"comedy":    35,
-	"horror":    27,
-	"romance":   10749,
-	"mystery":   9648,
-	"sci_fi":    878,
-	"western":   37,
-	"animation": 16,
-	"tv_movie":  10770,
-}
+// defaultWatchRegion is the region used for provider-based discovery when
+// the caller does not name one.
+const defaultWatchRegion = "US"
+
+// watchableMonetization are the offer types that need no extra payment on
+// top of a subscription.
+var watchableMonetization = []string{tmdb.MonetizationFlatrate, tmdb.MonetizationFree, tmdb.MonetizationAds}
 
 // MovieService handles movie discovery, search, and watchlist operations.
 type MovieService struct {
 	tmdb                tmdb.API
 	watchlistRepo       repository.WatchlistRepository
+	userRepo            repository.UserRepository
 	cloudinaryCloudName string
+	availabilityRegions []string
 }
 
 // NewMovieService creates and returns a new MovieService instance.
-func NewMovieService(tmdbClient tmdb.API, watchlistRepo repository.WatchlistRepository, cloudinaryCloudName string) *MovieService {
+// availabilityRegions are the regions the availability job tracks, which
+// the watchlist's services filter is limited to.
+func NewMovieService(tmdbClient tmdb.API, watchlistRepo repository.WatchlistRepository, userRepo repository.UserRepository, cloudinaryCloudName string, availabilityRegions []string) *MovieService {
 
 	return &MovieService{
 		tmdb:                tmdbClient,
 		watchlistRepo:       watchlistRepo,
+		userRepo:            userRepo,
 		cloudinaryCloudName: cloudinaryCloudName,
+		availabilityRegions: availabilityRegions,
 	}
 }
 
 // Discover handles categorized movie fetching and enriches results with user watchlist status.
 func (s *MovieService) Discover(userID uuid.UUID, genre string, page int) ([]models.Movie, error) {
+	movies, err := s.discover(genre, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.enrichWithWatchlist(userID, movies)
+}
+
+// discover fetches a movie category or genre without watchlist status.
+func (s *MovieService) discover(genre string, page int) ([]models.Movie, error) {
 	var movies []models.Movie
 	var err error
 
@@ -58,19 +73,80 @@ func (s *MovieService) Discover(userID uuid.UUID, genre string, page int) ([]mod
 	case "upcoming":
 		movies, err = s.tmdb.GetUpcoming(page)
 	default:
-		genreID, ok := genreMap[genre]
+		genreID, ok := s.resolveGenre("movie", genre)
 		if !ok {
 			return nil, ErrUnknownGenre
 		}
 		movies, err = s.tmdb.DiscoverByGenre(genreID, page)
 	}
 
+	return movies, err
+}
+
+// DiscoverTV returns TV shows for a category (trending, popular, on_the_air,
+// airing_today or top_rated) or a TV genre slug.
+func (s *MovieService) DiscoverTV(userID uuid.UUID, category string, page int) ([]models.Movie, error) {
+	var shows []models.Movie
+	var err error
+
+	switch category {
+	case "trending":
+		shows, err = s.tmdb.GetTrending("tv", "week")
+	case tmdb.TVPopular, tmdb.TVOnTheAir, tmdb.TVAiringToday, tmdb.TVTopRated:
+		shows, err = s.tmdb.GetTVList(category, page)
+	default:
+		genreID, ok := s.resolveGenre("tv", category)
+		if !ok {
+			return nil, ErrUnknownGenre
+		}
+		shows, err = s.tmdb.Discover("tv", tmdb.DiscoverParams{Genres: []int{genreID}, Page: page})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.enrichWithWatchlist(userID, shows)
+}
+
+// DiscoverOnMyServices returns movies or TV shows that can be streamed in
+// region on one of the user's streaming services. It returns
+// ErrNoStreamingServices when none of them are offered there.
+func (s *MovieService) DiscoverOnMyServices(userID uuid.UUID, mediaType string, region string, page int) ([]models.Movie, error) {
+	verr := &ValidationError{}
+	if mediaType != "movie" && mediaType != "tv" {
+		verr.add("media_type", "must be movie or tv")
+	}
+
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if region == "" {
+		region = defaultWatchRegion
+	} else if !regionPattern.MatchString(region) {
+		verr.add("region", "must be an ISO 3166-1 alpha-2 country code")
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	providerIDs, err := s.userProviderIDs(userID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	movies, err := s.tmdb.Discover(mediaType, tmdb.DiscoverParams{
+		WatchProviders:    providerIDs,
+		WatchRegion:       region,
+		MonetizationTypes: watchableMonetization,
+		Page:              page,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return s.enrichWithWatchlist(userID, movies)
 }
+
 func (s *MovieService) DiscoverAll(userID uuid.UUID) ([]models.Movie, error) {
 	categories := []string{"trending", "now_playing", "upcoming"}
 	uniqueMovies := make(map[int]models.Movie)
@@ -82,10 +158,13 @@ func (s *MovieService) DiscoverAll(userID uuid.UUID) ([]models.Movie, error) {
 		go func(category string) {
 			defer wg.Done()
 
-			movies, err := s.Discover(userID, category, 1)
+			// Watchlist status is checked once for all categories below.
+			movies, err := s.discover(category, 1)
 			if err != nil {
 				return
 			}
+			// Trailer keys go on a copy: the results may be cached and shared.
+			movies = slices.Clone(movies)
 
 			var videoWg sync.WaitGroup
 			for i := range movies {
@@ -141,21 +220,22 @@ func (s *MovieService) enrichAllWithWatchlist(userID uuid.UUID, feed map[string]
 		return feed, nil
 	}
 
-	watchlist, err := s.watchlistRepo.GetByUserID(userID)
-	if err != nil {
-		return feed, nil
+	var ids []int
+	for cat := range feed {
+		for _, movie := range feed[cat] {
+			ids = append(ids, movie.ID)
+		}
 	}
+	slices.Sort(ids)
 
-	saved := make(map[int]struct{})
-	for _, item := range watchlist {
-		saved[item.TMDBId] = struct{}{}
+	saved, err := s.watchlistRepo.SavedAmong(userID, slices.Compact(ids))
+	if err != nil {
+		return feed, nil
 	}
 
 	for cat := range feed {
 		for i := range feed[cat] {
-			if _, exists := saved[feed[cat][i].ID]; exists {
-				feed[cat][i].IsWatchlisted = true
-			}
+			feed[cat][i].IsWatchlisted = saved[feed[cat][i].ID]
 		}
 	}
 
@@ -187,14 +267,54 @@ func (s *MovieService) GetCredits(mediaType string, id int) (*tmdb.CreditsRespon
 	return s.tmdb.GetCredits(mediaType, id)
 }
 
-// GetProviders returns streaming providers for a title.
-func (s *MovieService) GetProviders(mediaType string, id int) (json.RawMessage, error) {
-	return s.tmdb.GetProviders(mediaType, id)
+// GetSimilar returns titles similar to a movie or TV show, flagging those
+// the user has saved.
+func (s *MovieService) GetSimilar(userID uuid.UUID, mediaType string, id int, page int) ([]models.Movie, error) {
+	movies, err := s.tmdb.GetSimilar(mediaType, id, page)
+	if err != nil {
+		return nil, tmdbError(err)
+	}
+
+	return s.enrichWithWatchlist(userID, movies)
 }
 
-// GetWatchlist returns all watchlist items for a user.
-func (s *MovieService) GetWatchlist(userID uuid.UUID) ([]models.Watchlist, error) {
-	return s.watchlistRepo.GetByUserID(userID)
+// GetRecommendations returns TMDB's recommendations for viewers of a movie or
+// TV show, flagging those the user has saved.
+func (s *MovieService) GetRecommendations(userID uuid.UUID, mediaType string, id int, page int) ([]models.Movie, error) {
+	movies, err := s.tmdb.GetRecommendations(mediaType, id, page)
+	if err != nil {
+		return nil, tmdbError(err)
+	}
+
+	return s.enrichWithWatchlist(userID, movies)
+}
+
+// GetProviders returns where a title can be watched, limited to one region
+// when region is set, with the user's own streaming services flagged.
+func (s *MovieService) GetProviders(userID uuid.UUID, mediaType string, id int, region string) (*models.WatchProviders, error) {
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if region != "" && !regionPattern.MatchString(region) {
+		verr := &ValidationError{}
+		verr.add("region", "must be an ISO 3166-1 alpha-2 country code")
+
+		return nil, verr
+	}
+
+	res, err := s.tmdb.GetProviders(mediaType, id)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := res.ToDomain(mediaType)
+	if region != "" {
+		filtered := make(map[string]models.RegionWatchProviders, 1)
+		if r, ok := providers.Regions[region]; ok {
+			filtered[region] = r
+		}
+		providers.Regions = filtered
+	}
+
+	return s.enrichWithServices(userID, &providers), nil
 }
 
 // CheckWatchlist checks if a movie is in the user's watchlist.
@@ -204,8 +324,6 @@ func (s *MovieService) CheckWatchlist(userID uuid.UUID, movieID int) (bool, erro
 
 // AddToWatchlist converts a request into a Database Watchlist model.
 func (s *MovieService) AddToWatchlist(userID uuid.UUID, req models.Movie) (*models.Watchlist, error) {
-	println("Request trailer", req.TrailerKey) // Debug log to verify trailer key presence
-
 	item := &models.Watchlist{
 		UserID:       userID,
 		TMDBId:       req.ID,
@@ -214,7 +332,17 @@ func (s *MovieService) AddToWatchlist(userID uuid.UUID, req models.Movie) (*mode
 		BackdropPath: req.BackdropPath,
 		MediaType:    req.MediaType,
 		TrailerKey:   req.TrailerKey,
+		ReleaseDate:  req.ReleaseDate,
+		VoteAverage:  req.VoteAverage,
 		AddedAt:      time.Now(),
+		// Items saved without details are filled in by WatchlistDetailsService.
+		DetailsSynced: req.ReleaseDate != "" || req.VoteAverage != 0 || len(req.GenreIDs) > 0,
+	}
+
+	genreIDs := slices.Clone(req.GenreIDs)
+	slices.Sort(genreIDs)
+	for _, genreID := range slices.Compact(genreIDs) {
+		item.Genres = append(item.Genres, models.WatchlistGenre{GenreID: genreID})
 	}
 
 	if err := s.watchlistRepo.Add(item); err != nil {
@@ -257,26 +385,83 @@ func (s *MovieService) RemoveFromWatchlist(userID uuid.UUID, movieID int) error
 
 // enrichWithWatchlist marks movies as 'IsWatchlisted' based on the user's data.
 func (s *MovieService) enrichWithWatchlist(userID uuid.UUID, movies []models.Movie) ([]models.Movie, error) {
-	if userID == uuid.Nil {
+	if userID == uuid.Nil || len(movies) == 0 {
 		return movies, nil
 	}
 
-	watchlist, err := s.watchlistRepo.GetByUserID(userID)
+	ids := make([]int, len(movies))
+	for i, movie := range movies {
+		ids[i] = movie.ID
+	}
+
+	saved, err := s.watchlistRepo.SavedAmong(userID, ids)
 	if err != nil {
 		return movies, nil // Fail silently on enrichment; better to show movies without icons than error.
 	}
 
-	// Create map for O(1) lookup
-	saved := make(map[int]struct{})
-	for _, item := range watchlist {
-		saved[item.TMDBId] = struct{}{}
+	// Flag a copy: the results may be cached and shared with other users.
+	enriched := slices.Clone(movies)
+	for i := range enriched {
+		enriched[i].IsWatchlisted = saved[enriched[i].ID]
+	}
+
+	return enriched, nil
+}
+
+// enrichWithServices flags providers that are among the user's streaming
+// services in each region.
+func (s *MovieService) enrichWithServices(userID uuid.UUID, providers *models.WatchProviders) *models.WatchProviders {
+	user, err := s.userRepo.FindByIDWithStreaming(userID)
+	if err != nil || len(user.StreamingServices) == 0 {
+		return providers // Fail silently on enrichment, as with the watchlist.
+	}
+
+	for code, region := range providers.Regions {
+		subscribed := make(map[int]struct{})
+		for _, id := range subscribedProviderIDs(user.StreamingServices, code) {
+			subscribed[id] = struct{}{}
+		}
+
+		for _, offers := range region.Offers() {
+			for i := range offers {
+				if _, ok := subscribed[offers[i].ProviderID]; ok {
+					offers[i].OnMyServices = true
+				}
+			}
+		}
+	}
+
+	return providers
+}
+
+// userProviderIDs returns the TMDB provider IDs of the user's streaming
+// services offered in region, or ErrNoStreamingServices if there are none.
+func (s *MovieService) userProviderIDs(userID uuid.UUID, region string) ([]int, error) {
+	user, err := s.userRepo.FindByIDWithStreaming(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	providerIDs := subscribedProviderIDs(user.StreamingServices, region)
+	if len(providerIDs) == 0 {
+		return nil, ErrNoStreamingServices
 	}
 
-	for i := range movies {
-		if _, exists := saved[movies[i].ID]; exists {
-			movies[i].IsWatchlisted = true
+	return providerIDs, nil
+}
+
+// subscribedProviderIDs returns the sorted TMDB provider IDs of the services
+// offered in region. Services not mapped to a TMDB provider are skipped.
+func subscribedProviderIDs(services []models.StreamingService, region string) []int {
+	ids := make([]int, 0, len(services))
+	for _, svc := range services {
+		if svc.TMDBProviderID != nil && svc.OffersRegion(region) {
+			ids = append(ids, *svc.TMDBProviderID)
 		}
 	}
+	slices.Sort(ids)
 
-	return movies, nil
+	return slices.Compact(ids)
 }
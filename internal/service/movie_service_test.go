@@ -34,7 +34,7 @@ func TestDiscover(t *testing.T) {
 						},
 					},
 				)
-				env.Watchlist.ReturnsWatchlist(userID, nil)
+				env.Watchlist.SavesAmong(userID, []int{1})
 			},
 			[]models.Movie{
 				{ID: 1, Title: "Trending Movie"},
@@ -43,17 +43,31 @@ func TestDiscover(t *testing.T) {
 		},
 		"top rated": {"top_rated", 1, func(env *TestEnv, userID uuid.UUID) {
 			env.TMDB.ReturnsTopRated(1, []models.Movie{{ID: 2, Title: "Top Rated"}})
-			env.Watchlist.ReturnsWatchlist(userID, nil)
+			env.Watchlist.SavesAmong(userID, []int{2})
 		}, []models.Movie{{ID: 2, Title: "Top Rated"}}, nil},
 		"action genre": {"action", 1, func(env *TestEnv, userID uuid.UUID) {
+			env.TMDB.ReturnsGenreList("movie", movieGenres)
 			env.TMDB.ReturnsGenre(28, 1, []models.Movie{{ID: 3, Title: "Action Movie"}})
-			env.Watchlist.ReturnsWatchlist(userID, nil)
+			env.Watchlist.SavesAmong(userID, []int{3})
 		}, []models.Movie{{ID: 3, Title: "Action Movie"}}, nil},
 		"comedy genre page 2": {"comedy", 2, func(env *TestEnv, userID uuid.UUID) {
+			env.TMDB.ReturnsGenreList("movie", movieGenres)
 			env.TMDB.ReturnsGenre(35, 2, []models.Movie{{ID: 4, Title: "Comedy Movie"}})
-			env.Watchlist.ReturnsWatchlist(userID, nil)
+			env.Watchlist.SavesAmong(userID, []int{4})
 		}, []models.Movie{{ID: 4, Title: "Comedy Movie"}}, nil},
-		"unknown genre": {"nonexistent", 1, func(_ *TestEnv, _ uuid.UUID) {}, nil, ErrUnknownGenre},
+		"drama from catalog": {"drama", 1, func(env *TestEnv, userID uuid.UUID) {
+			env.TMDB.ReturnsGenreList("movie", movieGenres)
+			env.TMDB.ReturnsGenre(18, 1, []models.Movie{{ID: 5, Title: "Drama Movie"}})
+			env.Watchlist.SavesAmong(userID, []int{5})
+		}, []models.Movie{{ID: 5, Title: "Drama Movie"}}, nil},
+		"alias without catalog": {"sci_fi", 1, func(env *TestEnv, userID uuid.UUID) {
+			env.TMDB.GenreListFails("movie", errors.New("tmdb down"))
+			env.TMDB.ReturnsGenre(878, 1, []models.Movie{{ID: 6, Title: "Sci-Fi Movie"}})
+			env.Watchlist.SavesAmong(userID, []int{6})
+		}, []models.Movie{{ID: 6, Title: "Sci-Fi Movie"}}, nil},
+		"unknown genre": {"nonexistent", 1, func(env *TestEnv, _ uuid.UUID) {
+			env.TMDB.ReturnsGenreList("movie", movieGenres)
+		}, nil, ErrUnknownGenre},
 		"tmdb error": {"trending", 1, func(env *TestEnv, _ uuid.UUID) {
 			env.TMDB.TrendingFails(errors.New("network error"))
 		}, nil, errors.New("network error")},
@@ -91,15 +105,15 @@ func TestDiscover_NewCategories(t *testing.T) {
 	}{
 		"now_playing": {"now_playing", func(env *TestEnv, userID uuid.UUID) {
 			env.TMDB.ReturnsNowPlaying(1, []models.Movie{{ID: 10, Title: "Now Playing"}})
-			env.Watchlist.ReturnsWatchlist(userID, nil)
+			env.Watchlist.SavesAmong(userID, []int{10})
 		}, "Now Playing"},
 		"popular": {"popular", func(env *TestEnv, userID uuid.UUID) {
 			env.TMDB.ReturnsPopular(1, []models.Movie{{ID: 11, Title: "Popular"}})
-			env.Watchlist.ReturnsWatchlist(userID, nil)
+			env.Watchlist.SavesAmong(userID, []int{11})
 		}, "Popular"},
 		"upcoming": {"upcoming", func(env *TestEnv, userID uuid.UUID) {
 			env.TMDB.ReturnsUpcoming(1, []models.Movie{{ID: 12, Title: "Upcoming"}})
-			env.Watchlist.ReturnsWatchlist(userID, nil)
+			env.Watchlist.SavesAmong(userID, []int{12})
 		}, "Upcoming"},
 	}
 
@@ -117,11 +131,11 @@ func TestDiscover_NewCategories(t *testing.T) {
 	}
 }
 
-func TestDiscover_GenreMapContainsExpectedKeys(t *testing.T) {
+func TestDiscover_GenreAliasesContainExpectedKeys(t *testing.T) {
 	expected := []string{"action", "comedy", "horror", "romance", "mystery", "sci_fi", "western", "animation", "tv_movie"}
 	for _, key := range expected {
-		_, ok := genreMap[key]
-		assert.True(t, ok, "genreMap missing key: %s", key)
+		_, ok := movieGenreAliases[key]
+		assert.True(t, ok, "movieGenreAliases missing key: %s", key)
 	}
 }
 
@@ -140,7 +154,7 @@ func TestDiscoverAll(t *testing.T) {
 		env.TMDB.ReturnsVideos("movie", 1, trailer)
 		env.TMDB.ReturnsVideos("movie", 2, trailer)
 		env.TMDB.ReturnsVideos("movie", 3, trailer)
-		env.Watchlist.ReturnsWatchlist(userID, nil)
+		env.Watchlist.SavesAmong(userID, []int{1, 2, 3})
 
 		movies, err := env.MovieService().DiscoverAll(userID)
 		require.NoError(t, err)
@@ -180,7 +194,7 @@ func TestDiscoverAll(t *testing.T) {
 		env.TMDB.ReturnsVideos("movie", 1, trailer)
 		env.TMDB.ReturnsVideos("movie", 2, trailer)
 		env.TMDB.ReturnsVideos("movie", 3, trailer)
-		env.Watchlist.ReturnsWatchlist(userID, []models.Watchlist{{TMDBId: 1}})
+		env.Watchlist.SavesAmong(userID, []int{1, 2, 3}, 1)
 
 		movies, err := env.MovieService().DiscoverAll(userID)
 		require.NoError(t, err)
@@ -194,6 +208,23 @@ func TestDiscoverAll(t *testing.T) {
 		assert.False(t, watchlisted[3])
 	})
 
+	t.Run("checks each title once across categories", func(t *testing.T) {
+		env := newTestEnv(t)
+		userID := uuid.New()
+
+		env.TMDB.ReturnsTrending([]models.Movie{{ID: 1, Title: "Trending", MediaType: "movie"}})
+		env.TMDB.ReturnsNowPlaying(1, []models.Movie{{ID: 1, Title: "Trending", MediaType: "movie"}, {ID: 2, Title: "Now Playing", MediaType: "movie"}})
+		env.TMDB.ReturnsUpcoming(1, nil)
+		env.TMDB.ReturnsVideos("movie", 1, trailer)
+		env.TMDB.ReturnsVideos("movie", 2, trailer)
+		env.Watchlist.SavesAmong(userID, []int{1, 2}, 2)
+
+		movies, err := env.MovieService().DiscoverAll(userID)
+		require.NoError(t, err)
+		assert.Len(t, movies, 2)
+		env.Watchlist.AssertNumberOfCalls(t, "SavedAmong", 1)
+	})
+
 	t.Run("partial failure still returns other categories", func(t *testing.T) {
 		env := newTestEnv(t)
 
@@ -227,7 +258,7 @@ func TestEnrichWithWatchlist_MarksSavedMovies(t *testing.T) {
 		{ID: 1, Title: "Saved"},
 		{ID: 2, Title: "Not Saved"},
 	})
-	env.Watchlist.ReturnsWatchlist(userID, []models.Watchlist{{TMDBId: 1}})
+	env.Watchlist.SavesAmong(userID, []int{1, 2}, 1)
 
 	movies, err := env.MovieService().Discover(userID, "trending", 1)
 	require.NoError(t, err)
@@ -235,6 +266,42 @@ func TestEnrichWithWatchlist_MarksSavedMovies(t *testing.T) {
 	assert.False(t, movies[1].IsWatchlisted)
 }
 
+func TestEnrichWithWatchlist_LeavesResultsUntouched(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	cached := []models.Movie{{ID: 1, Title: "Saved"}}
+	env.TMDB.ReturnsTrending(cached)
+	env.Watchlist.SavesAmong(userID, []int{1}, 1)
+
+	movies, err := env.MovieService().Discover(userID, "trending", 1)
+	require.NoError(t, err)
+	assert.True(t, movies[0].IsWatchlisted)
+	assert.False(t, cached[0].IsWatchlisted, "cached results are shared between users")
+}
+
+func TestEnrichWithWatchlist_ChecksOnlyTheResults(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	env.TMDB.ReturnsTrending([]models.Movie{{ID: 1}, {ID: 2}})
+	env.Watchlist.SavesAmong(userID, []int{1, 2}, 2)
+
+	movies, err := env.MovieService().Discover(userID, "trending", 1)
+	require.NoError(t, err)
+	assert.False(t, movies[0].IsWatchlisted)
+	assert.True(t, movies[1].IsWatchlisted)
+}
+
+func TestEnrichWithWatchlist_LookupFails(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	env.TMDB.ReturnsTrending([]models.Movie{{ID: 1}})
+	env.Watchlist.SavedAmongFails(userID, []int{1}, errors.New("db down"))
+
+	movies, err := env.MovieService().Discover(userID, "trending", 1)
+	require.NoError(t, err)
+	assert.False(t, movies[0].IsWatchlisted)
+}
+
 // --- Search ---
 
 func TestSearch(t *testing.T) {
@@ -247,7 +314,7 @@ func TestSearch(t *testing.T) {
 	}{
 		"success": {"fight club", 1, func(env *TestEnv, userID uuid.UUID) {
 			env.TMDB.SearchReturns("fight club", 1, []models.Movie{{ID: 550, Title: "Fight Club"}})
-			env.Watchlist.ReturnsWatchlist(userID, nil)
+			env.Watchlist.SavesAmong(userID, []int{550})
 		}, []models.Movie{{ID: 550, Title: "Fight Club"}}, false},
 		"tmdb error": {"query", 1, func(env *TestEnv, _ uuid.UUID) {
 			env.TMDB.SearchFails("query", 1, errors.New("timeout"))
@@ -309,6 +376,190 @@ func TestGetCredits(t *testing.T) {
 	assert.Equal(t, expected, credits)
 }
 
+// --- GetSimilar / GetRecommendations ---
+
+func TestGetSimilar_EnrichesWithWatchlist(t *testing.T) {
+	env := newTestEnv(t)
+	userID := uuid.New()
+	env.TMDB.On("GetSimilar", "movie", 550, 1).Return([]models.Movie{{ID: 807}, {ID: 680}}, nil)
+	env.Watchlist.SavesAmong(userID, []int{807, 680}, 680)
+
+	movies, err := env.MovieService().GetSimilar(userID, "movie", 550, 1)
+	require.NoError(t, err)
+	assert.False(t, movies[0].IsWatchlisted)
+	assert.True(t, movies[1].IsWatchlisted)
+}
+
+func TestGetRecommendations(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("enriches with watchlist", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.TMDB.On("GetRecommendations", "tv", 1399, 2).Return([]models.Movie{{ID: 1402, MediaType: "tv"}}, nil)
+		env.Watchlist.SavesAmong(userID, []int{1402}, 1402)
+
+		shows, err := env.MovieService().GetRecommendations(userID, "tv", 1399, 2)
+		require.NoError(t, err)
+		assert.True(t, shows[0].IsWatchlisted)
+	})
+
+	t.Run("unknown title", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.TMDB.On("GetRecommendations", "movie", 999, 1).Return(nil, tmdb.ErrNotFound)
+
+		_, err := env.MovieService().GetRecommendations(userID, "movie", 999, 1)
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+// --- DiscoverOnMyServices ---
+
+func TestDiscoverOnMyServices(t *testing.T) {
+	netflix, hulu, peacock := 8, 15, 386
+	services := []models.StreamingService{
+		{Slug: "peacock", TMDBProviderID: &peacock, Regions: []string{"US"}},
+		{Slug: "netflix", TMDBProviderID: &netflix},
+		{Slug: "hulu", TMDBProviderID: &hulu, Regions: []string{"US"}},
+		{Slug: "local"},
+	}
+
+	tests := map[string]struct {
+		mediaType string
+		region    string
+		services  []models.StreamingService
+		params    *tmdb.DiscoverParams
+		err       error
+	}{
+		"defaults to US": {"movie", "", services, &tmdb.DiscoverParams{
+			WatchProviders: []int{8, 15, 386}, WatchRegion: "US", MonetizationTypes: watchableMonetization, Page: 1,
+		}, nil},
+		"skips services not offered in region": {"tv", "gb", services, &tmdb.DiscoverParams{
+			WatchProviders: []int{8}, WatchRegion: "GB", MonetizationTypes: watchableMonetization, Page: 1,
+		}, nil},
+		"no services in region": {"movie", "FR", services[2:], nil, ErrNoStreamingServices},
+		"no services":           {"movie", "US", nil, nil, ErrNoStreamingServices},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			userID := uuid.New()
+			env.Users.FindsUser(userID, &models.User{ID: userID, StreamingServices: tt.services})
+			if tt.params != nil {
+				env.TMDB.DiscoverReturns(tt.mediaType, *tt.params, []models.Movie{{ID: 550}, {ID: 680}})
+				env.Watchlist.SavesAmong(userID, []int{550, 680}, 680)
+			}
+
+			movies, err := env.MovieService().DiscoverOnMyServices(userID, tt.mediaType, tt.region, 1)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, movies, 2)
+			assert.True(t, movies[1].IsWatchlisted)
+		})
+	}
+
+	t.Run("invalid filters", func(t *testing.T) {
+		env := newTestEnv(t)
+
+		_, err := env.MovieService().DiscoverOnMyServices(uuid.New(), "anime", "USA", 1)
+
+		var verr *ValidationError
+		require.ErrorAs(t, err, &verr)
+		assert.Contains(t, verr.Fields, "media_type")
+		assert.Contains(t, verr.Fields, "region")
+	})
+}
+
+// --- GetProviders ---
+
+func tmdbProviders() *tmdb.WatchProvidersResponse {
+	return &tmdb.WatchProvidersResponse{
+		ID: 550,
+		Results: map[string]tmdb.RegionWatchProviders{
+			"US": {
+				Link:     "https://www.themoviedb.org/movie/550/watch?locale=US",
+				Flatrate: []tmdb.WatchProvider{{ProviderID: 8, ProviderName: "Netflix"}, {ProviderID: 15, ProviderName: "Hulu"}},
+				Rent:     []tmdb.WatchProvider{{ProviderID: 2, ProviderName: "Apple TV"}},
+			},
+			"GB": {
+				Flatrate: []tmdb.WatchProvider{{ProviderID: 8, ProviderName: "Netflix"}, {ProviderID: 15, ProviderName: "Hulu"}},
+			},
+		},
+	}
+}
+
+func TestGetProviders(t *testing.T) {
+	netflix, hulu := 8, 15
+	userID := uuid.New()
+
+	env := newTestEnv(t)
+	env.TMDB.ReturnsProviders("movie", 550, tmdbProviders())
+	env.Users.FindsUser(userID, &models.User{ID: userID, StreamingServices: []models.StreamingService{
+		{Slug: "netflix", TMDBProviderID: &netflix},
+		{Slug: "hulu", TMDBProviderID: &hulu, Regions: []string{"US"}},
+		{Slug: "local"},
+	}})
+
+	providers, err := env.MovieService().GetProviders(userID, "movie", 550, "")
+	require.NoError(t, err)
+	assert.Equal(t, 550, providers.TMDBID)
+	require.Len(t, providers.Regions, 2)
+
+	us := providers.Regions["US"]
+	assert.Equal(t, "Netflix", us.Stream[0].Name)
+	assert.True(t, us.Stream[0].OnMyServices)
+	assert.True(t, us.Stream[1].OnMyServices)
+	assert.False(t, us.Rent[0].OnMyServices)
+
+	gb := providers.Regions["GB"]
+	assert.True(t, gb.Stream[0].OnMyServices)
+	assert.False(t, gb.Stream[1].OnMyServices, "Hulu is only offered in the US")
+}
+
+func TestGetProviders_Region(t *testing.T) {
+	tests := map[string]struct {
+		region  string
+		regions []string
+		err     error
+	}{
+		"filters to region":     {"gb", []string{"GB"}, nil},
+		"region without offers": {"FR", []string{}, nil},
+		"invalid region":        {"USA", nil, ErrInvalidInput},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			userID := uuid.New()
+			if tt.err == nil {
+				env.TMDB.ReturnsProviders("movie", 550, tmdbProviders())
+				env.Users.FindsUser(userID, &models.User{ID: userID})
+			}
+
+			providers, err := env.MovieService().GetProviders(userID, "movie", 550, tt.region)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			regions := []string{}
+			for code := range providers.Regions {
+				regions = append(regions, code)
+			}
+			assert.Equal(t, tt.regions, regions)
+		})
+	}
+}
+
 // --- Watchlist ---
 
 func TestAddToWatchlist(t *testing.T) {
@@ -322,6 +573,9 @@ func TestAddToWatchlist(t *testing.T) {
 		}, nil, func(t *testing.T, item *models.Watchlist) {
 			assert.Equal(t, 550, item.TMDBId)
 			assert.Equal(t, "Fight Club", item.Title)
+			assert.Equal(t, "1999-10-15", item.ReleaseDate)
+			assert.Equal(t, []models.WatchlistGenre{{GenreID: 18}, {GenreID: 53}}, item.Genres)
+			assert.True(t, item.DetailsSynced)
 		}},
 		"duplicate": {func(env *TestEnv) {
 			env.Watchlist.AddFails(errors.New("unique constraint violation"))
@@ -335,6 +589,7 @@ func TestAddToWatchlist(t *testing.T) {
 
 			item, err := env.MovieService().AddToWatchlist(uuid.New(), models.Movie{
 				ID: 550, Title: "Fight Club", MediaType: "movie",
+				ReleaseDate: "1999-10-15", VoteAverage: 8.4, GenreIDs: []int{18, 53, 18},
 			})
 
 			if tt.err != nil {
@@ -403,18 +658,3 @@ func TestCheckWatchlist(t *testing.T) {
 		})
 	}
 }
-
-func TestGetWatchlist(t *testing.T) {
-	env := newTestEnv(t)
-	userID := uuid.New()
-	expected := []models.Watchlist{
-		{TMDBId: 550, Title: "Fight Club"},
-		{TMDBId: 680, Title: "Pulp Fiction"},
-	}
-	env.Watchlist.ReturnsWatchlist(userID, expected)
-
-	items, err := env.MovieService().GetWatchlist(userID)
-	require.NoError(t, err)
-	assert.Len(t, items, 2)
-	assert.Equal(t, "Fight Club", items[0].Title)
-}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+// PersonService handles actor, director and crew details.
+type PersonService struct {
+	tmdb tmdb.API
+}
+
+// NewPersonService creates a new PersonService.
+func NewPersonService(tmdbClient tmdb.API) *PersonService {
+	return &PersonService{tmdb: tmdbClient}
+}
+
+// GetPerson returns a person's biography and details.
+func (s *PersonService) GetPerson(id int) (*models.Person, error) {
+	person, err := s.tmdb.GetPerson(id)
+	if err != nil {
+		return nil, tmdbError(err)
+	}
+
+	return person, nil
+}
+
+// GetCredits returns a person's movie and TV credits, newest first. Credits
+// without a release date are announced projects and come before the rest.
+func (s *PersonService) GetCredits(id int) (*models.PersonCredits, error) {
+	credits, err := s.tmdb.GetPersonCredits(id)
+	if err != nil {
+		return nil, tmdbError(err)
+	}
+
+	sorted := models.PersonCredits{
+		Cast: slices.Clone(credits.Cast),
+		Crew: slices.Clone(credits.Crew),
+	}
+	slices.SortStableFunc(sorted.Cast, compareCredits)
+	slices.SortStableFunc(sorted.Crew, compareCredits)
+
+	return &sorted, nil
+}
+
+// GetImages returns a person's profile photos.
+func (s *PersonService) GetImages(id int) ([]models.PersonImage, error) {
+	images, err := s.tmdb.GetPersonImages(id)
+	if err != nil {
+		return nil, tmdbError(err)
+	}
+
+	return images, nil
+}
+
+func compareCredits(a, b models.PersonCredit) int {
+	if (a.ReleaseDate == "") != (b.ReleaseDate == "") {
+		if a.ReleaseDate == "" {
+			return -1
+		}
+
+		return 1
+	}
+
+	return cmp.Compare(b.ReleaseDate, a.ReleaseDate)
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+func TestGetPersonCredits_NewestFirst(t *testing.T) {
+	env := newTestEnv(t)
+	env.TMDB.ReturnsPersonCredits(287, &models.PersonCredits{
+		Cast: []models.PersonCredit{
+			{ID: 550, Title: "Fight Club", ReleaseDate: "1999-10-15"},
+			{ID: 1, Title: "Untitled Project"},
+			{ID: 1422, Title: "The Curious Case of Benjamin Button", ReleaseDate: "2008-12-25"},
+		},
+		Crew: []models.PersonCredit{
+			{ID: 2, Title: "Older", ReleaseDate: "2001-01-01", Job: "Producer"},
+			{ID: 3, Title: "Newer", ReleaseDate: "2019-07-26", Job: "Producer"},
+		},
+	})
+
+	credits, err := env.PersonService().GetCredits(287)
+	require.NoError(t, err)
+
+	var cast []int
+	for _, c := range credits.Cast {
+		cast = append(cast, c.ID)
+	}
+	assert.Equal(t, []int{1, 1422, 550}, cast, "announced projects first, then newest")
+	assert.Equal(t, 3, credits.Crew[0].ID)
+}
+
+func TestGetPerson_NotFound(t *testing.T) {
+	env := newTestEnv(t)
+	env.TMDB.PersonFails(999, tmdb.ErrNotFound)
+
+	_, err := env.PersonService().GetPerson(999)
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
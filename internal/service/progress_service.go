@@ -0,0 +1,381 @@
+package service
+
+import (
+	"cmp"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+// airDateLayout is the format of TMDB air dates.
+const airDateLayout = "2006-01-02"
+
+// upNextConcurrency caps the shows looked up on TMDB at once for UpNext.
+const upNextConcurrency = 4
+
+// SeasonProgress counts the watched episodes of a season.
+type SeasonProgress struct {
+	SeasonNumber int  `json:"season_number"`
+	EpisodeCount int  `json:"episode_count"`
+	Watched      int  `json:"watched"`
+	Completed    bool `json:"completed"`
+}
+
+// ShowProgress is how far the user is through a TV show on their watchlist.
+type ShowProgress struct {
+	TMDBId          int              `json:"tmdb_id"`
+	Title           string           `json:"title"`
+	WatchedEpisodes int              `json:"watched_episodes"`
+	TotalEpisodes   int              `json:"total_episodes"`
+	Seasons         []SeasonProgress `json:"seasons"`
+	NextEpisode     *models.Episode  `json:"next_episode"`
+	LastWatchedAt   *time.Time       `json:"last_watched_at"`
+}
+
+// UpNextEntry is the next episode to watch of a show on the watchlist.
+type UpNextEntry struct {
+	models.Watchlist
+	NextEpisode   models.Episode `json:"next_episode"`
+	LastWatchedAt *time.Time     `json:"last_watched_at"`
+}
+
+// ProgressService tracks which episodes of watchlisted TV shows a user has
+// watched.
+type ProgressService struct {
+	tmdb          tmdb.API
+	watchlistRepo repository.WatchlistRepository
+	progressRepo  repository.ProgressRepository
+	clock         Clock
+}
+
+// NewProgressService creates a new ProgressService.
+func NewProgressService(tmdbClient tmdb.API, watchlistRepo repository.WatchlistRepository, progressRepo repository.ProgressRepository, clock Clock) *ProgressService {
+	return &ProgressService{
+		tmdb:          tmdbClient,
+		watchlistRepo: watchlistRepo,
+		progressRepo:  progressRepo,
+		clock:         clock,
+	}
+}
+
+// GetProgress returns the user's progress through a show on their watchlist.
+func (s *ProgressService) GetProgress(userID uuid.UUID, showID int) (*ShowProgress, error) {
+	item, err := s.watchlistShow(userID, showID)
+	if err != nil {
+		return nil, err
+	}
+
+	show, err := s.tmdb.GetTVDetails(showID)
+	if err != nil {
+		return nil, tmdbError(err)
+	}
+
+	watched, err := s.progressRepo.ListByItem(item.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &ShowProgress{
+		TMDBId:        item.TMDBId,
+		Title:         item.Title,
+		Seasons:       []SeasonProgress{},
+		LastWatchedAt: lastWatched(watched),
+	}
+
+	for _, summary := range show.Seasons {
+		season := SeasonProgress{SeasonNumber: summary.SeasonNumber, EpisodeCount: summary.EpisodeCount}
+		for _, w := range watched {
+			if w.SeasonNumber == summary.SeasonNumber {
+				season.Watched++
+			}
+		}
+		season.Completed = season.EpisodeCount > 0 && season.Watched >= season.EpisodeCount
+		progress.Seasons = append(progress.Seasons, season)
+
+		// Specials don't count towards the show's progress.
+		if summary.SeasonNumber > 0 {
+			progress.WatchedEpisodes += season.Watched
+			progress.TotalEpisodes += season.EpisodeCount
+		}
+	}
+
+	progress.NextEpisode, err = s.nextEpisode(show, watched)
+	if err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
+
+// MarkEpisode marks an aired episode of a show on the watchlist as watched.
+func (s *ProgressService) MarkEpisode(userID uuid.UUID, showID int, seasonNumber int, episodeNumber int) error {
+	item, err := s.watchlistShow(userID, showID)
+	if err != nil {
+		return err
+	}
+
+	season, err := s.tmdb.GetSeason(showID, seasonNumber)
+	if err != nil {
+		return tmdbError(err)
+	}
+
+	i := slices.IndexFunc(season.Episodes, func(e models.Episode) bool {
+		return e.EpisodeNumber == episodeNumber
+	})
+	if i < 0 {
+		return ErrNotFound
+	}
+
+	if !s.aired(season.Episodes[i]) {
+		return ErrNotAired
+	}
+
+	return s.progressRepo.MarkWatched([]models.EpisodeProgress{s.entry(item, seasonNumber, episodeNumber)})
+}
+
+// MarkSeason marks every aired episode of a season as watched and returns
+// how many episodes that covers.
+func (s *ProgressService) MarkSeason(userID uuid.UUID, showID int, seasonNumber int) (int, error) {
+	item, err := s.watchlistShow(userID, showID)
+	if err != nil {
+		return 0, err
+	}
+
+	season, err := s.tmdb.GetSeason(showID, seasonNumber)
+	if err != nil {
+		return 0, tmdbError(err)
+	}
+
+	var entries []models.EpisodeProgress
+	for _, e := range season.Episodes {
+		if s.aired(e) {
+			entries = append(entries, s.entry(item, seasonNumber, e.EpisodeNumber))
+		}
+	}
+
+	if len(entries) == 0 {
+		return 0, ErrNotAired
+	}
+
+	if err := s.progressRepo.MarkWatched(entries); err != nil {
+		return 0, err
+	}
+
+	return len(entries), nil
+}
+
+// UnmarkEpisode clears the watched state of an episode.
+func (s *ProgressService) UnmarkEpisode(userID uuid.UUID, showID int, seasonNumber int, episodeNumber int) error {
+	item, err := s.watchlistShow(userID, showID)
+	if err != nil {
+		return err
+	}
+
+	removed, err := s.progressRepo.UnmarkEpisode(item.ID, seasonNumber, episodeNumber)
+	if err != nil {
+		return err
+	}
+
+	if removed == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// UnmarkSeason clears the watched state of every episode of a season.
+func (s *ProgressService) UnmarkSeason(userID uuid.UUID, showID int, seasonNumber int) error {
+	item, err := s.watchlistShow(userID, showID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.progressRepo.UnmarkSeason(item.ID, seasonNumber)
+
+	return err
+}
+
+// UpNext returns the next aired, unwatched episode of each show on the
+// user's watchlist, most recently active show first. Shows that are caught
+// up are left out, as are shows whose details TMDB fails to return.
+func (s *ProgressService) UpNext(userID uuid.UUID) ([]UpNextEntry, error) {
+	items, err := s.watchlistRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.progressRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	watchedByItem := make(map[uuid.UUID][]models.EpisodeProgress)
+	for _, p := range progress {
+		watchedByItem[p.WatchlistID] = append(watchedByItem[p.WatchlistID], p)
+	}
+
+	results := []UpNextEntry{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, upNextConcurrency)
+
+	for _, item := range items {
+		if item.MediaType != "tv" {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(item models.Watchlist) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			watched := watchedByItem[item.ID]
+			next, err := s.upNext(item.TMDBId, watched)
+			if err != nil {
+				log.Printf("Failed to find next episode of show %d: %v", item.TMDBId, err)
+
+				return
+			}
+
+			if next == nil {
+				return
+			}
+
+			mu.Lock()
+			results = append(results, UpNextEntry{Watchlist: item, NextEpisode: *next, LastWatchedAt: lastWatched(watched)})
+			mu.Unlock()
+		}(item)
+	}
+	wg.Wait()
+
+	slices.SortFunc(results, func(a, b UpNextEntry) int {
+		return cmp.Or(
+			activity(b).Compare(activity(a)),
+			cmp.Compare(a.TMDBId, b.TMDBId),
+		)
+	})
+
+	return results, nil
+}
+
+func (s *ProgressService) upNext(showID int, watched []models.EpisodeProgress) (*models.Episode, error) {
+	show, err := s.tmdb.GetTVDetails(showID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.nextEpisode(show, watched)
+}
+
+// nextEpisode returns the episode after the furthest one watched, skipping
+// specials, or nil if it hasn't aired yet or the show is finished.
+func (s *ProgressService) nextEpisode(show *models.TVShow, watched []models.EpisodeProgress) (*models.Episode, error) {
+	var lastSeason, lastEpisode int
+	for _, w := range watched {
+		if w.SeasonNumber > lastSeason || (w.SeasonNumber == lastSeason && w.EpisodeNumber > lastEpisode) {
+			lastSeason, lastEpisode = w.SeasonNumber, w.EpisodeNumber
+		}
+	}
+
+	seasons := slices.Clone(show.Seasons)
+	slices.SortFunc(seasons, func(a, b models.SeasonSummary) int {
+		return cmp.Compare(a.SeasonNumber, b.SeasonNumber)
+	})
+
+	for _, summary := range seasons {
+		if summary.SeasonNumber == 0 || summary.SeasonNumber < lastSeason ||
+			(summary.SeasonNumber == lastSeason && lastEpisode >= summary.EpisodeCount) {
+			continue
+		}
+
+		season, err := s.tmdb.GetSeason(show.ID, summary.SeasonNumber)
+		if err != nil {
+			return nil, tmdbError(err)
+		}
+
+		for _, e := range season.Episodes {
+			if summary.SeasonNumber == lastSeason && e.EpisodeNumber <= lastEpisode {
+				continue
+			}
+
+			if !s.aired(e) {
+				return nil, nil
+			}
+
+			episode := e
+
+			return &episode, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// watchlistShow finds a TV show on the user's watchlist.
+func (s *ProgressService) watchlistShow(userID uuid.UUID, showID int) (*models.Watchlist, error) {
+	item, err := s.watchlistRepo.Find(userID, showID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if item.MediaType != "tv" {
+		return nil, ErrNotTVShow
+	}
+
+	return item, nil
+}
+
+func (s *ProgressService) entry(item *models.Watchlist, seasonNumber int, episodeNumber int) models.EpisodeProgress {
+	return models.EpisodeProgress{
+		WatchlistID:   item.ID,
+		UserID:        item.UserID,
+		TMDBId:        item.TMDBId,
+		SeasonNumber:  seasonNumber,
+		EpisodeNumber: episodeNumber,
+		WatchedAt:     s.clock.Now(),
+	}
+}
+
+// aired reports whether an episode has aired. Episodes without an air date
+// are yet to be scheduled.
+func (s *ProgressService) aired(e models.Episode) bool {
+	if e.AirDate == "" {
+		return false
+	}
+
+	return e.AirDate <= s.clock.Now().UTC().Format(airDateLayout)
+}
+
+func lastWatched(watched []models.EpisodeProgress) *time.Time {
+	var last *time.Time
+	for i := range watched {
+		if last == nil || watched[i].WatchedAt.After(*last) {
+			last = &watched[i].WatchedAt
+		}
+	}
+
+	return last
+}
+
+// activity is when the user last watched an episode of the show, or when
+// they saved it if they haven't started it.
+func activity(entry UpNextEntry) time.Time {
+	if entry.LastWatchedAt != nil {
+		return *entry.LastWatchedAt
+	}
+
+	return entry.AddedAt
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// testShow has a special, a finished two-episode first season and a second
+// season whose last episode airs after the test clock's date.
+func testShow() *models.TVShow {
+	return &models.TVShow{ID: 1399, Name: "Game of Thrones", Seasons: []models.SeasonSummary{
+		{SeasonNumber: 0, EpisodeCount: 1},
+		{SeasonNumber: 1, EpisodeCount: 2},
+		{SeasonNumber: 2, EpisodeCount: 2},
+	}}
+}
+
+func testSeason(number int, airDates ...string) *models.Season {
+	season := &models.Season{ShowID: 1399, SeasonNumber: number}
+	for i, airDate := range airDates {
+		season.Episodes = append(season.Episodes, models.Episode{
+			ShowID: 1399, SeasonNumber: number, EpisodeNumber: i + 1, AirDate: airDate,
+		})
+	}
+
+	return season
+}
+
+func watched(item models.Watchlist, season int, episode int, at time.Time) models.EpisodeProgress {
+	return models.EpisodeProgress{
+		WatchlistID: item.ID, UserID: item.UserID, TMDBId: item.TMDBId,
+		SeasonNumber: season, EpisodeNumber: episode, WatchedAt: at,
+	}
+}
+
+func TestGetProgress(t *testing.T) {
+	userID := uuid.New()
+	item := models.Watchlist{ID: uuid.New(), UserID: userID, TMDBId: 1399, MediaType: "tv", Title: "Game of Thrones"}
+	at := time.Date(2026, 2, 1, 20, 0, 0, 0, time.UTC)
+
+	env := newTestEnv(t)
+	env.Watchlist.FindsItem(&item)
+	env.TMDB.ReturnsShow(testShow())
+	env.Progress.ReturnsForItem(item.ID, []models.EpisodeProgress{
+		watched(item, 0, 1, at),
+		watched(item, 1, 1, at),
+		watched(item, 1, 2, at.Add(time.Hour)),
+	})
+	env.TMDB.ReturnsSeason(testSeason(2, "2026-02-20", "2026-03-20"))
+
+	progress, err := env.ProgressService().GetProgress(userID, 1399)
+	require.NoError(t, err)
+	assert.Equal(t, 2, progress.WatchedEpisodes, "specials don't count")
+	assert.Equal(t, 4, progress.TotalEpisodes)
+	require.Len(t, progress.Seasons, 3)
+	assert.True(t, progress.Seasons[1].Completed)
+	assert.False(t, progress.Seasons[2].Completed)
+	require.NotNil(t, progress.NextEpisode)
+	assert.Equal(t, 2, progress.NextEpisode.SeasonNumber)
+	assert.Equal(t, 1, progress.NextEpisode.EpisodeNumber)
+	assert.Equal(t, at.Add(time.Hour), *progress.LastWatchedAt)
+}
+
+func TestGetProgress_Errors(t *testing.T) {
+	userID := uuid.New()
+	errDBDown := errors.New("db down")
+
+	tests := map[string]struct {
+		setup func(*TestEnv)
+		want  error
+	}{
+		"not on watchlist": {
+			setup: func(env *TestEnv) { env.Watchlist.FindFails(userID, 1399, gorm.ErrRecordNotFound) },
+			want:  ErrNotFound,
+		},
+		"database error": {
+			setup: func(env *TestEnv) { env.Watchlist.FindFails(userID, 1399, errDBDown) },
+			want:  errDBDown,
+		},
+		"movie": {
+			setup: func(env *TestEnv) {
+				env.Watchlist.FindsItem(&models.Watchlist{UserID: userID, TMDBId: 1399, MediaType: "movie"})
+			},
+			want: ErrNotTVShow,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			tt.setup(env)
+
+			_, err := env.ProgressService().GetProgress(userID, 1399)
+
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestMarkEpisode(t *testing.T) {
+	userID := uuid.New()
+	item := models.Watchlist{ID: uuid.New(), UserID: userID, TMDBId: 1399, MediaType: "tv"}
+
+	tests := map[string]struct {
+		episode int
+		want    error
+	}{
+		"aired":     {episode: 1},
+		"not aired": {episode: 2, want: ErrNotAired},
+		"unknown":   {episode: 3, want: ErrNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			env.Watchlist.FindsItem(&item)
+			env.TMDB.ReturnsSeason(testSeason(2, "2026-02-20", "2026-03-20"))
+
+			var marked []models.EpisodeProgress
+			if tt.want == nil {
+				env.Progress.MarksWatched(&marked)
+			}
+
+			err := env.ProgressService().MarkEpisode(userID, 1399, 2, tt.episode)
+
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, marked, 1)
+			assert.Equal(t, item.ID, marked[0].WatchlistID)
+			assert.Equal(t, env.Clock.Now(), marked[0].WatchedAt)
+		})
+	}
+}
+
+func TestMarkSeason_OnlyAiredEpisodes(t *testing.T) {
+	userID := uuid.New()
+	item := models.Watchlist{ID: uuid.New(), UserID: userID, TMDBId: 1399, MediaType: "tv"}
+
+	env := newTestEnv(t)
+	env.Watchlist.FindsItem(&item)
+	env.TMDB.ReturnsSeason(testSeason(2, "2026-02-20", "2026-03-20"))
+
+	var marked []models.EpisodeProgress
+	env.Progress.MarksWatched(&marked)
+
+	count, err := env.ProgressService().MarkSeason(userID, 1399, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, marked, 1)
+	assert.Equal(t, 1, marked[0].EpisodeNumber)
+}
+
+func TestUnmarkEpisode_NotWatched(t *testing.T) {
+	userID := uuid.New()
+	item := models.Watchlist{ID: uuid.New(), UserID: userID, TMDBId: 1399, MediaType: "tv"}
+
+	env := newTestEnv(t)
+	env.Watchlist.FindsItem(&item)
+	env.Progress.On("UnmarkEpisode", item.ID, 1, 1).Return(int64(0), nil)
+
+	err := env.ProgressService().UnmarkEpisode(userID, 1399, 1, 1)
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestUpNext(t *testing.T) {
+	userID := uuid.New()
+	saved := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	got := models.Watchlist{ID: uuid.New(), UserID: userID, TMDBId: 1399, MediaType: "tv", AddedAt: saved}
+	fresh := models.Watchlist{ID: uuid.New(), UserID: userID, TMDBId: 66732, MediaType: "tv", AddedAt: saved.Add(24 * time.Hour)}
+	caughtUp := models.Watchlist{ID: uuid.New(), UserID: userID, TMDBId: 94997, MediaType: "tv", AddedAt: saved}
+	broken := models.Watchlist{ID: uuid.New(), UserID: userID, TMDBId: 1, MediaType: "tv", AddedAt: saved}
+	movie := models.Watchlist{ID: uuid.New(), UserID: userID, TMDBId: 550, MediaType: "movie", AddedAt: saved}
+	at := time.Date(2026, 2, 25, 21, 0, 0, 0, time.UTC)
+
+	env := newTestEnv(t)
+	env.Watchlist.ReturnsWatchlist(userID, []models.Watchlist{movie, fresh, got, caughtUp, broken})
+	env.Progress.ReturnsForUser(userID, []models.EpisodeProgress{
+		watched(got, 1, 2, at),
+		watched(caughtUp, 1, 1, at),
+	})
+
+	env.TMDB.ReturnsShow(testShow())
+	env.TMDB.ReturnsSeason(testSeason(2, "2026-02-20", "2026-03-20"))
+
+	env.TMDB.ReturnsShow(&models.TVShow{ID: 66732, Seasons: []models.SeasonSummary{{SeasonNumber: 1, EpisodeCount: 1}}})
+	env.TMDB.ReturnsSeason(&models.Season{ShowID: 66732, SeasonNumber: 1, Episodes: []models.Episode{
+		{ShowID: 66732, SeasonNumber: 1, EpisodeNumber: 1, AirDate: "2016-07-15"},
+	}})
+
+	env.TMDB.ReturnsShow(&models.TVShow{ID: 94997, Seasons: []models.SeasonSummary{{SeasonNumber: 1, EpisodeCount: 1}}})
+	env.TMDB.ShowFails(1, errors.New("tmdb down"))
+
+	entries, err := env.ProgressService().UpNext(userID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2, "caught up shows, movies and failures are left out")
+	assert.Equal(t, 1399, entries[0].TMDBId, "most recent activity first")
+	assert.Equal(t, 2, entries[0].NextEpisode.SeasonNumber)
+	assert.Equal(t, 1, entries[0].NextEpisode.EpisodeNumber)
+	assert.Equal(t, 66732, entries[1].TMDBId)
+	assert.Equal(t, 1, entries[1].NextEpisode.EpisodeNumber)
+	assert.Nil(t, entries[1].LastWatchedAt)
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	cache "github.com/patrickmn/go-cache"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/recommend"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+// Bounds on the work done for one recommendations feed.
+const (
+	// maxRecommendationSeeds caps how many recent posts and saves the taste
+	// profile is built from.
+	maxRecommendationSeeds = 10
+	// shortlistSize is how many candidates are scored again with their
+	// cast and keywords, which cost two TMDB calls each.
+	shortlistSize = 30
+	// recommendationLimit is the length of the feed.
+	recommendationLimit = 20
+	// seedCastSize is how many leading actors of a title are compared.
+	seedCastSize = 5
+	// discoverGenres is how many of the user's top genres seed discover.
+	discoverGenres = 3
+	// discoverMinVotes keeps obscure titles out of the discover candidates.
+	discoverMinVotes = 200
+	// recommendationConcurrency caps the titles looked up on TMDB at once
+	// while building a feed.
+	recommendationConcurrency = 4
+)
+
+// Built feeds are kept per user for a while, so repeat visits don't redo the
+// TMDB lookups. Titles saved since are still left out.
+const (
+	recommendationTTL     = 15 * time.Minute
+	recommendationCleanup = 30 * time.Minute
+)
+
+// RecommendationSource is the saved or posted title a recommendation is
+// based on.
+type RecommendationSource struct {
+	TMDBId    int              `json:"tmdb_id"`
+	MediaType string           `json:"media_type"`
+	Title     string           `json:"title"`
+	Source    recommend.Source `json:"source"`
+}
+
+// Recommendation is a title picked for the user with the reason it was.
+type Recommendation struct {
+	models.Movie
+	Score   float64              `json:"score"`
+	Reason  string               `json:"reason"`
+	Because RecommendationSource `json:"because"`
+}
+
+// RecommendationService builds a personal feed from the titles a user saved
+// or posted about.
+type RecommendationService struct {
+	tmdb          tmdb.API
+	watchlistRepo repository.WatchlistRepository
+	postRepo      repository.PostRepository
+	feeds         *cache.Cache
+}
+
+// NewRecommendationService creates a new RecommendationService.
+func NewRecommendationService(tmdbClient tmdb.API, watchlistRepo repository.WatchlistRepository, postRepo repository.PostRepository) *RecommendationService {
+	return &RecommendationService{
+		tmdb:          tmdbClient,
+		watchlistRepo: watchlistRepo,
+		postRepo:      postRepo,
+		feeds:         cache.New(recommendationTTL, recommendationCleanup),
+	}
+}
+
+// candidatePool collects candidates from several goroutines, keeping the
+// display data of each title next to the features the scorer needs.
+type candidatePool struct {
+	mu         sync.Mutex
+	movies     map[recommend.Title]models.Movie
+	candidates map[recommend.Title]*recommend.Candidate
+}
+
+func newCandidatePool() *candidatePool {
+	return &candidatePool{
+		movies:     map[recommend.Title]models.Movie{},
+		candidates: map[recommend.Title]*recommend.Candidate{},
+	}
+}
+
+// add records movies as candidates, noting the seed that recommended them
+// unless they came from discover.
+func (p *candidatePool) add(movies []models.Movie, recommendedBy *recommend.Title) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, m := range movies {
+		title := recommend.Title{ID: m.ID, MediaType: m.MediaType}
+		c, ok := p.candidates[title]
+		if !ok {
+			c = &recommend.Candidate{
+				Title:       title,
+				Features:    recommend.Features{Genres: m.GenreIDs},
+				VoteAverage: m.VoteAverage,
+			}
+			p.candidates[title] = c
+			p.movies[title] = m
+		}
+
+		if recommendedBy != nil {
+			c.RecommendedBy = append(c.RecommendedBy, *recommendedBy)
+		}
+	}
+}
+
+func (p *candidatePool) list() []recommend.Candidate {
+	list := make([]recommend.Candidate, 0, len(p.candidates))
+	for _, c := range p.candidates {
+		list = append(list, *c)
+	}
+
+	return list
+}
+
+// Recommend returns titles for the user ranked against the genres, people
+// and keywords of what they recently saved or posted about. Titles already
+// on the watchlist are left out. Users with neither get an empty feed.
+func (s *RecommendationService) Recommend(userID uuid.UUID) ([]Recommendation, error) {
+	watchlist, err := s.watchlistRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := make(map[recommend.Title]struct{}, len(watchlist))
+	for _, item := range watchlist {
+		saved[recommend.Title{ID: item.TMDBId, MediaType: item.MediaType}] = struct{}{}
+	}
+
+	key := userID.String()
+	if cached, found := s.feeds.Get(key); found {
+		return unsaved(cached.([]Recommendation), saved), nil
+	}
+
+	feed, err := s.buildFeed(userID, watchlist, saved)
+	if err != nil {
+		return nil, err
+	}
+	s.feeds.Set(key, feed, cache.DefaultExpiration)
+
+	return feed, nil
+}
+
+// buildFeed ranks candidates against the profile of the user's recent posts
+// and saves.
+func (s *RecommendationService) buildFeed(userID uuid.UUID, watchlist []models.Watchlist, saved map[recommend.Title]struct{}) ([]Recommendation, error) {
+	posts, err := s.postRepo.GetAllByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := newCandidatePool()
+	profile := recommend.BuildProfile(s.seeds(seedTitles(watchlist, posts), pool))
+	if len(profile.Seeds) == 0 {
+		return []Recommendation{}, nil
+	}
+
+	s.discoverCandidates(profile, pool)
+
+	shortlist := profile.Rank(pool.list(), saved, shortlistSize)
+	candidates := make([]recommend.Candidate, len(shortlist))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, recommendationConcurrency)
+	for i := range shortlist {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			c := shortlist[i].Candidate
+			c.Cast, c.Keywords = s.people(c.Title), s.keywords(c.Title)
+			candidates[i] = c
+		}(i)
+	}
+	wg.Wait()
+
+	results := []Recommendation{}
+	for _, scored := range profile.Rank(candidates, saved, recommendationLimit) {
+		results = append(results, Recommendation{
+			Movie:  pool.movies[scored.Title],
+			Score:  scored.Score,
+			Reason: reason(scored.Because),
+			Because: RecommendationSource{
+				TMDBId:    scored.Because.ID,
+				MediaType: scored.Because.MediaType,
+				Title:     scored.Because.Name,
+				Source:    scored.Because.Source,
+			},
+		})
+	}
+
+	return results, nil
+}
+
+// unsaved returns the recommendations whose titles are not in saved.
+func unsaved(feed []Recommendation, saved map[recommend.Title]struct{}) []Recommendation {
+	results := []Recommendation{}
+	for _, r := range feed {
+		if _, ok := saved[recommend.Title{ID: r.ID, MediaType: r.MediaType}]; !ok {
+			results = append(results, r)
+		}
+	}
+
+	return results
+}
+
+// seedTitles lists the titles the profile is built from, posts before saves
+// and newest first, without repeats.
+func seedTitles(watchlist []models.Watchlist, posts []models.Post) []recommend.Seed {
+	var seeds []recommend.Seed
+	seen := map[recommend.Title]struct{}{}
+	add := func(title recommend.Title, source recommend.Source) {
+		if _, ok := seen[title]; ok || len(seeds) == maxRecommendationSeeds {
+			return
+		}
+		seen[title] = struct{}{}
+		seeds = append(seeds, recommend.Seed{Title: title, Source: source})
+	}
+
+	for _, post := range posts {
+		add(recommend.Title{ID: post.TMDBId, MediaType: post.MediaType}, recommend.SourcePost)
+	}
+
+	for _, item := range watchlist {
+		add(recommend.Title{ID: item.TMDBId, MediaType: item.MediaType}, recommend.SourceWatchlist)
+	}
+
+	return seeds
+}
+
+// seeds fetches the features of each seed and adds the titles TMDB
+// recommends for it to the pool. Seeds whose details can't be fetched are
+// dropped.
+func (s *RecommendationService) seeds(seeds []recommend.Seed, pool *candidatePool) []recommend.Seed {
+	found := make([]bool, len(seeds))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, recommendationConcurrency)
+
+	for i := range seeds {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			seed := &seeds[i]
+
+			detail, err := s.tmdb.GetMovieDetails(seed.MediaType, seed.ID)
+			if err != nil {
+				log.Printf("Failed to fetch %s %d for recommendations: %v", seed.MediaType, seed.ID, err)
+
+				return
+			}
+
+			seed.Name = detail.Title
+			if seed.Name == "" {
+				seed.Name = detail.Name
+			}
+			for _, g := range detail.Genres {
+				seed.Genres = append(seed.Genres, g.ID)
+			}
+			seed.Cast, seed.Keywords = s.people(seed.Title), s.keywords(seed.Title)
+			found[i] = true
+
+			recommended, err := s.tmdb.GetRecommendations(seed.MediaType, seed.ID, 1)
+			if err != nil {
+				log.Printf("Failed to fetch recommendations for %s %d: %v", seed.MediaType, seed.ID, err)
+
+				return
+			}
+			pool.add(recommended, &seed.Title)
+		}(i)
+	}
+	wg.Wait()
+
+	var fetched []recommend.Seed
+	for i, seed := range seeds {
+		if found[i] {
+			fetched = append(fetched, seed)
+		}
+	}
+
+	return fetched
+}
+
+// discoverCandidates adds popular titles in the profile's top genres, so the
+// feed isn't limited to what TMDB recommends for single titles.
+func (s *RecommendationService) discoverCandidates(profile recommend.Profile, pool *candidatePool) {
+	var wg sync.WaitGroup
+
+	for _, mediaType := range []string{"movie", "tv"} {
+		genres := profile.TopGenres(mediaType, discoverGenres)
+		if len(genres) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(mediaType string) {
+			defer wg.Done()
+
+			movies, err := s.tmdb.Discover(mediaType, tmdb.DiscoverParams{
+				Genres:       genres,
+				AnyGenre:     true,
+				MinVoteCount: discoverMinVotes,
+				Page:         1,
+			})
+			if err != nil {
+				log.Printf("Failed to discover %s candidates: %v", mediaType, err)
+
+				return
+			}
+			pool.add(movies, nil)
+		}(mediaType)
+	}
+	wg.Wait()
+}
+
+// people returns the leading actors and the directors of a title. Failures
+// leave the title without people rather than failing the feed.
+func (s *RecommendationService) people(title recommend.Title) []int {
+	credits, err := s.tmdb.GetCredits(title.MediaType, title.ID)
+	if err != nil {
+		log.Printf("Failed to fetch credits of %s %d: %v", title.MediaType, title.ID, err)
+
+		return nil
+	}
+
+	var ids []int
+	for _, member := range credits.Cast[:min(seedCastSize, len(credits.Cast))] {
+		ids = append(ids, member.ID)
+	}
+
+	for _, member := range credits.Crew {
+		if member.Job == "Director" {
+			ids = append(ids, member.ID)
+		}
+	}
+
+	return ids
+}
+
+// keywords returns the keyword IDs of a title, or none if they can't be
+// fetched.
+func (s *RecommendationService) keywords(title recommend.Title) []int {
+	keywords, err := s.tmdb.GetKeywords(title.MediaType, title.ID)
+	if err != nil {
+		log.Printf("Failed to fetch keywords of %s %d: %v", title.MediaType, title.ID, err)
+
+		return nil
+	}
+
+	ids := make([]int, len(keywords))
+	for i, k := range keywords {
+		ids[i] = k.ID
+	}
+
+	return ids
+}
+
+func reason(seed recommend.Seed) string {
+	if seed.Source == recommend.SourcePost {
+		return "Because you posted about " + seed.Name
+	}
+
+	return "Because you saved " + seed.Name
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/recommend"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+func TestRecommend(t *testing.T) {
+	userID := uuid.New()
+	pitt := tmdb.CastMember{ID: 287, Name: "Brad Pitt"}
+	fincher := tmdb.CrewMember{ID: 7467, Name: "David Fincher", Job: "Director"}
+
+	env := newTestEnv(t)
+	env.Watchlist.ReturnsWatchlist(userID, []models.Watchlist{
+		{TMDBId: 550, MediaType: "movie", Title: "Fight Club"},
+		{TMDBId: 680, MediaType: "movie", Title: "Pulp Fiction"},
+	})
+	env.Posts.ReturnsUserPosts(userID, []models.Post{{TMDBId: 1399, MediaType: "tv"}})
+
+	// Seeds: both saved movies and the show posted about.
+	env.TMDB.ReturnsDetails("movie", 550, &tmdb.MovieDetail{ID: 550, Title: "Fight Club", Genres: []tmdb.Genre{{ID: 18}, {ID: 53}}})
+	env.TMDB.ReturnsCredits("movie", 550, &tmdb.CreditsResponse{Cast: []tmdb.CastMember{pitt}, Crew: []tmdb.CrewMember{fincher}})
+	env.TMDB.ReturnsKeywords("movie", 550, []tmdb.Keyword{{ID: 1523}})
+	env.TMDB.ReturnsRecommendations("movie", 550, []models.Movie{
+		{ID: 807, MediaType: "movie", Title: "Se7en", GenreIDs: []int{80, 53}},
+		{ID: 680, MediaType: "movie", Title: "Pulp Fiction", GenreIDs: []int{53, 80}},
+	})
+
+	env.TMDB.ReturnsDetails("movie", 680, &tmdb.MovieDetail{ID: 680, Title: "Pulp Fiction", Genres: []tmdb.Genre{{ID: 53}, {ID: 80}}})
+	env.TMDB.ReturnsCredits("movie", 680, &tmdb.CreditsResponse{})
+	env.TMDB.ReturnsKeywords("movie", 680, nil)
+	env.TMDB.On("GetRecommendations", "movie", 680, 1).Return(nil, errors.New("tmdb down"))
+
+	env.TMDB.ReturnsDetails("tv", 1399, &tmdb.MovieDetail{ID: 1399, Name: "Game of Thrones", Genres: []tmdb.Genre{{ID: 10765}}})
+	env.TMDB.ReturnsCredits("tv", 1399, &tmdb.CreditsResponse{})
+	env.TMDB.ReturnsKeywords("tv", 1399, nil)
+	env.TMDB.ReturnsRecommendations("tv", 1399, []models.Movie{
+		{ID: 1402, MediaType: "tv", Title: "The Walking Dead", GenreIDs: []int{18}},
+	})
+
+	// Discover in the top genres of each media type.
+	env.TMDB.DiscoverReturns("movie", tmdb.DiscoverParams{Genres: []int{53, 18, 80}, AnyGenre: true, MinVoteCount: 200, Page: 1}, []models.Movie{
+		{ID: 500, MediaType: "movie", Title: "Reservoir Dogs", GenreIDs: []int{80, 53}},
+	})
+	env.TMDB.DiscoverReturns("tv", tmdb.DiscoverParams{Genres: []int{10765}, AnyGenre: true, MinVoteCount: 200, Page: 1}, []models.Movie{
+		{ID: 1396, MediaType: "tv", Title: "Breaking Bad", GenreIDs: []int{18, 80}},
+	})
+
+	// Shortlisted candidates are scored again with their people and keywords.
+	env.TMDB.ReturnsCredits("movie", 807, &tmdb.CreditsResponse{Cast: []tmdb.CastMember{pitt}, Crew: []tmdb.CrewMember{fincher}})
+	env.TMDB.ReturnsKeywords("movie", 807, nil)
+	env.TMDB.ReturnsCredits("movie", 500, &tmdb.CreditsResponse{})
+	env.TMDB.ReturnsKeywords("movie", 500, nil)
+	env.TMDB.ReturnsCredits("tv", 1402, &tmdb.CreditsResponse{})
+	env.TMDB.ReturnsKeywords("tv", 1402, nil)
+
+	results, err := env.RecommendationService().Recommend(userID)
+	require.NoError(t, err)
+
+	var ids []int
+	for _, r := range results {
+		ids = append(ids, r.ID)
+	}
+	assert.Equal(t, []int{807, 1402, 500}, ids, "saved titles are left out and shows without shared traits dropped")
+
+	assert.Equal(t, "Se7en", results[0].Title)
+	assert.Equal(t, "Because you saved Fight Club", results[0].Reason)
+	assert.Equal(t, recommend.SourceWatchlist, results[0].Because.Source)
+	assert.Equal(t, "Because you posted about Game of Thrones", results[1].Reason)
+	assert.Greater(t, results[0].Score, results[2].Score)
+}
+
+func TestRecommend_CachedPerUser(t *testing.T) {
+	userID := uuid.New()
+	fightClub := models.Watchlist{TMDBId: 550, MediaType: "movie", Title: "Fight Club"}
+
+	env := newTestEnv(t)
+	env.Watchlist.ReturnsWatchlistOnce(userID, []models.Watchlist{fightClub})
+	env.Watchlist.ReturnsWatchlistOnce(userID, []models.Watchlist{fightClub, {TMDBId: 807, MediaType: "movie"}})
+	env.Posts.ReturnsUserPosts(userID, nil)
+	env.TMDB.ReturnsDetails("movie", 550, &tmdb.MovieDetail{ID: 550, Title: "Fight Club", Genres: []tmdb.Genre{{ID: 53}}})
+	env.TMDB.ReturnsCredits("movie", 550, &tmdb.CreditsResponse{})
+	env.TMDB.ReturnsKeywords("movie", 550, nil)
+	env.TMDB.ReturnsRecommendations("movie", 550, []models.Movie{
+		{ID: 807, MediaType: "movie", Title: "Se7en", GenreIDs: []int{53}},
+		{ID: 500, MediaType: "movie", Title: "Reservoir Dogs", GenreIDs: []int{53}},
+	})
+	env.TMDB.DiscoverReturns("movie", tmdb.DiscoverParams{Genres: []int{53}, AnyGenre: true, MinVoteCount: 200, Page: 1}, nil)
+	env.TMDB.ReturnsCredits("movie", 807, &tmdb.CreditsResponse{})
+	env.TMDB.ReturnsKeywords("movie", 807, nil)
+	env.TMDB.ReturnsCredits("movie", 500, &tmdb.CreditsResponse{})
+	env.TMDB.ReturnsKeywords("movie", 500, nil)
+
+	svc := env.RecommendationService()
+
+	first, err := svc.Recommend(userID)
+	require.NoError(t, err)
+	assert.Len(t, first, 2)
+
+	second, err := svc.Recommend(userID)
+	require.NoError(t, err)
+	require.Len(t, second, 1, "titles saved since the feed was built are left out")
+	assert.Equal(t, 500, second[0].ID)
+
+	env.TMDB.AssertNumberOfCalls(t, "GetMovieDetails", 1)
+	env.Posts.AssertNumberOfCalls(t, "GetAllByUserID", 1)
+}
+
+func TestRecommend_NothingSavedOrPosted(t *testing.T) {
+	userID := uuid.New()
+
+	env := newTestEnv(t)
+	env.Watchlist.ReturnsWatchlist(userID, nil)
+	env.Posts.ReturnsUserPosts(userID, nil)
+
+	results, err := env.RecommendationService().Recommend(userID)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestRecommend_WatchlistFails(t *testing.T) {
+	userID := uuid.New()
+
+	env := newTestEnv(t)
+	env.Watchlist.On("GetByUserID", userID).Return(nil, errors.New("db down"))
+
+	_, err := env.RecommendationService().Recommend(userID)
+
+	assert.Error(t, err)
+}
@@ -1,15 +1,21 @@
 package service
 
 import (
-	"encoding/json"
+	"context"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
 
+	"github.com/milansax96/movie-terminal-api/config"
 	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
 	repoMocks "github.com/milansax96/movie-terminal-api/internal/repository/mocks"
+	"github.com/milansax96/movie-terminal-api/internal/signing"
 	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
 	tmdbMocks "github.com/milansax96/movie-terminal-api/pkg/tmdb/mocks"
 )
@@ -17,25 +23,111 @@ import (
 // --- TestEnv ---
 
 type TestEnv struct {
-	TMDB      *TMDBHelper
-	Users     *UserRepoHelper
-	Watchlist *WatchlistRepoHelper
-	Friends   *FriendRepoHelper
-	Posts     *PostRepoHelper
+	TMDB       *TMDBHelper
+	Users      *UserRepoHelper
+	Watchlist  *WatchlistRepoHelper
+	Friends    *FriendRepoHelper
+	Posts      *PostRepoHelper
+	Sessions   *SessionRepoHelper
+	Devices    *DeviceRepoHelper
+	Tokens     *TokenRepoHelper
+	Identities *IdentityRepoHelper
+	Streaming  *StreamingRepoHelper
+	Avail      *AvailabilityRepoHelper
+	Progress   *ProgressRepoHelper
+	Diary      *DiaryRepoHelper
+	Lists      *ListRepoHelper
+	Clock      *FakeClock
+	Notifier   *RecordingNotifier
+	Config     *config.Config
 }
 
 func newTestEnv(t *testing.T) *TestEnv {
 	return &TestEnv{
-		TMDB:      &TMDBHelper{tmdbMocks.NewMockAPI(t)},
-		Users:     &UserRepoHelper{repoMocks.NewMockUserRepository(t)},
-		Watchlist: &WatchlistRepoHelper{repoMocks.NewMockWatchlistRepository(t)},
-		Friends:   &FriendRepoHelper{repoMocks.NewMockFriendshipRepository(t)},
-		Posts:     &PostRepoHelper{repoMocks.NewMockPostRepository(t)},
+		TMDB:       &TMDBHelper{tmdbMocks.NewMockAPI(t)},
+		Users:      &UserRepoHelper{repoMocks.NewMockUserRepository(t)},
+		Watchlist:  &WatchlistRepoHelper{repoMocks.NewMockWatchlistRepository(t)},
+		Friends:    &FriendRepoHelper{repoMocks.NewMockFriendshipRepository(t)},
+		Posts:      &PostRepoHelper{repoMocks.NewMockPostRepository(t)},
+		Sessions:   &SessionRepoHelper{repoMocks.NewMockSessionRepository(t)},
+		Devices:    &DeviceRepoHelper{repoMocks.NewMockDeviceAuthRepository(t)},
+		Tokens:     &TokenRepoHelper{repoMocks.NewMockTokenRepository(t)},
+		Identities: &IdentityRepoHelper{repoMocks.NewMockIdentityRepository(t)},
+		Streaming:  &StreamingRepoHelper{repoMocks.NewMockStreamingServiceRepository(t)},
+		Avail:      &AvailabilityRepoHelper{repoMocks.NewMockAvailabilityRepository(t)},
+		Progress:   &ProgressRepoHelper{repoMocks.NewMockProgressRepository(t)},
+		Diary:      &DiaryRepoHelper{repoMocks.NewMockDiaryRepository(t)},
+		Lists:      &ListRepoHelper{repoMocks.NewMockListRepository(t)},
+		Clock:      newFakeClock(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)),
+		Notifier:   &RecordingNotifier{},
+		Config:     &config.Config{JWTSecret: "test-secret", PublicURL: "http://api.test"},
 	}
 }
 
+func (e *TestEnv) AuthService() *AuthService {
+	return NewAuthService(
+		e.Users.MockUserRepository, e.Sessions.MockSessionRepository, e.Devices.MockDeviceAuthRepository,
+		e.Identities.MockIdentityRepository, signing.NewHMACKeySet(e.Config.JWTSecret), e.Config, NewGoogleProvider(e.Config.GoogleUserInfoURL),
+	)
+}
+
+func (e *TestEnv) TokenService() *TokenService {
+	return NewTokenService(e.Tokens.MockTokenRepository)
+}
+
+func (e *TestEnv) AccountService() *AccountService {
+	return NewAccountService(
+		e.Users.MockUserRepository, e.Identities.MockIdentityRepository, e.Watchlist.MockWatchlistRepository,
+		e.Friends.MockFriendshipRepository, e.Posts.MockPostRepository, e.Progress.MockProgressRepository,
+		e.Diary.MockDiaryRepository, e.Lists.MockListRepository,
+	)
+}
+
+func (e *TestEnv) AdminService() *AdminService {
+	return NewAdminService(e.Users.MockUserRepository, e.Sessions.MockSessionRepository)
+}
+
+func (e *TestEnv) CatalogService() *CatalogService {
+	return NewCatalogService(e.Streaming.MockStreamingServiceRepository)
+}
+
+func (e *TestEnv) TVService() *TVService {
+	return NewTVService(e.TMDB.MockAPI, e.Watchlist.MockWatchlistRepository)
+}
+
+func (e *TestEnv) PersonService() *PersonService {
+	return NewPersonService(e.TMDB.MockAPI)
+}
+
+func (e *TestEnv) RecommendationService() *RecommendationService {
+	return NewRecommendationService(e.TMDB.MockAPI, e.Watchlist.MockWatchlistRepository, e.Posts.MockPostRepository)
+}
+
+func (e *TestEnv) AvailabilityService(regions ...string) *AvailabilityService {
+	return NewAvailabilityService(
+		e.TMDB.MockAPI, e.Avail.MockAvailabilityRepository, e.Watchlist.MockWatchlistRepository,
+		e.Users.MockUserRepository, e.Notifier, e.Clock, regions,
+	)
+}
+
+func (e *TestEnv) ProgressService() *ProgressService {
+	return NewProgressService(e.TMDB.MockAPI, e.Watchlist.MockWatchlistRepository, e.Progress.MockProgressRepository, e.Clock)
+}
+
+func (e *TestEnv) DiaryService() *DiaryService {
+	return NewDiaryService(e.Diary.MockDiaryRepository, e.Clock)
+}
+
+func (e *TestEnv) ListService() *ListService {
+	return NewListService(e.Lists.MockListRepository, e.Friends.MockFriendshipRepository)
+}
+
 func (e *TestEnv) MovieService() *MovieService {
-	return NewMovieService(e.TMDB.MockAPI, e.Watchlist.MockWatchlistRepository, "")
+	return NewMovieService(e.TMDB.MockAPI, e.Watchlist.MockWatchlistRepository, e.Users.MockUserRepository, "", []string{"US", "GB"})
+}
+
+func (e *TestEnv) WatchlistDetailsService() *WatchlistDetailsService {
+	return NewWatchlistDetailsService(e.TMDB.MockAPI, e.Watchlist.MockWatchlistRepository, e.Clock)
 }
 
 func (e *TestEnv) UserService() *UserService {
@@ -76,6 +168,10 @@ func (h *TMDBHelper) ReturnsGenre(genreID, page int, movies []models.Movie) {
 	h.On("DiscoverByGenre", genreID, page).Return(movies, nil)
 }
 
+func (h *TMDBHelper) DiscoverReturns(mediaType string, params tmdb.DiscoverParams, movies []models.Movie) {
+	h.On("Discover", mediaType, params).Return(movies, nil)
+}
+
 func (h *TMDBHelper) SearchReturns(query string, page int, movies []models.Movie) {
 	h.On("SearchMovies", query, page).Return(movies, nil)
 }
@@ -88,14 +184,70 @@ func (h *TMDBHelper) ReturnsVideos(mediaType string, id int, videos []tmdb.Video
 	h.On("GetVideos", mediaType, id).Return(videos, nil)
 }
 
+func (h *TMDBHelper) DetailsFail(mediaType string, id int, err error) {
+	h.On("GetMovieDetails", mediaType, id).Return((*tmdb.MovieDetail)(nil), err)
+}
+
 func (h *TMDBHelper) ReturnsCredits(mediaType string, id int, credits *tmdb.CreditsResponse) {
 	h.On("GetCredits", mediaType, id).Return(credits, nil)
 }
 
-func (h *TMDBHelper) ReturnsProviders(mediaType string, id int, providers json.RawMessage) {
+func (h *TMDBHelper) ReturnsKeywords(mediaType string, id int, keywords []tmdb.Keyword) {
+	h.On("GetKeywords", mediaType, id).Return(keywords, nil)
+}
+
+func (h *TMDBHelper) ReturnsRecommendations(mediaType string, id int, movies []models.Movie) {
+	h.On("GetRecommendations", mediaType, id, 1).Return(movies, nil)
+}
+
+func (h *TMDBHelper) ReturnsProviders(mediaType string, id int, providers *tmdb.WatchProvidersResponse) {
 	h.On("GetProviders", mediaType, id).Return(providers, nil)
 }
 
+func (h *TMDBHelper) ReturnsGenreList(mediaType string, genres []tmdb.Genre) {
+	h.On("GetGenres", mediaType).Return(genres, nil)
+}
+
+func (h *TMDBHelper) GenreListFails(mediaType string, err error) {
+	h.On("GetGenres", mediaType).Return([]tmdb.Genre(nil), err)
+}
+
+func (h *TMDBHelper) ReturnsTVList(category string, page int, shows []models.Movie) {
+	h.On("GetTVList", category, page).Return(shows, nil)
+}
+
+func (h *TMDBHelper) ReturnsShow(show *models.TVShow) {
+	h.On("GetTVDetails", show.ID).Return(show, nil)
+}
+
+func (h *TMDBHelper) ShowFails(id int, err error) {
+	h.On("GetTVDetails", id).Return((*models.TVShow)(nil), err)
+}
+
+func (h *TMDBHelper) ReturnsSeason(season *models.Season) {
+	h.On("GetSeason", season.ShowID, season.SeasonNumber).Return(season, nil)
+}
+
+func (h *TMDBHelper) SeasonFails(tvID int, seasonNumber int, err error) {
+	h.On("GetSeason", tvID, seasonNumber).Return((*models.Season)(nil), err)
+}
+
+func (h *TMDBHelper) ReturnsPersonCredits(id int, credits *models.PersonCredits) {
+	h.On("GetPersonCredits", id).Return(credits, nil)
+}
+
+func (h *TMDBHelper) PersonFails(id int, err error) {
+	h.On("GetPerson", id).Return((*models.Person)(nil), err)
+}
+
+func (h *TMDBHelper) ReturnsEpisode(episode *models.Episode) {
+	h.On("GetEpisode", episode.ShowID, episode.SeasonNumber, episode.EpisodeNumber).Return(episode, nil)
+}
+
+func (h *TMDBHelper) ProvidersFail(mediaType string, id int, err error) {
+	h.On("GetProviders", mediaType, id).Return((*tmdb.WatchProvidersResponse)(nil), err)
+}
+
 func (h *TMDBHelper) NowPlayingFails(page int, err error) {
 	h.On("GetNowPlaying", page).Return([]models.Movie(nil), err)
 }
@@ -108,6 +260,11 @@ func (h *TMDBHelper) SearchFails(query string, page int, err error) {
 	h.On("SearchMovies", query, page).Return([]models.Movie(nil), err)
 }
 
+var (
+	movieGenres = []tmdb.Genre{{ID: 28, Name: "Action"}, {ID: 35, Name: "Comedy"}, {ID: 18, Name: "Drama"}, {ID: 878, Name: "Science Fiction"}}
+	tvGenres    = []tmdb.Genre{{ID: 35, Name: "Comedy"}, {ID: 18, Name: "Drama"}, {ID: 10765, Name: "Sci-Fi & Fantasy"}}
+)
+
 // --- WatchlistRepoHelper ---
 
 type WatchlistRepoHelper struct {
@@ -126,6 +283,46 @@ func (h *WatchlistRepoHelper) ReturnsWatchlist(userID uuid.UUID, items []models.
 	h.On("GetByUserID", userID).Return(items, nil)
 }
 
+func (h *WatchlistRepoHelper) ReturnsWatchlistOnce(userID uuid.UUID, items []models.Watchlist) {
+	h.On("GetByUserID", userID).Return(items, nil).Once()
+}
+
+// SavesAmong answers one membership check of the user for exactly the IDs on
+// page, in any order, with saved on the watchlist.
+func (h *WatchlistRepoHelper) SavesAmong(userID uuid.UUID, page []int, saved ...int) {
+	found := make(map[int]bool, len(saved))
+	for _, id := range saved {
+		found[id] = true
+	}
+	h.On("SavedAmong", userID, sameIDs(page)).Return(found, nil).Once()
+}
+
+func (h *WatchlistRepoHelper) SavedAmongFails(userID uuid.UUID, page []int, err error) {
+	h.On("SavedAmong", userID, sameIDs(page)).Return(nil, err).Once()
+}
+
+// sameIDs matches a list holding the same IDs as want, in any order and
+// without repeats.
+func sameIDs(want []int) any {
+	want = slices.Sorted(slices.Values(want))
+
+	return mock.MatchedBy(func(ids []int) bool {
+		return slices.Equal(want, slices.Sorted(slices.Values(ids)))
+	})
+}
+
+func (h *WatchlistRepoHelper) HasUnsyncedTitles(titles []models.Watchlist) {
+	h.On("UnsyncedTitles").Return(titles, nil)
+}
+
+func (h *WatchlistRepoHelper) SyncsDetails(tmdbID int, mediaType string, details *models.Watchlist) {
+	h.On("SyncDetails", tmdbID, mediaType, details).Return(nil)
+}
+
+func (h *WatchlistRepoHelper) ReturnsPage(userID uuid.UUID, query repository.WatchlistQuery, items []models.Watchlist) {
+	h.On("Page", userID, query).Return(items, nil)
+}
+
 func (h *WatchlistRepoHelper) RemovesItem(userID uuid.UUID, tmdbID int) {
 	h.On("Remove", userID, tmdbID).Return(int64(1), nil)
 }
@@ -134,6 +331,14 @@ func (h *WatchlistRepoHelper) ItemNotFound(userID uuid.UUID, tmdbID int) {
 	h.On("Remove", userID, tmdbID).Return(int64(0), nil)
 }
 
+func (h *WatchlistRepoHelper) FindsItem(item *models.Watchlist) {
+	h.On("Find", item.UserID, item.TMDBId).Return(item, nil)
+}
+
+func (h *WatchlistRepoHelper) FindFails(userID uuid.UUID, tmdbID int, err error) {
+	h.On("Find", userID, tmdbID).Return((*models.Watchlist)(nil), err)
+}
+
 func (h *WatchlistRepoHelper) ItemExists(userID uuid.UUID, tmdbID int, exists bool) {
 	h.On("Exists", userID, tmdbID).Return(exists, nil)
 }
@@ -152,6 +357,18 @@ func (h *UserRepoHelper) UserNotFound(userID uuid.UUID) {
 	h.On("FindByIDWithStreaming", userID).Return((*models.User)(nil), gorm.ErrRecordNotFound)
 }
 
+func (h *UserRepoHelper) FindUserFails(userID uuid.UUID, err error) {
+	h.On("FindByIDWithStreaming", userID).Return((*models.User)(nil), err)
+}
+
+func (h *UserRepoHelper) FindsByID(userID uuid.UUID, user *models.User) {
+	h.On("FindByID", userID).Return(user, nil)
+}
+
+func (h *UserRepoHelper) ByIDNotFound(userID uuid.UUID) {
+	h.On("FindByID", userID).Return((*models.User)(nil), gorm.ErrRecordNotFound)
+}
+
 func (h *UserRepoHelper) FindsStreamingServices(ids []int, services []models.StreamingService) {
 	h.On("FindStreamingServicesByIDs", ids).Return(services, nil)
 }
@@ -160,6 +377,18 @@ func (h *UserRepoHelper) ReplacesStreamingServices(userID uuid.UUID) {
 	h.On("ReplaceStreamingServices", userID, mock.AnythingOfType("[]models.StreamingService")).Return(nil)
 }
 
+func (h *UserRepoHelper) EmailExists(email string, exists bool) {
+	h.On("EmailExists", email).Return(exists, nil)
+}
+
+func (h *UserRepoHelper) HandlesTaken(taken ...string) {
+	h.On("TakenUsernames", mock.AnythingOfType("[]string")).Return(taken, nil)
+}
+
+func (h *UserRepoHelper) UpdatesProfile() {
+	h.On("UpdateProfile", mock.AnythingOfType("*models.User")).Return(nil)
+}
+
 func (h *UserRepoHelper) SearchReturns(query string, users []models.User) {
 	h.On("SearchByUsername", query, 20).Return(users, nil)
 }
@@ -201,6 +430,382 @@ func (h *PostRepoHelper) CreatesPost() {
 	h.On("Create", mock.AnythingOfType("*models.Post")).Return(nil)
 }
 
+func (h *PostRepoHelper) ReturnsUserPosts(userID uuid.UUID, posts []models.Post) {
+	h.On("GetAllByUserID", userID).Return(posts, nil)
+}
+
 func (h *PostRepoHelper) ReturnsPosts(posts []models.Post) {
 	h.On("GetByUserIDs", mock.Anything, 50).Return(posts, nil)
 }
+
+// --- StreamingRepoHelper ---
+
+type StreamingRepoHelper struct {
+	*repoMocks.MockStreamingServiceRepository
+}
+
+func (h *StreamingRepoHelper) FindsService(service *models.StreamingService) {
+	h.On("FindByID", service.ID).Return(service, nil)
+}
+
+func (h *StreamingRepoHelper) ServiceNotFound(id int) {
+	h.On("FindByID", id).Return((*models.StreamingService)(nil), gorm.ErrRecordNotFound)
+}
+
+func (h *StreamingRepoHelper) CreatesService(err error) {
+	h.On("Create", mock.AnythingOfType("*models.StreamingService")).Return(err)
+}
+
+func (h *StreamingRepoHelper) UpdatesService(err error) {
+	h.On("Update", mock.AnythingOfType("*models.StreamingService")).Return(err)
+}
+
+func (h *StreamingRepoHelper) DeletesService(id int, deleted int64) {
+	h.On("Delete", id).Return(deleted, nil)
+}
+
+// --- AvailabilityRepoHelper ---
+
+type AvailabilityRepoHelper struct {
+	*repoMocks.MockAvailabilityRepository
+}
+
+// RecordedCheck captures what the tracker stored for a title.
+type RecordedCheck struct {
+	Title   models.TrackedTitle
+	Added   []models.TitleAvailability
+	Removed []models.TitleAvailability
+	Changes []models.AvailabilityChange
+}
+
+func (h *AvailabilityRepoHelper) TracksTitles(titles []models.TrackedTitle) {
+	h.On("ListTrackedTitles").Return(titles, nil)
+}
+
+func (h *AvailabilityRepoHelper) HasAvailability(tmdbID int, mediaType string, rows []models.TitleAvailability) {
+	h.On("ListByTitle", tmdbID, mediaType).Return(rows, nil)
+}
+
+func (h *AvailabilityRepoHelper) ReturnsForUser(userID uuid.UUID, region string, rows []models.TitleAvailability) {
+	h.On("ListForUser", userID, region).Return(rows, nil)
+}
+
+func (h *AvailabilityRepoHelper) FindsWatchers(tmdbID int, mediaType string, users []models.User) {
+	h.On("FindWatchers", tmdbID, mediaType).Return(users, nil)
+}
+
+// Records stores each Record call in checks, keyed by TMDB ID.
+func (h *AvailabilityRepoHelper) Records(checks map[int]*RecordedCheck) {
+	h.On("Record", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			title := *args.Get(0).(*models.TrackedTitle)
+			checks[title.TMDBId] = &RecordedCheck{
+				Title:   title,
+				Added:   args.Get(1).([]models.TitleAvailability),
+				Removed: args.Get(2).([]models.TitleAvailability),
+				Changes: args.Get(3).([]models.AvailabilityChange),
+			}
+		}).
+		Return(nil)
+}
+
+// --- ProgressRepoHelper ---
+
+type ProgressRepoHelper struct {
+	*repoMocks.MockProgressRepository
+}
+
+func (h *ProgressRepoHelper) ReturnsForItem(watchlistID uuid.UUID, entries []models.EpisodeProgress) {
+	h.On("ListByItem", watchlistID).Return(entries, nil)
+}
+
+func (h *ProgressRepoHelper) ReturnsForUser(userID uuid.UUID, entries []models.EpisodeProgress) {
+	h.On("ListByUserID", userID).Return(entries, nil)
+}
+
+// MarksWatched appends the entries of each MarkWatched call to marked.
+func (h *ProgressRepoHelper) MarksWatched(marked *[]models.EpisodeProgress) {
+	h.On("MarkWatched", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*marked = append(*marked, args.Get(0).([]models.EpisodeProgress)...)
+		}).
+		Return(nil)
+}
+
+// --- DiaryRepoHelper ---
+
+type DiaryRepoHelper struct {
+	*repoMocks.MockDiaryRepository
+}
+
+func (h *DiaryRepoHelper) ReturnsForUser(userID uuid.UUID, entries []models.DiaryEntry) {
+	h.On("ListByUserID", userID).Return(entries, nil)
+}
+
+func (h *DiaryRepoHelper) Finds(entry *models.DiaryEntry) {
+	h.On("Find", entry.UserID, entry.ID).Return(entry, nil)
+}
+
+func (h *DiaryRepoHelper) NotFound(userID uuid.UUID, id uuid.UUID) {
+	h.On("Find", userID, id).Return(nil, gorm.ErrRecordNotFound)
+}
+
+func (h *DiaryRepoHelper) HasWatched(userID uuid.UUID, tmdbID int, mediaType string, on time.Time, watched bool) {
+	h.On("HasWatched", userID, tmdbID, mediaType, on).Return(watched, nil)
+}
+
+// Creates captures the created entry and reports removed as the outcome of
+// taking the title off the watchlist.
+func (h *DiaryRepoHelper) Creates(created **models.DiaryEntry, removeFromWatchlist bool, removed bool) {
+	h.On("Create", mock.AnythingOfType("*models.DiaryEntry"), removeFromWatchlist).
+		Run(func(args mock.Arguments) {
+			*created = args.Get(0).(*models.DiaryEntry)
+		}).
+		Return(removed, nil)
+}
+
+// --- ListRepoHelper ---
+
+type ListRepoHelper struct {
+	*repoMocks.MockListRepository
+}
+
+func (h *ListRepoHelper) Finds(list *models.List) {
+	h.On("Find", list.ID).Return(list, nil)
+}
+
+func (h *ListRepoHelper) NotFound(id uuid.UUID) {
+	h.On("Find", id).Return(nil, gorm.ErrRecordNotFound)
+}
+
+// --- FakeClock ---
+
+// FakeClock is a Clock whose time only moves when the test advances it.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+	waiting chan struct{}
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func newFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now, waiting: make(chan struct{}, 100)}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	c.waiters = append(c.waiters, fakeWaiter{deadline: c.now.Add(d), ch: ch})
+	c.waiting <- struct{}{}
+
+	return ch
+}
+
+// Advance moves the clock forward and fires the waiters that are due.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.deadline.After(c.now) {
+			pending = append(pending, w)
+
+			continue
+		}
+		w.ch <- c.now
+	}
+	c.waiters = pending
+}
+
+// Pending returns the number of waiters that have not fired.
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.waiters)
+}
+
+// BlockUntilWaiting returns once After has been called.
+func (c *FakeClock) BlockUntilWaiting(t *testing.T) {
+	t.Helper()
+
+	select {
+	case <-c.waiting:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the clock to be waited on")
+	}
+}
+
+// --- RecordingNotifier ---
+
+type RecordingNotifier struct {
+	mu     sync.Mutex
+	Events []AvailabilityEvent
+}
+
+func (n *RecordingNotifier) NotifyAvailable(_ context.Context, event AvailabilityEvent) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.Events = append(n.Events, event)
+
+	return nil
+}
+
+// --- SessionRepoHelper ---
+
+type SessionRepoHelper struct {
+	*repoMocks.MockSessionRepository
+}
+
+func (h *SessionRepoHelper) FindsSession(session *models.Session) {
+	h.On("FindByID", session.ID).Return(session, nil)
+}
+
+func (h *SessionRepoHelper) SessionNotFound(sessionID uuid.UUID) {
+	h.On("FindByID", sessionID).Return((*models.Session)(nil), gorm.ErrRecordNotFound)
+}
+
+func (h *SessionRepoHelper) ChecksActive(sessionID uuid.UUID, active bool) {
+	h.On("IsActive", sessionID, mock.AnythingOfType("time.Time")).Return(active, nil)
+}
+
+func (h *SessionRepoHelper) RevokesAllForUser(userID uuid.UUID, revoked int64) {
+	h.On("RevokeAllForUser", userID, mock.AnythingOfType("time.Time")).Return(revoked, nil)
+}
+
+func (h *SessionRepoHelper) ListsSessions(userID uuid.UUID, sessions []models.Session) {
+	h.On("ListActiveByUserID", userID, mock.AnythingOfType("time.Time")).Return(sessions, nil)
+}
+
+func (h *SessionRepoHelper) CreatesSession() {
+	h.On("Create", mock.AnythingOfType("*models.Session")).Return(nil)
+}
+
+func (h *SessionRepoHelper) TouchesSession(sessionID uuid.UUID) {
+	h.On("Touch", sessionID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(nil)
+}
+
+func (h *SessionRepoHelper) RevokesSession(sessionID uuid.UUID) {
+	h.On("Revoke", sessionID, mock.AnythingOfType("time.Time")).Return(nil)
+}
+
+func (h *SessionRepoHelper) FindsRefreshToken(raw string, token *models.RefreshToken) {
+	h.On("FindRefreshToken", hashToken(raw)).Return(token, nil)
+}
+
+func (h *SessionRepoHelper) RefreshTokenNotFound(raw string) {
+	h.On("FindRefreshToken", hashToken(raw)).Return((*models.RefreshToken)(nil), gorm.ErrRecordNotFound)
+}
+
+func (h *SessionRepoHelper) MarksTokenUsed(tokenID uuid.UUID, fresh bool) {
+	h.On("MarkRefreshTokenUsed", tokenID, mock.AnythingOfType("time.Time")).Return(fresh, nil)
+}
+
+func (h *SessionRepoHelper) CreatesRefreshToken() {
+	h.On("CreateRefreshToken", mock.AnythingOfType("*models.RefreshToken")).Return(nil)
+}
+
+func activeSession(userID uuid.UUID) *models.Session {
+	return &models.Session{ID: uuid.New(), UserID: userID, ExpiresAt: time.Now().Add(time.Hour)}
+}
+
+// --- DeviceRepoHelper ---
+
+type DeviceRepoHelper struct {
+	*repoMocks.MockDeviceAuthRepository
+}
+
+func (h *DeviceRepoHelper) FindsByDeviceCode(raw string, auth *models.DeviceAuthorization) {
+	h.On("FindByDeviceCodeHash", hashToken(raw)).Return(auth, nil)
+}
+
+func (h *DeviceRepoHelper) DeviceCodeNotFound(raw string) {
+	h.On("FindByDeviceCodeHash", hashToken(raw)).Return((*models.DeviceAuthorization)(nil), gorm.ErrRecordNotFound)
+}
+
+func (h *DeviceRepoHelper) MarksPolled(id uuid.UUID) {
+	h.On("MarkPolled", id, mock.AnythingOfType("time.Time")).Return(nil)
+}
+
+func (h *DeviceRepoHelper) UserCodeNotFound(userCode string) {
+	h.On("FindPendingByUserCode", userCode, mock.AnythingOfType("time.Time")).
+		Return((*models.DeviceAuthorization)(nil), gorm.ErrRecordNotFound)
+}
+
+// --- TokenRepoHelper ---
+
+type TokenRepoHelper struct {
+	*repoMocks.MockTokenRepository
+}
+
+func (h *TokenRepoHelper) CreatesToken() {
+	h.On("Create", mock.AnythingOfType("*models.PersonalAccessToken")).Return(nil)
+}
+
+func (h *TokenRepoHelper) FindsToken(raw string, token *models.PersonalAccessToken) {
+	h.On("FindByHash", hashToken(raw)).Return(token, nil)
+}
+
+func (h *TokenRepoHelper) TokenNotFound(raw string) {
+	h.On("FindByHash", hashToken(raw)).Return((*models.PersonalAccessToken)(nil), gorm.ErrRecordNotFound)
+}
+
+func (h *TokenRepoHelper) TouchesToken(tokenID uuid.UUID) {
+	h.On("TouchLastUsed", tokenID, mock.AnythingOfType("time.Time")).Return(nil)
+}
+
+func (h *TokenRepoHelper) RevokesToken(userID, tokenID uuid.UUID, rows int64) {
+	h.On("Revoke", userID, tokenID, mock.AnythingOfType("time.Time")).Return(rows, nil)
+}
+
+// --- IdentityRepoHelper ---
+
+type IdentityRepoHelper struct {
+	*repoMocks.MockIdentityRepository
+}
+
+func (h *IdentityRepoHelper) FindsIdentity(identity *models.UserIdentity) {
+	h.On("FindByProviderSubject", identity.Provider, identity.Subject).Return(identity, nil)
+}
+
+func (h *IdentityRepoHelper) IdentityNotFound(provider, subject string) {
+	h.On("FindByProviderSubject", provider, subject).Return((*models.UserIdentity)(nil), gorm.ErrRecordNotFound)
+}
+
+func (h *IdentityRepoHelper) SignsUp(userID uuid.UUID) {
+	h.On("CreateUserWithIdentity", mock.AnythingOfType("*models.User"), mock.AnythingOfType("*models.UserIdentity")).
+		Run(func(args mock.Arguments) {
+			args.Get(0).(*models.User).ID = userID
+			args.Get(1).(*models.UserIdentity).UserID = userID
+		}).Return(nil)
+}
+
+func (h *IdentityRepoHelper) CreatesIdentity() {
+	h.On("Create", mock.AnythingOfType("*models.UserIdentity")).Return(nil)
+}
+
+func (h *IdentityRepoHelper) ListsIdentities(userID uuid.UUID, identities []models.UserIdentity) {
+	h.On("ListByUserID", userID).Return(identities, nil)
+}
+
+func (h *IdentityRepoHelper) DeletesIdentity(userID, identityID uuid.UUID, rows int64) {
+	h.On("DeleteUnlessLast", userID, identityID).Return(rows, nil)
+}
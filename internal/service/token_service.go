@@ -0,0 +1,132 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/milansax96/movie-terminal-api/internal/middleware"
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+)
+
+// Personal access token limits.
+const (
+	maxTokenNameLength = 100
+	maxTokenLifetime   = 365 * 24 * time.Hour
+
+	// tokenTouchInterval throttles last-used bookkeeping to one write per token per minute.
+	tokenTouchInterval = time.Minute
+
+	// tokenPrefixLength is how much of the token is kept in clear for display.
+	tokenPrefixLength = len(middleware.PersonalTokenPrefix) + 8
+)
+
+// TokenService manages personal access tokens for scripts and CI.
+type TokenService struct {
+	tokenRepo repository.TokenRepository
+}
+
+// NewTokenService creates a new TokenService.
+func NewTokenService(tokenRepo repository.TokenRepository) *TokenService {
+	return &TokenService{tokenRepo: tokenRepo}
+}
+
+// CreateToken mints a new personal access token. The plaintext token is only
+// available on the returned value; afterwards only its hash is kept.
+// A zero expiresInDays creates a token that never expires.
+func (s *TokenService) CreateToken(userID uuid.UUID, name string, scopes []string, expiresInDays int) (*models.PersonalAccessToken, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxTokenNameLength {
+		return nil, ErrInvalidInput
+	}
+
+	if len(scopes) == 0 {
+		return nil, ErrInvalidScope
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(models.TokenScopes, scope) {
+			return nil, ErrInvalidScope
+		}
+	}
+
+	lifetime := time.Duration(expiresInDays) * 24 * time.Hour
+	if expiresInDays < 0 || lifetime > maxTokenLifetime {
+		return nil, ErrInvalidInput
+	}
+
+	secret, err := generateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	raw := middleware.PersonalTokenPrefix + secret
+
+	token := &models.PersonalAccessToken{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    raw[:tokenPrefixLength],
+		TokenHash: hashToken(raw),
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+	}
+
+	if expiresInDays > 0 {
+		expiresAt := time.Now().Add(lifetime)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := s.tokenRepo.Create(token); err != nil {
+		return nil, err
+	}
+
+	token.Token = raw
+
+	return token, nil
+}
+
+// ListTokens returns the user's tokens that have not been revoked.
+func (s *TokenService) ListTokens(userID uuid.UUID) ([]models.PersonalAccessToken, error) {
+	return s.tokenRepo.ListActiveByUserID(userID)
+}
+
+// RevokeToken permanently disables one of the user's tokens.
+func (s *TokenService) RevokeToken(userID uuid.UUID, tokenID uuid.UUID) error {
+	rows, err := s.tokenRepo.Revoke(userID, tokenID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ValidatePersonalToken resolves a bearer token to its owner and scopes.
+// It satisfies middleware.PersonalTokenValidator.
+func (s *TokenService) ValidatePersonalToken(raw string) (*middleware.TokenPrincipal, error) {
+	token, err := s.tokenRepo.FindByHash(hashToken(raw))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !token.IsActive(now) {
+		return nil, nil
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > tokenTouchInterval {
+		if err := s.tokenRepo.TouchLastUsed(token.ID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	return &middleware.TokenPrincipal{UserID: token.UserID.String(), Scopes: token.Scopes}, nil
+}
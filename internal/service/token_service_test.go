@@ -0,0 +1,140 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+func TestCreateToken(t *testing.T) {
+	tests := map[string]struct {
+		name   string
+		scopes []string
+		days   int
+		setup  func(*TestEnv)
+		err    error
+		check  func(*testing.T, *models.PersonalAccessToken)
+	}{
+		"success": {"cron", []string{"watchlist:write", "watchlist:read", "watchlist:read"}, 90, func(env *TestEnv) {
+			env.Tokens.CreatesToken()
+		}, nil, func(t *testing.T, token *models.PersonalAccessToken) {
+			assert.True(t, strings.HasPrefix(token.Token, "mtp_"))
+			assert.True(t, strings.HasPrefix(token.Token, token.Prefix))
+			assert.Equal(t, hashToken(token.Token), token.TokenHash)
+			assert.Equal(t, []string{"watchlist:read", "watchlist:write"}, token.Scopes)
+			require.NotNil(t, token.ExpiresAt)
+			assert.WithinDuration(t, time.Now().Add(90*24*time.Hour), *token.ExpiresAt, time.Minute)
+		}},
+		"no expiry": {"ci", []string{"movies:read"}, 0, func(env *TestEnv) {
+			env.Tokens.CreatesToken()
+		}, nil, func(t *testing.T, token *models.PersonalAccessToken) {
+			assert.Nil(t, token.ExpiresAt)
+		}},
+		"unknown scope": {"cron", []string{"admin"}, 0, func(_ *TestEnv) {}, ErrInvalidScope, nil},
+		"no scopes":     {"cron", nil, 0, func(_ *TestEnv) {}, ErrInvalidScope, nil},
+		"blank name":    {"  ", []string{"movies:read"}, 0, func(_ *TestEnv) {}, ErrInvalidInput, nil},
+		"too long":      {"cron", []string{"movies:read"}, 400, func(_ *TestEnv) {}, ErrInvalidInput, nil},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			tt.setup(env)
+
+			token, err := env.TokenService().CreateToken(uuid.New(), tt.name, tt.scopes, tt.days)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			if tt.check != nil {
+				tt.check(t, token)
+			}
+		})
+	}
+}
+
+func TestValidatePersonalToken(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	recent := time.Now().Add(-time.Second)
+
+	tests := map[string]struct {
+		setup func(*TestEnv, *models.PersonalAccessToken)
+		valid bool
+	}{
+		"valid records use": {func(env *TestEnv, token *models.PersonalAccessToken) {
+			env.Tokens.FindsToken("mtp_raw", token)
+			env.Tokens.TouchesToken(token.ID)
+		}, true},
+		"recently used skips write": {func(env *TestEnv, token *models.PersonalAccessToken) {
+			token.LastUsedAt = &recent
+			env.Tokens.FindsToken("mtp_raw", token)
+		}, true},
+		"expired": {func(env *TestEnv, token *models.PersonalAccessToken) {
+			token.ExpiresAt = &past
+			env.Tokens.FindsToken("mtp_raw", token)
+		}, false},
+		"revoked": {func(env *TestEnv, token *models.PersonalAccessToken) {
+			token.RevokedAt = &past
+			env.Tokens.FindsToken("mtp_raw", token)
+		}, false},
+		"unknown": {func(env *TestEnv, _ *models.PersonalAccessToken) {
+			env.Tokens.TokenNotFound("mtp_raw")
+		}, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			token := &models.PersonalAccessToken{ID: uuid.New(), UserID: uuid.New(), Scopes: []string{"movies:read"}}
+			tt.setup(env, token)
+
+			principal, err := env.TokenService().ValidatePersonalToken("mtp_raw")
+			require.NoError(t, err)
+
+			if !tt.valid {
+				assert.Nil(t, principal)
+
+				return
+			}
+			require.NotNil(t, principal)
+			assert.Equal(t, token.UserID.String(), principal.UserID)
+			assert.Equal(t, token.Scopes, principal.Scopes)
+		})
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	tests := map[string]struct {
+		rows int64
+		err  error
+	}{
+		"success":   {1, nil},
+		"not found": {0, ErrNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			userID, tokenID := uuid.New(), uuid.New()
+			env.Tokens.RevokesToken(userID, tokenID, tt.rows)
+
+			err := env.TokenService().RevokeToken(userID, tokenID)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
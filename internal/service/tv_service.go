@@ -0,0 +1,72 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+// TVService handles TV show, season and episode details.
+type TVService struct {
+	tmdb          tmdb.API
+	watchlistRepo repository.WatchlistRepository
+}
+
+// NewTVService creates a new TVService.
+func NewTVService(tmdbClient tmdb.API, watchlistRepo repository.WatchlistRepository) *TVService {
+	return &TVService{
+		tmdb:          tmdbClient,
+		watchlistRepo: watchlistRepo,
+	}
+}
+
+// GetShow returns a TV show, flagged if it is on the user's watchlist.
+func (s *TVService) GetShow(userID uuid.UUID, id int) (*models.TVShow, error) {
+	show, err := s.tmdb.GetTVDetails(id)
+	if err != nil {
+		return nil, tmdbError(err)
+	}
+
+	if userID != uuid.Nil {
+		// Fail silently on enrichment, as with discover results.
+		saved, _ := s.watchlistRepo.Exists(userID, id)
+		result := *show
+		result.IsWatchlisted = saved
+		show = &result
+	}
+
+	return show, nil
+}
+
+// GetSeason returns a season of a TV show with its episodes.
+func (s *TVService) GetSeason(id int, seasonNumber int) (*models.Season, error) {
+	season, err := s.tmdb.GetSeason(id, seasonNumber)
+	if err != nil {
+		return nil, tmdbError(err)
+	}
+
+	return season, nil
+}
+
+// GetEpisode returns a single episode of a TV show.
+func (s *TVService) GetEpisode(id int, seasonNumber int, episodeNumber int) (*models.Episode, error) {
+	episode, err := s.tmdb.GetEpisode(id, seasonNumber, episodeNumber)
+	if err != nil {
+		return nil, tmdbError(err)
+	}
+
+	return episode, nil
+}
+
+// tmdbError maps TMDB's not found onto ErrNotFound.
+func tmdbError(err error) error {
+	if errors.Is(err, tmdb.ErrNotFound) {
+		return ErrNotFound
+	}
+
+	return err
+}
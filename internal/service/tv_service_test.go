@@ -0,0 +1,122 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+func TestGetShow(t *testing.T) {
+	userID := uuid.New()
+	cached := &models.TVShow{ID: 1399, Name: "Game of Thrones", Status: "Ended"}
+
+	env := newTestEnv(t)
+	env.TMDB.ReturnsShow(cached)
+	env.Watchlist.ItemExists(userID, 1399, true)
+
+	show, err := env.TVService().GetShow(userID, 1399)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Game of Thrones", show.Name)
+	assert.True(t, show.IsWatchlisted)
+	assert.False(t, cached.IsWatchlisted, "the cached show is shared between users")
+}
+
+func TestGetShow_NotFound(t *testing.T) {
+	env := newTestEnv(t)
+	env.TMDB.ShowFails(1, tmdb.ErrNotFound)
+
+	_, err := env.TVService().GetShow(uuid.New(), 1)
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetSeason(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.TMDB.ReturnsSeason(&models.Season{ShowID: 1399, SeasonNumber: 1, Episodes: []models.Episode{{EpisodeNumber: 1}}})
+
+		season, err := env.TVService().GetSeason(1399, 1)
+		require.NoError(t, err)
+		assert.Len(t, season.Episodes, 1)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.TMDB.SeasonFails(1399, 9, tmdb.ErrNotFound)
+
+		_, err := env.TVService().GetSeason(1399, 9)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("upstream failure", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.TMDB.SeasonFails(1399, 1, errors.New("tmdb down"))
+
+		_, err := env.TVService().GetSeason(1399, 1)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestGetEpisode(t *testing.T) {
+	env := newTestEnv(t)
+	env.TMDB.ReturnsEpisode(&models.Episode{ShowID: 1399, SeasonNumber: 1, EpisodeNumber: 1, Name: "Winter Is Coming"})
+
+	episode, err := env.TVService().GetEpisode(1399, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Winter Is Coming", episode.Name)
+}
+
+func TestDiscoverTV(t *testing.T) {
+	tests := map[string]struct {
+		category string
+		setup    func(*TestEnv)
+		err      error
+	}{
+		"trending": {"trending", func(env *TestEnv) {
+			env.TMDB.On("GetTrending", "tv", "week").Return([]models.Movie{{ID: 1399, MediaType: "tv"}}, nil)
+		}, nil},
+		"on the air": {"on_the_air", func(env *TestEnv) {
+			env.TMDB.ReturnsTVList(tmdb.TVOnTheAir, 1, []models.Movie{{ID: 1399, MediaType: "tv"}})
+		}, nil},
+		"airing today": {"airing_today", func(env *TestEnv) {
+			env.TMDB.ReturnsTVList(tmdb.TVAiringToday, 1, []models.Movie{{ID: 1399, MediaType: "tv"}})
+		}, nil},
+		"genre": {"drama", func(env *TestEnv) {
+			env.TMDB.ReturnsGenreList("tv", tvGenres)
+			env.TMDB.DiscoverReturns("tv", tmdb.DiscoverParams{Genres: []int{18}, Page: 1}, []models.Movie{{ID: 1399, MediaType: "tv"}})
+		}, nil},
+		"movie-only category": {"upcoming", func(env *TestEnv) {
+			env.TMDB.ReturnsGenreList("tv", tvGenres)
+		}, ErrUnknownGenre},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			userID := uuid.New()
+			tt.setup(env)
+			if tt.err == nil {
+				env.Watchlist.SavesAmong(userID, []int{1399}, 1399)
+			}
+
+			shows, err := env.MovieService().DiscoverTV(userID, tt.category, 1)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, shows, 1)
+			assert.True(t, shows[0].IsWatchlisted)
+		})
+	}
+}
@@ -2,14 +2,25 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"github.com/milansax96/movie-terminal-api/internal/handle"
 	"github.com/milansax96/movie-terminal-api/internal/models"
 	"github.com/milansax96/movie-terminal-api/internal/repository"
 )
 
+// Profile field limits, in characters.
+const (
+	maxDisplayNameLength = 50
+	maxBioLength         = 160
+)
+
 // UserService handles user profile operations.
 type UserService struct {
 	userRepo repository.UserRepository
@@ -39,3 +50,92 @@ func (s *UserService) UpdateStreamingServices(userID uuid.UUID, serviceIDs []int
 
 	return s.userRepo.ReplaceStreamingServices(userID, services)
 }
+
+// ProfileUpdate holds the profile fields to change; nil fields are left as they are.
+type ProfileUpdate struct {
+	Username    *string `json:"username"`
+	DisplayName *string `json:"display_name"`
+	Bio         *string `json:"bio"`
+}
+
+// UpdateProfile changes the user's handle, display name and bio. Invalid
+// fields are reported together in a *ValidationError; a handle someone else
+// holds returns ErrHandleTaken.
+func (s *UserService) UpdateProfile(userID uuid.UUID, update ProfileUpdate) (*models.User, error) {
+	user, err := s.GetProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	verr := &ValidationError{}
+	previousHandle := user.Username
+
+	if update.Username != nil {
+		username := handle.Normalize(*update.Username)
+		switch err := handle.Validate(username); {
+		case errors.Is(err, handle.ErrReserved):
+			verr.add("username", "is reserved")
+		case err != nil:
+			verr.add("username", fmt.Sprintf("must be %d-%d characters of lowercase letters, digits and underscores, starting with a letter", handle.MinLength, handle.MaxLength))
+		default:
+			user.Username = username
+		}
+	}
+
+	if update.DisplayName != nil {
+		displayName := strings.TrimSpace(*update.DisplayName)
+		switch {
+		case displayName == "":
+			verr.add("display_name", "must not be empty")
+		case utf8.RuneCountInString(displayName) > maxDisplayNameLength:
+			verr.add("display_name", fmt.Sprintf("must be at most %d characters", maxDisplayNameLength))
+		case strings.ContainsFunc(displayName, unicode.IsControl):
+			verr.add("display_name", "must not contain control characters")
+		default:
+			user.DisplayName = displayName
+		}
+	}
+
+	if update.Bio != nil {
+		bio := strings.TrimSpace(*update.Bio)
+		if utf8.RuneCountInString(bio) > maxBioLength {
+			verr.add("bio", fmt.Sprintf("must be at most %d characters", maxBioLength))
+		} else {
+			user.Bio = bio
+		}
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	if user.Username != previousHandle {
+		taken, err := s.userRepo.TakenUsernames([]string{user.Username})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(taken) > 0 {
+			return nil, ErrHandleTaken
+		}
+	}
+
+	if err := s.userRepo.UpdateProfile(user); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrHandleTaken
+		}
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// truncateRunes shortens s to at most n characters.
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	return string([]rune(s)[:n])
+}
@@ -1,11 +1,16 @@
 package service
 
 import (
+	"maps"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 
 	"github.com/milansax96/movie-terminal-api/internal/models"
 )
@@ -59,3 +64,62 @@ func TestUpdateStreamingServices(t *testing.T) {
 	err := env.UserService().UpdateStreamingServices(userID, serviceIDs)
 	require.NoError(t, err)
 }
+
+func TestUpdateProfile(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	tests := map[string]struct {
+		update ProfileUpdate
+		setup  func(*TestEnv)
+		err    error
+		fields []string
+		check  func(*testing.T, *models.User)
+	}{
+		"all fields": {ProfileUpdate{Username: str("@New_Handle"), DisplayName: str(" New Name "), Bio: str("Film nerd")}, func(env *TestEnv) {
+			env.Users.HandlesTaken()
+			env.Users.UpdatesProfile()
+		}, nil, nil, func(t *testing.T, user *models.User) {
+			assert.Equal(t, "new_handle", user.Username)
+			assert.Equal(t, "New Name", user.DisplayName)
+			assert.Equal(t, "Film nerd", user.Bio)
+		}},
+		"unchanged handle skips lookup": {ProfileUpdate{Username: str("old_handle"), Bio: str("")}, func(env *TestEnv) {
+			env.Users.UpdatesProfile()
+		}, nil, nil, nil},
+		"handle taken": {ProfileUpdate{Username: str("popular")}, func(env *TestEnv) {
+			env.Users.HandlesTaken("popular")
+		}, ErrHandleTaken, nil, nil},
+		"lost race for handle": {ProfileUpdate{Username: str("popular")}, func(env *TestEnv) {
+			env.Users.HandlesTaken()
+			env.Users.On("UpdateProfile", mock.AnythingOfType("*models.User")).Return(gorm.ErrDuplicatedKey)
+		}, ErrHandleTaken, nil, nil},
+		"invalid fields": {ProfileUpdate{Username: str("admin"), DisplayName: str("  "), Bio: str(strings.Repeat("x", 161))}, func(_ *TestEnv) {},
+			ErrInvalidInput, []string{"bio", "display_name", "username"}, nil},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			userID := uuid.New()
+			env.Users.FindsUser(userID, &models.User{ID: userID, Username: "old_handle", DisplayName: "Old"})
+			tt.setup(env)
+
+			user, err := env.UserService().UpdateProfile(userID, tt.update)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				if tt.fields != nil {
+					var verr *ValidationError
+					require.ErrorAs(t, err, &verr)
+					assert.ElementsMatch(t, tt.fields, slices.Sorted(maps.Keys(verr.Fields)))
+				}
+
+				return
+			}
+			require.NoError(t, err)
+			if tt.check != nil {
+				tt.check(t, user)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+// watchlistDetailsConcurrency caps the titles looked up on TMDB at once while
+// syncing watchlist details.
+const watchlistDetailsConcurrency = 4
+
+// WatchlistDetailsService fills in the release date, rating and genres of
+// watchlist items saved without them, such as those saved before the
+// details were stored, so they sort and filter like the rest.
+type WatchlistDetailsService struct {
+	tmdb          tmdb.API
+	watchlistRepo repository.WatchlistRepository
+	clock         Clock
+}
+
+// NewWatchlistDetailsService creates a new WatchlistDetailsService.
+func NewWatchlistDetailsService(tmdbClient tmdb.API, watchlistRepo repository.WatchlistRepository, clock Clock) *WatchlistDetailsService {
+	return &WatchlistDetailsService{
+		tmdb:          tmdbClient,
+		watchlistRepo: watchlistRepo,
+		clock:         clock,
+	}
+}
+
+// Run syncs unsynced items straight away and then once per interval until
+// ctx is cancelled.
+func (s *WatchlistDetailsService) Run(ctx context.Context, interval time.Duration) {
+	for {
+		if err := s.SyncAll(ctx); err != nil {
+			log.Printf("Watchlist details sync failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.clock.After(interval):
+		}
+	}
+}
+
+// SyncAll fetches each unsynced title from TMDB once for every user who
+// saved it. A failure on one title does not stop the others; it is retried
+// on the next run.
+func (s *WatchlistDetailsService) SyncAll(ctx context.Context) error {
+	titles, err := s.watchlistRepo.UnsyncedTitles()
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, watchlistDetailsConcurrency)
+
+	for _, title := range titles {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(title models.Watchlist) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := s.syncTitle(title.TMDBId, title.MediaType); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s %d: %w", title.MediaType, title.TMDBId, err))
+				mu.Unlock()
+			}
+		}(title)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (s *WatchlistDetailsService) syncTitle(tmdbID int, mediaType string) error {
+	details := &models.Watchlist{}
+
+	if mediaType == "tv" {
+		show, err := s.tmdb.GetTVDetails(tmdbID)
+		if err != nil {
+			return err
+		}
+
+		details.ReleaseDate, details.VoteAverage = show.FirstAirDate, show.VoteAverage
+		for _, g := range show.Genres {
+			details.Genres = append(details.Genres, models.WatchlistGenre{GenreID: g.ID})
+		}
+	} else {
+		movie, err := s.tmdb.GetMovieDetails("movie", tmdbID)
+		if err != nil {
+			return err
+		}
+
+		details.ReleaseDate, details.VoteAverage = movie.ReleaseDate, movie.VoteAverage
+		for _, g := range movie.Genres {
+			details.Genres = append(details.Genres, models.WatchlistGenre{GenreID: g.ID})
+		}
+	}
+
+	return s.watchlistRepo.SyncDetails(tmdbID, mediaType, details)
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+func TestSyncAll(t *testing.T) {
+	env := newTestEnv(t)
+	env.Watchlist.HasUnsyncedTitles([]models.Watchlist{
+		{TMDBId: 550, MediaType: "movie"},
+		{TMDBId: 1399, MediaType: "tv"},
+	})
+	env.TMDB.ReturnsDetails("movie", 550, &tmdb.MovieDetail{
+		ID: 550, ReleaseDate: "1999-10-15", VoteAverage: 8.4, Genres: []tmdb.Genre{{ID: 18}, {ID: 53}},
+	})
+	env.TMDB.ReturnsShow(&models.TVShow{ID: 1399, FirstAirDate: "2011-04-17", VoteAverage: 8.5, Genres: []models.Genre{{ID: 10765}}})
+	env.Watchlist.SyncsDetails(550, "movie", &models.Watchlist{
+		ReleaseDate: "1999-10-15", VoteAverage: 8.4, Genres: []models.WatchlistGenre{{GenreID: 18}, {GenreID: 53}},
+	})
+	env.Watchlist.SyncsDetails(1399, "tv", &models.Watchlist{
+		ReleaseDate: "2011-04-17", VoteAverage: 8.5, Genres: []models.WatchlistGenre{{GenreID: 10765}},
+	})
+
+	err := env.WatchlistDetailsService().SyncAll(context.Background())
+
+	require.NoError(t, err)
+}
+
+func TestSyncAll_ContinuesAfterFailure(t *testing.T) {
+	env := newTestEnv(t)
+	env.Watchlist.HasUnsyncedTitles([]models.Watchlist{
+		{TMDBId: 550, MediaType: "movie"},
+		{TMDBId: 1399, MediaType: "tv"},
+	})
+	env.TMDB.DetailsFail("movie", 550, errors.New("tmdb down"))
+	env.TMDB.ReturnsShow(&models.TVShow{ID: 1399, FirstAirDate: "2011-04-17"})
+	env.Watchlist.SyncsDetails(1399, "tv", &models.Watchlist{ReleaseDate: "2011-04-17"})
+
+	err := env.WatchlistDetailsService().SyncAll(context.Background())
+
+	assert.ErrorContains(t, err, "tmdb down")
+	env.Watchlist.AssertNotCalled(t, "SyncDetails", 550, "movie", mock.Anything)
+}
+
+func TestWatchlistDetailsRun_SyncsEachInterval(t *testing.T) {
+	const interval = time.Hour
+
+	env := newTestEnv(t)
+	env.Watchlist.HasUnsyncedTitles(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		env.WatchlistDetailsService().Run(ctx, interval)
+		close(done)
+	}()
+
+	env.Clock.BlockUntilWaiting(t)
+	env.Watchlist.AssertNumberOfCalls(t, "UnsyncedTitles", 1)
+
+	env.Clock.Advance(interval)
+	env.Clock.BlockUntilWaiting(t)
+	env.Watchlist.AssertNumberOfCalls(t, "UnsyncedTitles", 2)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not stop after cancellation")
+	}
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+)
+
+// WatchlistSortAdded sorts the watchlist by when titles were saved. The
+// watchlist also sorts by SortTitle, SortReleaseDate and SortRating; each
+// sorts descending unless suffixed with ".asc", except title, which sorts
+// ascending unless suffixed with ".desc".
+const WatchlistSortAdded = "added"
+
+// Bounds on a watchlist page.
+const (
+	defaultWatchlistLimit = 50
+	maxWatchlistLimit     = 100
+)
+
+var watchlistSortColumns = map[string]string{
+	WatchlistSortAdded: repository.WatchlistByAdded,
+	SortTitle:          repository.WatchlistByTitle,
+	SortReleaseDate:    repository.WatchlistByRelease,
+	SortRating:         repository.WatchlistByRating,
+}
+
+// WatchlistFilters narrow and order a page of the watchlist. Zero values are
+// unset.
+type WatchlistFilters struct {
+	MediaType string
+
+	// Genre is a slug or TMDB genre ID.
+	Genre string
+
+	// Sort is WatchlistSortAdded (the default), SortTitle, SortReleaseDate
+	// or SortRating, optionally suffixed with ".asc" or ".desc".
+	Sort string
+
+	// OnMyServices limits the page to titles streaming on the user's
+	// streaming services in Region, which defaults to the first region the
+	// availability job tracks and must be one of them. Streaming is as of
+	// the job's last check, so titles saved since then don't match yet.
+	OnMyServices bool
+	Region       string
+
+	// Cursor is the NextCursor of the previous page. Without a Cursor or a
+	// Limit the whole watchlist comes back as one page, as it did before
+	// pagination; with a Cursor alone the page size defaults to 50.
+	Cursor string
+	Limit  int
+}
+
+// WatchlistPage is a page of the watchlist. NextCursor is empty on the last
+// page.
+type WatchlistPage struct {
+	Results    []models.Watchlist `json:"results"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
+
+// watchlistCursor is the sort key of the last item on a page. Sort includes
+// the direction so a cursor can't be reused with a different order.
+type watchlistCursor struct {
+	Sort  string    `json:"s"`
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// GetWatchlist returns a page of the user's watchlist. Invalid filters are
+// reported as a ValidationError.
+func (s *MovieService) GetWatchlist(userID uuid.UUID, filters WatchlistFilters) (*WatchlistPage, error) {
+	verr := &ValidationError{}
+	query := repository.WatchlistQuery{MediaType: filters.MediaType, Limit: filters.Limit}
+
+	if filters.MediaType != "" && filters.MediaType != "movie" && filters.MediaType != "tv" {
+		verr.add("media_type", "must be movie or tv")
+	}
+
+	if filters.Genre != "" {
+		id, ok := s.watchlistGenre(filters.MediaType, filters.Genre)
+		if !ok {
+			verr.add("genre", "unknown genre "+filters.Genre)
+		}
+		query.GenreID = id
+	}
+
+	sort, msg := watchlistSort(filters.Sort, &query)
+	if msg != "" {
+		verr.add("sort", msg)
+	}
+
+	paged := filters.Limit != 0 || filters.Cursor != ""
+	if query.Limit == 0 && paged {
+		query.Limit = defaultWatchlistLimit
+	} else if paged && (query.Limit < 1 || query.Limit > maxWatchlistLimit) {
+		verr.add("limit", "must be between 1 and "+strconv.Itoa(maxWatchlistLimit))
+	}
+
+	if filters.OnMyServices {
+		query.Region = strings.ToUpper(strings.TrimSpace(filters.Region))
+		if query.Region == "" && len(s.availabilityRegions) > 0 {
+			query.Region = s.availabilityRegions[0]
+		}
+
+		if !slices.Contains(s.availabilityRegions, query.Region) {
+			verr.add("region", "must be one of "+strings.Join(s.availabilityRegions, ", "))
+		}
+	} else if filters.Region != "" {
+		verr.add("region", "only applies with services=mine")
+	}
+
+	if filters.Cursor != "" {
+		after, ok := decodeWatchlistCursor(filters.Cursor, sort, query.SortBy)
+		if !ok {
+			verr.add("cursor", "is not a cursor for this sort")
+		}
+		query.After = after
+	}
+
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	if filters.OnMyServices {
+		providerIDs, err := s.userProviderIDs(userID, query.Region)
+		if err != nil {
+			return nil, err
+		}
+		query.ProviderIDs = providerIDs
+	}
+
+	// Ask for one more than the page to learn whether another page follows.
+	limit := query.Limit
+	if paged {
+		query.Limit++
+	}
+
+	items, err := s.watchlistRepo.Page(userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &WatchlistPage{Results: items}
+	if paged && len(items) > limit {
+		page.Results = items[:limit]
+		page.NextCursor = encodeWatchlistCursor(sort, query.SortBy, &page.Results[limit-1])
+	}
+
+	return page, nil
+}
+
+// watchlistGenre resolves a genre slug or ID. Without a media type, slugs
+// are looked up among movie genres and then TV genres.
+func (s *MovieService) watchlistGenre(mediaType string, genre string) (int, bool) {
+	mediaTypes := []string{mediaType}
+	if mediaType == "" {
+		mediaTypes = []string{"movie", "tv"}
+	}
+
+	for _, mt := range mediaTypes {
+		if id, ok := lookupGenre(s.genreIndex(mt), genre); ok {
+			return id, true
+		}
+	}
+
+	return 0, false
+}
+
+// watchlistSort sets the sort column and direction of query from a sort such
+// as "rating" or "title.desc". It returns the sort normalized to include its
+// direction, and a message when the sort is not supported.
+func watchlistSort(sort string, query *repository.WatchlistQuery) (string, string) {
+	if sort == "" {
+		sort = WatchlistSortAdded
+	}
+
+	field, dir, hasDir := strings.Cut(sort, ".")
+	if hasDir && dir != "asc" && dir != "desc" {
+		return "", "direction must be asc or desc"
+	}
+
+	column, ok := watchlistSortColumns[field]
+	if !ok {
+		return "", "must be one of added, title, release_date or rating"
+	}
+
+	if !hasDir {
+		dir = "desc"
+		if field == SortTitle {
+			dir = "asc"
+		}
+	}
+
+	query.SortBy = column
+	query.Ascending = dir == "asc"
+
+	return field + "." + dir, ""
+}
+
+func encodeWatchlistCursor(sort string, column string, item *models.Watchlist) string {
+	cursor := watchlistCursor{Sort: sort, ID: item.ID}
+	switch column {
+	case repository.WatchlistByAdded:
+		cursor.Value = item.AddedAt.UTC().Format(time.RFC3339Nano)
+	case repository.WatchlistByTitle:
+		cursor.Value = item.Title
+	case repository.WatchlistByRelease:
+		cursor.Value = item.ReleaseDate
+	case repository.WatchlistByRating:
+		cursor.Value = strconv.FormatFloat(item.VoteAverage, 'f', -1, 64)
+	}
+
+	raw, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeWatchlistCursor returns the item a cursor points after. It reports
+// false if the cursor is malformed or was made for a different sort.
+func decodeWatchlistCursor(encoded string, sort string, column string) (*models.Watchlist, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, false
+	}
+
+	var cursor watchlistCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.Sort != sort || cursor.ID == uuid.Nil {
+		return nil, false
+	}
+
+	after := &models.Watchlist{ID: cursor.ID}
+	switch column {
+	case repository.WatchlistByAdded:
+		after.AddedAt, err = time.Parse(time.RFC3339Nano, cursor.Value)
+	case repository.WatchlistByTitle:
+		after.Title = cursor.Value
+	case repository.WatchlistByRelease:
+		after.ReleaseDate = cursor.Value
+	case repository.WatchlistByRating:
+		after.VoteAverage, err = strconv.ParseFloat(cursor.Value, 64)
+	}
+
+	return after, err == nil
+}
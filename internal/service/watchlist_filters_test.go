@@ -0,0 +1,175 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/internal/repository"
+)
+
+func TestGetWatchlist(t *testing.T) {
+	userID := uuid.New()
+	items := []models.Watchlist{{ID: uuid.New(), TMDBId: 550, Title: "Fight Club"}, {ID: uuid.New(), TMDBId: 680, Title: "Pulp Fiction"}}
+
+	tests := map[string]struct {
+		filters WatchlistFilters
+		query   repository.WatchlistQuery
+	}{
+		"whole watchlist newest first by default": {WatchlistFilters{}, repository.WatchlistQuery{
+			SortBy: repository.WatchlistByAdded,
+		}},
+		"title sorts ascending": {WatchlistFilters{Sort: "title", Limit: 10}, repository.WatchlistQuery{
+			SortBy: repository.WatchlistByTitle, Ascending: true, Limit: 11,
+		}},
+		"lowest rated first": {WatchlistFilters{Sort: "rating.asc"}, repository.WatchlistQuery{
+			SortBy: repository.WatchlistByRating, Ascending: true,
+		}},
+		"media type and genre id": {WatchlistFilters{MediaType: "tv", Genre: "18", Sort: "release_date"}, repository.WatchlistQuery{
+			SortBy: repository.WatchlistByRelease, MediaType: "tv", GenreID: 18,
+		}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			if tt.filters.Genre != "" {
+				env.TMDB.ReturnsGenreList(tt.filters.MediaType, tvGenres)
+			}
+			env.Watchlist.ReturnsPage(userID, tt.query, items)
+
+			page, err := env.MovieService().GetWatchlist(userID, tt.filters)
+			require.NoError(t, err)
+			assert.Equal(t, items, page.Results)
+			assert.Empty(t, page.NextCursor)
+		})
+	}
+}
+
+func TestGetWatchlist_GenreSlug(t *testing.T) {
+	userID := uuid.New()
+
+	env := newTestEnv(t)
+	env.TMDB.ReturnsGenreList("movie", movieGenres)
+	env.TMDB.ReturnsGenreList("tv", tvGenres)
+	env.Watchlist.ReturnsPage(userID, repository.WatchlistQuery{
+		SortBy: repository.WatchlistByAdded, GenreID: 10765,
+	}, nil)
+
+	_, err := env.MovieService().GetWatchlist(userID, WatchlistFilters{Genre: "sci_fi_and_fantasy"})
+	require.NoError(t, err, "slugs fall back to TV genres without a media type")
+}
+
+func TestGetWatchlist_Cursor(t *testing.T) {
+	userID := uuid.New()
+	added := time.Date(2026, 2, 1, 20, 0, 0, 123, time.UTC)
+	items := []models.Watchlist{
+		{ID: uuid.New(), TMDBId: 550, AddedAt: added.Add(time.Hour)},
+		{ID: uuid.New(), TMDBId: 680, AddedAt: added},
+		{ID: uuid.New(), TMDBId: 13, AddedAt: added.Add(-time.Hour)},
+	}
+
+	env := newTestEnv(t)
+	env.Watchlist.ReturnsPage(userID, repository.WatchlistQuery{SortBy: repository.WatchlistByAdded, Limit: 3}, items)
+
+	first, err := env.MovieService().GetWatchlist(userID, WatchlistFilters{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, items[:2], first.Results)
+	require.NotEmpty(t, first.NextCursor)
+
+	env.Watchlist.ReturnsPage(userID, repository.WatchlistQuery{
+		SortBy: repository.WatchlistByAdded, Limit: 3, After: &models.Watchlist{ID: items[1].ID, AddedAt: added},
+	}, items[2:])
+
+	second, err := env.MovieService().GetWatchlist(userID, WatchlistFilters{Limit: 2, Cursor: first.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, items[2:], second.Results)
+	assert.Empty(t, second.NextCursor)
+
+	env.Watchlist.ReturnsPage(userID, repository.WatchlistQuery{
+		SortBy: repository.WatchlistByAdded, Limit: defaultWatchlistLimit + 1, After: &models.Watchlist{ID: items[1].ID, AddedAt: added},
+	}, items[2:])
+
+	_, err = env.MovieService().GetWatchlist(userID, WatchlistFilters{Cursor: first.NextCursor})
+	require.NoError(t, err, "a cursor alone pages at the default size")
+
+	_, err = env.MovieService().GetWatchlist(userID, WatchlistFilters{Sort: "added.asc", Cursor: first.NextCursor})
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Contains(t, verr.Fields, "cursor", "a cursor only continues the sort it came from")
+}
+
+func TestGetWatchlist_OnMyServices(t *testing.T) {
+	netflix, hulu := 8, 15
+	userID := uuid.New()
+
+	env := newTestEnv(t)
+	env.Users.FindsUser(userID, &models.User{ID: userID, StreamingServices: []models.StreamingService{
+		{Slug: "netflix", TMDBProviderID: &netflix},
+		{Slug: "hulu", TMDBProviderID: &hulu, Regions: []string{"US"}},
+	}})
+	env.Watchlist.ReturnsPage(userID, repository.WatchlistQuery{
+		SortBy: repository.WatchlistByAdded, ProviderIDs: []int{8}, Region: "GB",
+	}, []models.Watchlist{{TMDBId: 550}})
+
+	page, err := env.MovieService().GetWatchlist(userID, WatchlistFilters{OnMyServices: true, Region: "gb"})
+	require.NoError(t, err)
+	assert.Len(t, page.Results, 1)
+}
+
+func TestGetWatchlist_OnMyServicesDefaultsToFirstTrackedRegion(t *testing.T) {
+	netflix := 8
+	userID := uuid.New()
+
+	env := newTestEnv(t)
+	env.Users.FindsUser(userID, &models.User{ID: userID, StreamingServices: []models.StreamingService{
+		{Slug: "netflix", TMDBProviderID: &netflix},
+	}})
+	env.Watchlist.ReturnsPage(userID, repository.WatchlistQuery{
+		SortBy: repository.WatchlistByAdded, ProviderIDs: []int{8}, Region: "US",
+	}, []models.Watchlist{{TMDBId: 550}})
+
+	page, err := env.MovieService().GetWatchlist(userID, WatchlistFilters{OnMyServices: true})
+	require.NoError(t, err)
+	assert.Len(t, page.Results, 1)
+}
+
+func TestGetWatchlist_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		filters WatchlistFilters
+		fields  []string
+	}{
+		"media type":              {WatchlistFilters{MediaType: "anime"}, []string{"media_type"}},
+		"unknown genre":           {WatchlistFilters{MediaType: "movie", Genre: "mumblecore"}, []string{"genre"}},
+		"unknown sort":            {WatchlistFilters{Sort: "popularity"}, []string{"sort"}},
+		"sort direction":          {WatchlistFilters{Sort: "rating.up"}, []string{"sort"}},
+		"limit too large":         {WatchlistFilters{Limit: maxWatchlistLimit + 1}, []string{"limit"}},
+		"negative limit":          {WatchlistFilters{Limit: -1}, []string{"limit"}},
+		"region without services": {WatchlistFilters{Region: "US"}, []string{"region"}},
+		"bad region":              {WatchlistFilters{OnMyServices: true, Region: "USA"}, []string{"region"}},
+		"untracked region":        {WatchlistFilters{OnMyServices: true, Region: "DE"}, []string{"region"}},
+		"malformed cursor":        {WatchlistFilters{Cursor: "not-a-cursor"}, []string{"cursor"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newTestEnv(t)
+			if tt.filters.Genre != "" {
+				env.TMDB.ReturnsGenreList(tt.filters.MediaType, movieGenres)
+			}
+
+			_, err := env.MovieService().GetWatchlist(uuid.New(), tt.filters)
+
+			var verr *ValidationError
+			require.ErrorAs(t, err, &verr)
+			for _, field := range tt.fields {
+				assert.Contains(t, verr.Fields, field)
+			}
+			assert.Len(t, verr.Fields, len(tt.fields))
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Package signing manages the keys used to sign and verify access tokens.
+package signing
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits is the smallest RSA modulus accepted for signing keys.
+const minRSABits = 2048
+
+// Key is one signing key. Public is nil for symmetric keys, which are never
+// published.
+type Key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	private interface{}
+	Public  crypto.PublicKey
+}
+
+// KeySet holds every key tokens may be verified with and the one new tokens
+// are signed with. Rotating keys means adding a key, making it active, and
+// removing the old one once tokens signed with it have expired.
+type KeySet struct {
+	keys   map[string]*Key
+	active *Key
+}
+
+// NewHMACKeySet returns a key set that signs and verifies HS256 tokens with
+// a shared secret. Tokens carry no kid header.
+func NewHMACKeySet(secret string) *KeySet {
+	key := &Key{Method: jwt.SigningMethodHS256, private: []byte(secret)}
+
+	return &KeySet{keys: map[string]*Key{"": key}, active: key}
+}
+
+// LoadKeySet reads every PEM-encoded private key in dir; each file's name
+// without its .pem extension is the key's kid. RSA keys sign with RS256 and
+// Ed25519 keys with EdDSA. activeKID selects the signing key and may be empty
+// when the directory holds a single key.
+//
+// An empty dir falls back to HS256 with legacySecret. Otherwise a non-empty
+// legacySecret keeps kid-less HS256 tokens verifiable during a migration
+// from the shared secret, but is never used to sign.
+func LoadKeySet(dir string, activeKID string, legacySecret string) (*KeySet, error) {
+	if dir == "" {
+		if legacySecret == "" {
+			return nil, errors.New("no signing keys: set JWT_KEYS_DIR or JWT_SECRET")
+		}
+
+		return NewHMACKeySet(legacySecret), nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("listing signing keys: %w", err)
+	}
+
+	ks := &KeySet{keys: make(map[string]*Key, len(paths)+1)}
+	for _, path := range paths {
+		key, err := loadKey(path)
+		if err != nil {
+			return nil, err
+		}
+		ks.keys[key.ID] = key
+	}
+
+	if len(ks.keys) == 0 {
+		return nil, fmt.Errorf("no signing keys found in %s", dir)
+	}
+
+	if activeKID == "" && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			activeKID = key.ID
+		}
+	}
+
+	active, ok := ks.keys[activeKID]
+	if !ok {
+		return nil, fmt.Errorf("active signing key %q not found in %s", activeKID, dir)
+	}
+	ks.active = active
+
+	if legacySecret != "" {
+		ks.keys[""] = &Key{Method: jwt.SigningMethodHS256, private: []byte(legacySecret)}
+	}
+
+	return ks, nil
+}
+
+func loadKey(path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading signing key: %w", err)
+	}
+
+	kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("signing key %s: no PEM block", kid)
+	}
+
+	var parsed interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("signing key %s: unsupported PEM type %q", kid, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("signing key %s: %w", kid, err)
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("signing key %s: RSA keys must be at least %d bits", kid, minRSABits)
+		}
+
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, private: k, Public: &k.PublicKey}, nil
+	case ed25519.PrivateKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, private: k, Public: k.Public()}, nil
+	default:
+		return nil, fmt.Errorf("signing key %s: unsupported key type %T", kid, parsed)
+	}
+}
+
+// Sign signs claims with the active key, naming it in the kid header.
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.active.Method, claims)
+	if ks.active.ID != "" {
+		token.Header["kid"] = ks.active.ID
+	}
+
+	return token.SignedString(ks.active.private)
+}
+
+// Keyfunc resolves the verification key for a token from its kid header. It
+// is meant for jwt.Parse and rejects tokens whose alg does not match the key.
+func (ks *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("signing key %q does not use %s", kid, token.Method.Alg())
+	}
+
+	if key.Public == nil {
+		return key.private, nil
+	}
+
+	return key.Public, nil
+}
+
+// Methods returns the algorithms of every key in the set, for jwt.WithValidMethods.
+func (ks *KeySet) Methods() []string {
+	seen := map[string]bool{}
+	var methods []string
+	for _, key := range ks.keys {
+		if alg := key.Method.Alg(); !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	sort.Strings(methods)
+
+	return methods
+}
+
+// PublicKeys returns the asymmetric keys in kid order.
+func (ks *KeySet) PublicKeys() []*Key {
+	var keys []*Key
+	for _, key := range ks.keys {
+		if key.Public != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+
+	return keys
+}
@@ -0,0 +1,166 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePKCS8(t *testing.T, dir string, kid string, key interface{}) {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), pemBytes, 0o600))
+}
+
+// keyDir writes an RSA key "rsa-1" and an Ed25519 key "ed-1".
+func keyDir(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writePKCS8(t, dir, "rsa-1", rsaKey)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	writePKCS8(t, dir, "ed-1", edKey)
+
+	return dir
+}
+
+func claims() jwt.Claims {
+	return jwt.RegisteredClaims{Subject: "user", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))}
+}
+
+func verify(ks *KeySet, token string) error {
+	_, err := jwt.Parse(token, ks.Keyfunc, jwt.WithValidMethods(ks.Methods()))
+
+	return err
+}
+
+func TestKeySet_SignAndVerify(t *testing.T) {
+	dir := keyDir(t)
+
+	for kid, alg := range map[string]string{"rsa-1": "RS256", "ed-1": "EdDSA"} {
+		t.Run(kid, func(t *testing.T) {
+			ks, err := LoadKeySet(dir, kid, "")
+			require.NoError(t, err)
+
+			token, err := ks.Sign(claims())
+			require.NoError(t, err)
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.RegisteredClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, kid, parsed.Header["kid"])
+			assert.Equal(t, alg, parsed.Method.Alg())
+			assert.NoError(t, verify(ks, token))
+		})
+	}
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	dir := keyDir(t)
+
+	before, err := LoadKeySet(dir, "rsa-1", "")
+	require.NoError(t, err)
+	token, err := before.Sign(claims())
+	require.NoError(t, err)
+
+	after, err := LoadKeySet(dir, "ed-1", "")
+	require.NoError(t, err)
+	assert.NoError(t, verify(after, token), "tokens signed by a retired active key stay valid")
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "rsa-1.pem")))
+	removed, err := LoadKeySet(dir, "ed-1", "")
+	require.NoError(t, err)
+	assert.Error(t, verify(removed, token), "tokens fail once their key is removed")
+}
+
+func TestKeySet_RejectsForgeries(t *testing.T) {
+	dir := keyDir(t)
+	ks, err := LoadKeySet(dir, "rsa-1", "legacy-secret")
+	require.NoError(t, err)
+
+	unknown := jwt.NewWithClaims(jwt.SigningMethodHS256, claims())
+	unknown.Header["kid"] = "nope"
+	signed, err := unknown.SignedString([]byte("legacy-secret"))
+	require.NoError(t, err)
+	assert.Error(t, verify(ks, signed), "unknown kid")
+
+	// An HS256 token naming the RSA key must not be checked as an HMAC.
+	confused := jwt.NewWithClaims(jwt.SigningMethodHS256, claims())
+	confused.Header["kid"] = "rsa-1"
+	signed, err = confused.SignedString([]byte("anything"))
+	require.NoError(t, err)
+	assert.Error(t, verify(ks, signed), "alg mismatch")
+
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims()).SignedString([]byte("legacy-secret"))
+	require.NoError(t, err)
+	assert.NoError(t, verify(ks, legacy), "kid-less legacy token")
+}
+
+func TestLoadKeySet_Errors(t *testing.T) {
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	weakDir := t.TempDir()
+	writePKCS8(t, weakDir, "weak", weak)
+
+	tests := map[string]struct {
+		dir    string
+		kid    string
+		secret string
+	}{
+		"nothing configured":   {"", "", ""},
+		"empty directory":      {t.TempDir(), "", ""},
+		"ambiguous active key": {keyDir(t), "", ""},
+		"missing active key":   {keyDir(t), "nope", ""},
+		"weak rsa key":         {weakDir, "weak", ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadKeySet(tt.dir, tt.kid, tt.secret)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	ks, err := LoadKeySet(keyDir(t), "ed-1", "legacy-secret")
+	require.NoError(t, err)
+
+	doc := ks.JWKS()
+	require.Len(t, doc.Keys, 2, "the legacy secret is never published")
+
+	ed, rsaKey := doc.Keys[0], doc.Keys[1]
+	assert.Equal(t, JWK{KeyType: "OKP", KeyID: "ed-1", Use: "sig", Algorithm: "EdDSA", Curve: "Ed25519", X: ed.X}, ed)
+	assert.NotEmpty(t, ed.X)
+	assert.Equal(t, "RSA", rsaKey.KeyType)
+	assert.Equal(t, "AQAB", rsaKey.E)
+	assert.NotEmpty(t, rsaKey.N)
+}
+
+func TestNewHMACKeySet(t *testing.T) {
+	ks := NewHMACKeySet("secret")
+	token, err := ks.Sign(claims())
+	require.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.RegisteredClaims{})
+	require.NoError(t, err)
+	assert.NotContains(t, parsed.Header, "kid")
+	assert.NoError(t, verify(ks, token))
+	assert.Empty(t, ks.JWKS().Keys)
+}
@@ -1,7 +1,6 @@
 package tmdb
 
 import (
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -12,17 +11,27 @@ import (
 
 // TTL constants for different endpoint types.
 const (
-	ttlTrending   = 1 * time.Hour
-	ttlTopRated   = 6 * time.Hour
-	ttlNowPlaying = 3 * time.Hour
-	ttlPopular    = 3 * time.Hour
-	ttlUpcoming   = 6 * time.Hour
-	ttlGenre      = 3 * time.Hour
-	ttlSearch     = 30 * time.Minute
-	ttlDetail     = 24 * time.Hour
-	ttlVideos     = 24 * time.Hour
-	ttlCredits    = 24 * time.Hour
-	ttlProviders  = 6 * time.Hour
+	ttlTrending      = 1 * time.Hour
+	ttlTopRated      = 6 * time.Hour
+	ttlNowPlaying    = 3 * time.Hour
+	ttlPopular       = 3 * time.Hour
+	ttlUpcoming      = 6 * time.Hour
+	ttlGenre         = 3 * time.Hour
+	ttlSearch        = 30 * time.Minute
+	ttlDetail        = 24 * time.Hour
+	ttlVideos        = 24 * time.Hour
+	ttlCredits       = 24 * time.Hour
+	ttlKeywords      = 24 * time.Hour
+	ttlSimilar       = 24 * time.Hour
+	ttlRecommended   = 12 * time.Hour
+	ttlProviders     = 6 * time.Hour
+	ttlGenreList     = 24 * time.Hour
+	ttlTVDetail      = 6 * time.Hour
+	ttlSeason        = 12 * time.Hour
+	ttlEpisode       = 24 * time.Hour
+	ttlPerson        = 24 * time.Hour
+	ttlPersonCredits = 12 * time.Hour
+	ttlPersonImages  = 24 * time.Hour
 
 	cleanupInterval = 10 * time.Minute
 )
@@ -115,6 +124,15 @@ func (c *CachedClient) DiscoverByGenre(genreID int, page int) ([]models.Movie, e
 	})
 }
 
+// Discover returns filtered discover results, cached for 3 hours.
+func (c *CachedClient) Discover(mediaType string, params DiscoverParams) ([]models.Movie, error) {
+	key := fmt.Sprintf("discover:%s:%s", mediaType, params.Query(mediaType).Encode())
+
+	return cacheGet(c, key, ttlGenre, func() ([]models.Movie, error) {
+		return c.inner.Discover(mediaType, params)
+	})
+}
+
 // SearchMovies returns search results, cached for 30 minutes.
 func (c *CachedClient) SearchMovies(query string, page int) ([]models.Movie, error) {
 	key := fmt.Sprintf("search:%s:%d", query, page)
@@ -151,11 +169,125 @@ func (c *CachedClient) GetCredits(mediaType string, id int) (*CreditsResponse, e
 	})
 }
 
+// GetKeywords returns a title's keywords, cached for 24 hours.
+func (c *CachedClient) GetKeywords(mediaType string, id int) ([]Keyword, error) {
+	key := fmt.Sprintf("keywords:%s:%d", mediaType, id)
+
+	return cacheGet(c, key, ttlKeywords, func() ([]Keyword, error) {
+		return c.inner.GetKeywords(mediaType, id)
+	})
+}
+
+// GetSimilar returns similar titles, cached for 24 hours.
+func (c *CachedClient) GetSimilar(mediaType string, id int, page int) ([]models.Movie, error) {
+	key := fmt.Sprintf("similar:%s:%d:%d", mediaType, id, page)
+
+	return cacheGet(c, key, ttlSimilar, func() ([]models.Movie, error) {
+		return c.inner.GetSimilar(mediaType, id, page)
+	})
+}
+
+// GetRecommendations returns recommended titles, cached for 12 hours.
+func (c *CachedClient) GetRecommendations(mediaType string, id int, page int) ([]models.Movie, error) {
+	key := fmt.Sprintf("recommendations:%s:%d:%d", mediaType, id, page)
+
+	return cacheGet(c, key, ttlRecommended, func() ([]models.Movie, error) {
+		return c.inner.GetRecommendations(mediaType, id, page)
+	})
+}
+
 // GetProviders returns streaming providers, cached for 6 hours.
-func (c *CachedClient) GetProviders(mediaType string, id int) (json.RawMessage, error) {
+func (c *CachedClient) GetProviders(mediaType string, id int) (*WatchProvidersResponse, error) {
 	key := fmt.Sprintf("providers:%s:%d", mediaType, id)
 
-	return cacheGet(c, key, ttlProviders, func() (json.RawMessage, error) {
+	return cacheGet(c, key, ttlProviders, func() (*WatchProvidersResponse, error) {
 		return c.inner.GetProviders(mediaType, id)
 	})
 }
+
+// GetGenres returns the genre list for a media type, cached for 24 hours.
+func (c *CachedClient) GetGenres(mediaType string) ([]Genre, error) {
+	key := fmt.Sprintf("genres:%s", mediaType)
+
+	return cacheGet(c, key, ttlGenreList, func() ([]Genre, error) {
+		return c.inner.GetGenres(mediaType)
+	})
+}
+
+// tvListTTL is how long each TV list category is cached. Airing lists change
+// daily, so they expire sooner.
+var tvListTTL = map[string]time.Duration{
+	TVPopular:     ttlPopular,
+	TVOnTheAir:    ttlNowPlaying,
+	TVAiringToday: ttlTrending,
+	TVTopRated:    ttlTopRated,
+}
+
+// GetTVList returns a page of a TV list category, cached for 1 to 6 hours.
+func (c *CachedClient) GetTVList(category string, page int) ([]models.Movie, error) {
+	key := fmt.Sprintf("tv_list:%s:%d", category, page)
+	ttl, ok := tvListTTL[category]
+	if !ok {
+		ttl = ttlTrending
+	}
+
+	return cacheGet(c, key, ttl, func() ([]models.Movie, error) {
+		return c.inner.GetTVList(category, page)
+	})
+}
+
+// GetTVDetails returns TV show details, cached for 6 hours so the next air
+// date stays current.
+func (c *CachedClient) GetTVDetails(id int) (*models.TVShow, error) {
+	key := fmt.Sprintf("tv:%d", id)
+
+	return cacheGet(c, key, ttlTVDetail, func() (*models.TVShow, error) {
+		return c.inner.GetTVDetails(id)
+	})
+}
+
+// GetSeason returns a season with its episodes, cached for 12 hours.
+func (c *CachedClient) GetSeason(tvID int, seasonNumber int) (*models.Season, error) {
+	key := fmt.Sprintf("season:%d:%d", tvID, seasonNumber)
+
+	return cacheGet(c, key, ttlSeason, func() (*models.Season, error) {
+		return c.inner.GetSeason(tvID, seasonNumber)
+	})
+}
+
+// GetEpisode returns episode details, cached for 24 hours.
+func (c *CachedClient) GetEpisode(tvID int, seasonNumber int, episodeNumber int) (*models.Episode, error) {
+	key := fmt.Sprintf("episode:%d:%d:%d", tvID, seasonNumber, episodeNumber)
+
+	return cacheGet(c, key, ttlEpisode, func() (*models.Episode, error) {
+		return c.inner.GetEpisode(tvID, seasonNumber, episodeNumber)
+	})
+}
+
+// GetPerson returns a person's details, cached for 24 hours.
+func (c *CachedClient) GetPerson(id int) (*models.Person, error) {
+	key := fmt.Sprintf("person:%d", id)
+
+	return cacheGet(c, key, ttlPerson, func() (*models.Person, error) {
+		return c.inner.GetPerson(id)
+	})
+}
+
+// GetPersonCredits returns a person's credits, cached for 12 hours so newly
+// announced projects show up within a day.
+func (c *CachedClient) GetPersonCredits(id int) (*models.PersonCredits, error) {
+	key := fmt.Sprintf("person_credits:%d", id)
+
+	return cacheGet(c, key, ttlPersonCredits, func() (*models.PersonCredits, error) {
+		return c.inner.GetPersonCredits(id)
+	})
+}
+
+// GetPersonImages returns a person's profile photos, cached for 24 hours.
+func (c *CachedClient) GetPersonImages(id int) ([]models.PersonImage, error) {
+	key := fmt.Sprintf("person_images:%d", id)
+
+	return cacheGet(c, key, ttlPersonImages, func() ([]models.PersonImage, error) {
+		return c.inner.GetPersonImages(id)
+	})
+}
@@ -1,7 +1,6 @@
 package tmdb_test
 
 import (
-	"encoding/json"
 	"errors"
 	"testing"
 
@@ -176,9 +175,26 @@ func TestGetCredits_CacheHit(t *testing.T) {
 	inner.AssertNumberOfCalls(t, "GetCredits", 1)
 }
 
+func TestGetSimilar_CacheHit(t *testing.T) {
+	client, inner := newCachedClient(t)
+	inner.On("GetSimilar", "movie", 550, 1).Return([]models.Movie{{ID: 807}}, nil).Once()
+	inner.On("GetRecommendations", "movie", 550, 1).Return([]models.Movie{{ID: 680}}, nil).Once()
+
+	first, _ := client.GetSimilar("movie", 550, 1)
+	second, _ := client.GetSimilar("movie", 550, 1)
+	recommended, _ := client.GetRecommendations("movie", 550, 1)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 680, recommended[0].ID, "similar and recommended titles are cached apart")
+
+	inner.AssertNumberOfCalls(t, "GetSimilar", 1)
+	inner.AssertNumberOfCalls(t, "GetRecommendations", 1)
+}
+
 func TestGetProviders_CacheHit(t *testing.T) {
 	client, inner := newCachedClient(t)
-	providers := json.RawMessage(`{"US":{"flatrate":[{"provider_name":"Netflix"}]}}`)
+	providers := &tmdb.WatchProvidersResponse{ID: 550, Results: map[string]tmdb.RegionWatchProviders{
+		"US": {Flatrate: []tmdb.WatchProvider{{ProviderID: 8, ProviderName: "Netflix"}}},
+	}}
 	inner.On("GetProviders", "movie", 550).Return(providers, nil).Once()
 
 	first, _ := client.GetProviders("movie", 550)
@@ -188,6 +204,20 @@ func TestGetProviders_CacheHit(t *testing.T) {
 	inner.AssertNumberOfCalls(t, "GetProviders", 1)
 }
 
+func TestGetGenres_CacheHit(t *testing.T) {
+	client, inner := newCachedClient(t)
+	inner.On("GetGenres", "movie").Return([]tmdb.Genre{{ID: 18, Name: "Drama"}}, nil).Once()
+	inner.On("GetGenres", "tv").Return([]tmdb.Genre{{ID: 10765, Name: "Sci-Fi & Fantasy"}}, nil).Once()
+
+	first, _ := client.GetGenres("movie")
+	second, _ := client.GetGenres("movie")
+	tv, _ := client.GetGenres("tv")
+	assert.Equal(t, first, second)
+	assert.Equal(t, 10765, tv[0].ID)
+
+	inner.AssertNumberOfCalls(t, "GetGenres", 2)
+}
+
 func TestDifferentKeysDontCollide(t *testing.T) {
 	client, inner := newCachedClient(t)
 	inner.On("GetMovieDetails", "movie", 550).Return(&tmdb.MovieDetail{ID: 550, Title: "Fight Club"}, nil).Once()
@@ -3,6 +3,7 @@ package tmdb
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -19,13 +20,28 @@ type API interface {
 	GetPopular(page int) ([]models.Movie, error)
 	GetUpcoming(page int) ([]models.Movie, error)
 	DiscoverByGenre(genreID int, page int) ([]models.Movie, error)
+	Discover(mediaType string, params DiscoverParams) ([]models.Movie, error)
 	SearchMovies(query string, page int) ([]models.Movie, error)
 	GetMovieDetails(mediaType string, id int) (*MovieDetail, error)
 	GetVideos(mediaType string, id int) ([]Video, error)
 	GetCredits(mediaType string, id int) (*CreditsResponse, error)
-	GetProviders(mediaType string, id int) (json.RawMessage, error)
+	GetKeywords(mediaType string, id int) ([]Keyword, error)
+	GetSimilar(mediaType string, id int, page int) ([]models.Movie, error)
+	GetRecommendations(mediaType string, id int, page int) ([]models.Movie, error)
+	GetProviders(mediaType string, id int) (*WatchProvidersResponse, error)
+	GetGenres(mediaType string) ([]Genre, error)
+	GetTVList(category string, page int) ([]models.Movie, error)
+	GetTVDetails(id int) (*models.TVShow, error)
+	GetSeason(tvID int, seasonNumber int) (*models.Season, error)
+	GetEpisode(tvID int, seasonNumber int, episodeNumber int) (*models.Episode, error)
+	GetPerson(id int) (*models.Person, error)
+	GetPersonCredits(id int) (*models.PersonCredits, error)
+	GetPersonImages(id int) ([]models.PersonImage, error)
 }
 
+// ErrNotFound is returned when TMDB has no such resource.
+var ErrNotFound = errors.New("tmdb: not found")
+
 // Client is the TMDB API client.
 type Client struct {
 	APIKey     string
@@ -44,6 +60,7 @@ type Movie struct {
 	ReleaseDate  string  `json:"release_date"`
 	VoteAverage  float64 `json:"vote_average"`
 	MediaType    string  `json:"media_type"`
+	GenreIDs     []int   `json:"genre_ids"`
 }
 
 // MovieDetail represents detailed information about a movie from the TMDB API.
@@ -84,11 +101,38 @@ type CastMember struct {
 	ProfilePath string `json:"profile_path"`
 }
 
+// CrewMember represents a crew member from the TMDB API.
+type CrewMember struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Job         string `json:"job"`
+	Department  string `json:"department"`
+	ProfilePath string `json:"profile_path"`
+}
+
+// Keyword represents a keyword tagging a title on TMDB.
+type Keyword struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// KeywordsResponse represents a title's keywords from the TMDB API. Movies
+// list them under "keywords" and TV shows under "results".
+type KeywordsResponse struct {
+	Keywords []Keyword `json:"keywords"`
+	Results  []Keyword `json:"results"`
+}
+
 // MovieListResponse represents a list of movies from the TMDB API.
 type MovieListResponse struct {
 	Results []Movie `json:"results"`
 }
 
+// GenreListResponse represents the genre list of a media type from the TMDB API.
+type GenreListResponse struct {
+	Genres []Genre `json:"genres"`
+}
+
 // VideosResponse represents a list of videos from the TMDB API.
 type VideosResponse struct {
 	Results []Video `json:"results"`
@@ -97,6 +141,33 @@ type VideosResponse struct {
 // CreditsResponse represents credits information from the TMDB API.
 type CreditsResponse struct {
 	Cast []CastMember `json:"cast"`
+	Crew []CrewMember `json:"crew"`
+}
+
+// WatchProvider is a service offering a title, as listed by TMDB.
+type WatchProvider struct {
+	ProviderID      int    `json:"provider_id"`
+	ProviderName    string `json:"provider_name"`
+	LogoPath        string `json:"logo_path"`
+	DisplayPriority int    `json:"display_priority"`
+}
+
+// RegionWatchProviders lists a region's providers by offer type. TMDB calls
+// subscription streaming "flatrate".
+type RegionWatchProviders struct {
+	Link     string          `json:"link"`
+	Flatrate []WatchProvider `json:"flatrate"`
+	Free     []WatchProvider `json:"free"`
+	Ads      []WatchProvider `json:"ads"`
+	Rent     []WatchProvider `json:"rent"`
+	Buy      []WatchProvider `json:"buy"`
+}
+
+// WatchProvidersResponse represents watch providers from the TMDB API, keyed
+// by ISO 3166-1 region code.
+type WatchProvidersResponse struct {
+	ID      int                             `json:"id"`
+	Results map[string]RegionWatchProviders `json:"results"`
 }
 
 // NewClient creates a new TMDB API client.
@@ -127,6 +198,10 @@ func (c *Client) fetch(path string, target interface{}) error {
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("tmdb api error: status %d", resp.StatusCode)
 	}
@@ -247,14 +322,63 @@ func (c *Client) GetCredits(mediaType string, id int) (*CreditsResponse, error)
 	return &res, nil
 }
 
+// GetKeywords returns the keywords tagging a title.
+func (c *Client) GetKeywords(mediaType string, id int) ([]Keyword, error) {
+	var res KeywordsResponse
+	path := fmt.Sprintf("/%s/%d/keywords", mediaType, id)
+	if err := c.fetch(path, &res); err != nil {
+		return nil, err
+	}
+
+	if mediaType == "tv" {
+		return res.Results, nil
+	}
+
+	return res.Keywords, nil
+}
+
+// GetSimilar returns titles similar to a title by genre and keywords.
+func (c *Client) GetSimilar(mediaType string, id int, page int) ([]models.Movie, error) {
+	var res MovieListResponse
+	path := fmt.Sprintf("/%s/%d/similar?page=%d", mediaType, id, page)
+	if err := c.fetch(path, &res); err != nil {
+		return nil, err
+	}
+
+	return toDomainListWithDefault(res.Results, mediaType), nil
+}
+
+// GetRecommendations returns titles TMDB recommends to viewers of a title.
+func (c *Client) GetRecommendations(mediaType string, id int, page int) ([]models.Movie, error) {
+	var res MovieListResponse
+	path := fmt.Sprintf("/%s/%d/recommendations?page=%d", mediaType, id, page)
+	if err := c.fetch(path, &res); err != nil {
+		return nil, err
+	}
+
+	return toDomainListWithDefault(res.Results, mediaType), nil
+}
+
 // GetProviders returns streaming provider information for a title.
-func (c *Client) GetProviders(mediaType string, id int) (json.RawMessage, error) {
-	var res json.RawMessage
+func (c *Client) GetProviders(mediaType string, id int) (*WatchProvidersResponse, error) {
+	var res WatchProvidersResponse
 	path := fmt.Sprintf("/%s/%d/watch/providers", mediaType, id)
 
 	if err := c.fetch(path, &res); err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	return &res, nil
+}
+
+// GetGenres returns the official genres for movies or TV shows.
+func (c *Client) GetGenres(mediaType string) ([]Genre, error) {
+	var res GenreListResponse
+	path := fmt.Sprintf("/genre/%s/list", mediaType)
+
+	if err := c.fetch(path, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Genres, nil
 }
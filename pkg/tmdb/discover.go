@@ -0,0 +1,141 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// Watch monetization types accepted by TMDB's discover endpoints.
+const (
+	MonetizationFlatrate = "flatrate"
+	MonetizationFree     = "free"
+	MonetizationAds      = "ads"
+	MonetizationRent     = "rent"
+	MonetizationBuy      = "buy"
+)
+
+// DiscoverParams are the filters passed to /discover/movie and /discover/tv.
+// Zero values are left out of the request.
+type DiscoverParams struct {
+	// Genres must all match, or with AnyGenre any one of them.
+	Genres   []int
+	AnyGenre bool
+
+	// YearFrom and YearTo bound the release year of movies or the first air
+	// year of TV shows, inclusive.
+	YearFrom int
+	YearTo   int
+
+	MinVoteAverage float64
+	MinVoteCount   int
+
+	// MinRuntime and MaxRuntime are in minutes.
+	MinRuntime int
+	MaxRuntime int
+
+	// OriginalLanguage is an ISO 639-1 code.
+	OriginalLanguage string
+
+	// SortBy is a TMDB sort such as "vote_average.desc".
+	SortBy string
+
+	// WatchProviders matches titles offered by any of these TMDB provider
+	// IDs in WatchRegion, which TMDB requires alongside them.
+	WatchProviders    []int
+	WatchRegion       string
+	MonetizationTypes []string
+
+	Page int
+}
+
+// Query encodes the params as TMDB query parameters for mediaType. The
+// encoding is deterministic, so it doubles as a cache key.
+func (p DiscoverParams) Query(mediaType string) url.Values {
+	q := url.Values{}
+	if len(p.Genres) > 0 {
+		sep := ","
+		if p.AnyGenre {
+			sep = "|"
+		}
+		q.Set("with_genres", joinInts(p.Genres, sep))
+	}
+
+	dateField := "primary_release_date"
+	if mediaType == "tv" {
+		dateField = "first_air_date"
+	}
+
+	if p.YearFrom > 0 {
+		q.Set(dateField+".gte", fmt.Sprintf("%04d-01-01", p.YearFrom))
+	}
+
+	if p.YearTo > 0 {
+		q.Set(dateField+".lte", fmt.Sprintf("%04d-12-31", p.YearTo))
+	}
+
+	if p.MinVoteAverage > 0 {
+		q.Set("vote_average.gte", strconv.FormatFloat(p.MinVoteAverage, 'f', -1, 64))
+	}
+
+	if p.MinVoteCount > 0 {
+		q.Set("vote_count.gte", strconv.Itoa(p.MinVoteCount))
+	}
+
+	if p.MinRuntime > 0 {
+		q.Set("with_runtime.gte", strconv.Itoa(p.MinRuntime))
+	}
+
+	if p.MaxRuntime > 0 {
+		q.Set("with_runtime.lte", strconv.Itoa(p.MaxRuntime))
+	}
+
+	if p.OriginalLanguage != "" {
+		q.Set("with_original_language", p.OriginalLanguage)
+	}
+
+	if p.SortBy != "" {
+		q.Set("sort_by", p.SortBy)
+	}
+
+	if len(p.WatchProviders) > 0 {
+		q.Set("with_watch_providers", joinInts(p.WatchProviders, "|"))
+	}
+
+	if p.WatchRegion != "" {
+		q.Set("watch_region", p.WatchRegion)
+	}
+
+	if len(p.MonetizationTypes) > 0 {
+		q.Set("with_watch_monetization_types", strings.Join(p.MonetizationTypes, "|"))
+	}
+
+	if p.Page > 0 {
+		q.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return q
+}
+
+// Discover returns movies or TV shows matching params.
+func (c *Client) Discover(mediaType string, params DiscoverParams) ([]models.Movie, error) {
+	var res MovieListResponse
+	path := fmt.Sprintf("/discover/%s?%s", mediaType, params.Query(mediaType).Encode())
+	if err := c.fetch(path, &res); err != nil {
+		return nil, err
+	}
+
+	return toDomainListWithDefault(res.Results, mediaType), nil
+}
+
+func joinInts(values []int, sep string) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,61 @@
+package tmdb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+func TestDiscoverParams_Query(t *testing.T) {
+	tests := map[string]struct {
+		mediaType string
+		params    tmdb.DiscoverParams
+		want      string
+	}{
+		"empty": {"movie", tmdb.DiscoverParams{}, ""},
+		"watch providers": {"movie", tmdb.DiscoverParams{
+			Genres:            []int{28, 878},
+			WatchProviders:    []int{8, 15},
+			WatchRegion:       "US",
+			MonetizationTypes: []string{tmdb.MonetizationFlatrate, tmdb.MonetizationAds},
+			Page:              2,
+		}, "page=2&watch_region=US&with_genres=28%2C878&with_watch_monetization_types=flatrate%7Cads&with_watch_providers=8%7C15"},
+		"movie filters": {"movie", tmdb.DiscoverParams{
+			Genres:           []int{28, 12},
+			AnyGenre:         true,
+			YearFrom:         1990,
+			YearTo:           1999,
+			MinVoteAverage:   7.5,
+			MinVoteCount:     200,
+			MinRuntime:       90,
+			MaxRuntime:       150,
+			OriginalLanguage: "en",
+			SortBy:           "vote_average.desc",
+		}, "primary_release_date.gte=1990-01-01&primary_release_date.lte=1999-12-31&sort_by=vote_average.desc&vote_average.gte=7.5&vote_count.gte=200&with_genres=28%7C12&with_original_language=en&with_runtime.gte=90&with_runtime.lte=150"},
+		"tv years": {"tv", tmdb.DiscoverParams{YearFrom: 2010}, "first_air_date.gte=2010-01-01"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.params.Query(tt.mediaType).Encode())
+		})
+	}
+}
+
+func TestDiscover_CacheHit(t *testing.T) {
+	client, inner := newCachedClient(t)
+	params := tmdb.DiscoverParams{WatchProviders: []int{8}, WatchRegion: "US", Page: 1}
+	inner.On("Discover", "movie", params).Return([]models.Movie{{ID: 550}}, nil).Once()
+	inner.On("Discover", "tv", params).Return([]models.Movie{{ID: 1399}}, nil).Once()
+
+	first, _ := client.Discover("movie", params)
+	second, _ := client.Discover("movie", params)
+	tv, _ := client.Discover("tv", params)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1399, tv[0].ID)
+
+	inner.AssertNumberOfCalls(t, "Discover", 2)
+}
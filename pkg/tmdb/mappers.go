@@ -21,6 +21,7 @@ func (m Movie) ToDomain() models.Movie {
 		ReleaseDate:  m.ReleaseDate,
 		VoteAverage:  m.VoteAverage,
 		MediaType:    m.MediaType,
+		GenreIDs:     m.GenreIDs,
 	}
 }
 
@@ -46,3 +47,182 @@ func toDomainListWithDefault(tmdbMovies []Movie, defaultMediaType string) []mode
 
 	return domain
 }
+
+// ToDomain converts TMDB watch providers into our internal model.
+func (w WatchProvidersResponse) ToDomain(mediaType string) models.WatchProviders {
+	regions := make(map[string]models.RegionWatchProviders, len(w.Results))
+	for code, r := range w.Results {
+		regions[code] = models.RegionWatchProviders{
+			Link:   r.Link,
+			Stream: toDomainProviders(r.Flatrate),
+			Free:   toDomainProviders(r.Free),
+			Ads:    toDomainProviders(r.Ads),
+			Rent:   toDomainProviders(r.Rent),
+			Buy:    toDomainProviders(r.Buy),
+		}
+	}
+
+	return models.WatchProviders{
+		TMDBID:    w.ID,
+		MediaType: mediaType,
+		Regions:   regions,
+	}
+}
+
+func toDomainProviders(providers []WatchProvider) []models.WatchProvider {
+	if len(providers) == 0 {
+		return nil
+	}
+
+	domain := make([]models.WatchProvider, len(providers))
+	for i, p := range providers {
+		domain[i] = models.WatchProvider{
+			ProviderID:      p.ProviderID,
+			Name:            p.ProviderName,
+			LogoPath:        p.LogoPath,
+			DisplayPriority: p.DisplayPriority,
+		}
+	}
+
+	return domain
+}
+
+// ToDomain converts a TMDB TV show into our internal model.
+func (d TVDetail) ToDomain() models.TVShow {
+	show := models.TVShow{
+		ID:               d.ID,
+		Name:             d.Name,
+		OriginalName:     d.OriginalName,
+		Overview:         d.Overview,
+		PosterPath:       d.PosterPath,
+		BackdropPath:     d.BackdropPath,
+		FirstAirDate:     d.FirstAirDate,
+		LastAirDate:      d.LastAirDate,
+		Status:           d.Status,
+		InProduction:     d.InProduction,
+		NumberOfSeasons:  d.NumberOfSeasons,
+		NumberOfEpisodes: d.NumberOfEpisodes,
+		EpisodeRunTime:   d.EpisodeRunTime,
+		VoteAverage:      d.VoteAverage,
+		Genres:           make([]models.Genre, len(d.Genres)),
+		Networks:         make([]models.Network, len(d.Networks)),
+		Seasons:          make([]models.SeasonSummary, len(d.Seasons)),
+	}
+
+	for i, g := range d.Genres {
+		show.Genres[i] = models.Genre{ID: g.ID, Name: g.Name, MediaType: "tv"}
+	}
+
+	for i, n := range d.Networks {
+		show.Networks[i] = models.Network(n)
+	}
+
+	for i, s := range d.Seasons {
+		show.Seasons[i] = models.SeasonSummary{
+			SeasonNumber: s.SeasonNumber,
+			Name:         s.Name,
+			Overview:     s.Overview,
+			EpisodeCount: s.EpisodeCount,
+			AirDate:      s.AirDate,
+			PosterPath:   s.PosterPath,
+		}
+	}
+
+	if d.LastEpisodeToAir != nil {
+		last := d.LastEpisodeToAir.ToDomain(d.ID)
+		show.LastEpisode = &last
+	}
+
+	if d.NextEpisodeToAir != nil {
+		next := d.NextEpisodeToAir.ToDomain(d.ID)
+		show.NextEpisode = &next
+		show.NextAirDate = next.AirDate
+	}
+
+	return show
+}
+
+// ToDomain converts a TMDB season of the show showID into our internal model.
+func (s Season) ToDomain(showID int) models.Season {
+	season := models.Season{
+		ShowID:       showID,
+		SeasonNumber: s.SeasonNumber,
+		Name:         s.Name,
+		Overview:     s.Overview,
+		AirDate:      s.AirDate,
+		PosterPath:   s.PosterPath,
+		Episodes:     make([]models.Episode, len(s.Episodes)),
+	}
+
+	for i, e := range s.Episodes {
+		season.Episodes[i] = e.ToDomain(showID)
+	}
+
+	return season
+}
+
+// ToDomain converts a TMDB episode of the show showID into our internal model.
+func (e Episode) ToDomain(showID int) models.Episode {
+	return models.Episode{
+		ID:            e.ID,
+		ShowID:        showID,
+		SeasonNumber:  e.SeasonNumber,
+		EpisodeNumber: e.EpisodeNumber,
+		Name:          e.Name,
+		Overview:      e.Overview,
+		AirDate:       e.AirDate,
+		Runtime:       e.Runtime,
+		StillPath:     e.StillPath,
+		VoteAverage:   e.VoteAverage,
+	}
+}
+
+// ToDomain converts a TMDB person into our internal model.
+func (p PersonDetail) ToDomain() models.Person {
+	return models.Person(p)
+}
+
+// ToDomain converts a TMDB person's combined credits into our internal model.
+func (r CombinedCreditsResponse) ToDomain() models.PersonCredits {
+	credits := models.PersonCredits{
+		Cast: make([]models.PersonCredit, len(r.Cast)),
+		Crew: make([]models.PersonCredit, len(r.Crew)),
+	}
+
+	for i, c := range r.Cast {
+		credits.Cast[i] = c.ToDomain()
+	}
+
+	for i, c := range r.Crew {
+		credits.Crew[i] = c.ToDomain()
+	}
+
+	return credits
+}
+
+// ToDomain converts a TMDB person credit into our internal model, taking the
+// title and date from whichever of the movie or TV fields is set.
+func (c PersonCredit) ToDomain() models.PersonCredit {
+	credit := models.PersonCredit{
+		ID:           c.ID,
+		MediaType:    c.MediaType,
+		Title:        c.Title,
+		ReleaseDate:  c.ReleaseDate,
+		PosterPath:   c.PosterPath,
+		VoteAverage:  c.VoteAverage,
+		Character:    c.Character,
+		Job:          c.Job,
+		Department:   c.Department,
+		EpisodeCount: c.EpisodeCount,
+	}
+
+	if credit.Title == "" {
+		credit.Title = c.Name
+	}
+
+	if credit.ReleaseDate == "" {
+		credit.ReleaseDate = c.FirstAirDate
+	}
+
+	return credit
+}
@@ -3,8 +3,6 @@
 package mocks
 
 import (
-	json "encoding/json"
-
 	models "github.com/milansax96/movie-terminal-api/internal/models"
 	tmdb "github.com/milansax96/movie-terminal-api/pkg/tmdb"
 	mock "github.com/stretchr/testify/mock"
@@ -23,6 +21,65 @@ func (_m *MockAPI) EXPECT() *MockAPI_Expecter {
 	return &MockAPI_Expecter{mock: &_m.Mock}
 }
 
+// Discover provides a mock function with given fields: mediaType, params
+func (_m *MockAPI) Discover(mediaType string, params tmdb.DiscoverParams) ([]models.Movie, error) {
+	ret := _m.Called(mediaType, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Discover")
+	}
+
+	var r0 []models.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, tmdb.DiscoverParams) ([]models.Movie, error)); ok {
+		return rf(mediaType, params)
+	}
+	if rf, ok := ret.Get(0).(func(string, tmdb.DiscoverParams) []models.Movie); ok {
+		r0 = rf(mediaType, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, tmdb.DiscoverParams) error); ok {
+		r1 = rf(mediaType, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_Discover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Discover'
+type MockAPI_Discover_Call struct {
+	*mock.Call
+}
+
+// Discover is a helper method to define mock.On call
+//   - mediaType string
+//   - params tmdb.DiscoverParams
+func (_e *MockAPI_Expecter) Discover(mediaType interface{}, params interface{}) *MockAPI_Discover_Call {
+	return &MockAPI_Discover_Call{Call: _e.mock.On("Discover", mediaType, params)}
+}
+
+func (_c *MockAPI_Discover_Call) Run(run func(mediaType string, params tmdb.DiscoverParams)) *MockAPI_Discover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(tmdb.DiscoverParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_Discover_Call) Return(_a0 []models.Movie, _a1 error) *MockAPI_Discover_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_Discover_Call) RunAndReturn(run func(string, tmdb.DiscoverParams) ([]models.Movie, error)) *MockAPI_Discover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DiscoverByGenre provides a mock function with given fields: genreID, page
 func (_m *MockAPI) DiscoverByGenre(genreID int, page int) ([]models.Movie, error) {
 	ret := _m.Called(genreID, page)
@@ -141,6 +198,183 @@ func (_c *MockAPI_GetCredits_Call) RunAndReturn(run func(string, int) (*tmdb.Cre
 	return _c
 }
 
+// GetEpisode provides a mock function with given fields: tvID, seasonNumber, episodeNumber
+func (_m *MockAPI) GetEpisode(tvID int, seasonNumber int, episodeNumber int) (*models.Episode, error) {
+	ret := _m.Called(tvID, seasonNumber, episodeNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEpisode")
+	}
+
+	var r0 *models.Episode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, int) (*models.Episode, error)); ok {
+		return rf(tvID, seasonNumber, episodeNumber)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, int) *models.Episode); ok {
+		r0 = rf(tvID, seasonNumber, episodeNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Episode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, int) error); ok {
+		r1 = rf(tvID, seasonNumber, episodeNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetEpisode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEpisode'
+type MockAPI_GetEpisode_Call struct {
+	*mock.Call
+}
+
+// GetEpisode is a helper method to define mock.On call
+//   - tvID int
+//   - seasonNumber int
+//   - episodeNumber int
+func (_e *MockAPI_Expecter) GetEpisode(tvID interface{}, seasonNumber interface{}, episodeNumber interface{}) *MockAPI_GetEpisode_Call {
+	return &MockAPI_GetEpisode_Call{Call: _e.mock.On("GetEpisode", tvID, seasonNumber, episodeNumber)}
+}
+
+func (_c *MockAPI_GetEpisode_Call) Run(run func(tvID int, seasonNumber int, episodeNumber int)) *MockAPI_GetEpisode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetEpisode_Call) Return(_a0 *models.Episode, _a1 error) *MockAPI_GetEpisode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetEpisode_Call) RunAndReturn(run func(int, int, int) (*models.Episode, error)) *MockAPI_GetEpisode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGenres provides a mock function with given fields: mediaType
+func (_m *MockAPI) GetGenres(mediaType string) ([]tmdb.Genre, error) {
+	ret := _m.Called(mediaType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGenres")
+	}
+
+	var r0 []tmdb.Genre
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]tmdb.Genre, error)); ok {
+		return rf(mediaType)
+	}
+	if rf, ok := ret.Get(0).(func(string) []tmdb.Genre); ok {
+		r0 = rf(mediaType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Genre)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(mediaType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetGenres_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGenres'
+type MockAPI_GetGenres_Call struct {
+	*mock.Call
+}
+
+// GetGenres is a helper method to define mock.On call
+//   - mediaType string
+func (_e *MockAPI_Expecter) GetGenres(mediaType interface{}) *MockAPI_GetGenres_Call {
+	return &MockAPI_GetGenres_Call{Call: _e.mock.On("GetGenres", mediaType)}
+}
+
+func (_c *MockAPI_GetGenres_Call) Run(run func(mediaType string)) *MockAPI_GetGenres_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetGenres_Call) Return(_a0 []tmdb.Genre, _a1 error) *MockAPI_GetGenres_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetGenres_Call) RunAndReturn(run func(string) ([]tmdb.Genre, error)) *MockAPI_GetGenres_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetKeywords provides a mock function with given fields: mediaType, id
+func (_m *MockAPI) GetKeywords(mediaType string, id int) ([]tmdb.Keyword, error) {
+	ret := _m.Called(mediaType, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKeywords")
+	}
+
+	var r0 []tmdb.Keyword
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]tmdb.Keyword, error)); ok {
+		return rf(mediaType, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []tmdb.Keyword); ok {
+		r0 = rf(mediaType, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Keyword)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(mediaType, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetKeywords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKeywords'
+type MockAPI_GetKeywords_Call struct {
+	*mock.Call
+}
+
+// GetKeywords is a helper method to define mock.On call
+//   - mediaType string
+//   - id int
+func (_e *MockAPI_Expecter) GetKeywords(mediaType interface{}, id interface{}) *MockAPI_GetKeywords_Call {
+	return &MockAPI_GetKeywords_Call{Call: _e.mock.On("GetKeywords", mediaType, id)}
+}
+
+func (_c *MockAPI_GetKeywords_Call) Run(run func(mediaType string, id int)) *MockAPI_GetKeywords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetKeywords_Call) Return(_a0 []tmdb.Keyword, _a1 error) *MockAPI_GetKeywords_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetKeywords_Call) RunAndReturn(run func(string, int) ([]tmdb.Keyword, error)) *MockAPI_GetKeywords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMovieDetails provides a mock function with given fields: mediaType, id
 func (_m *MockAPI) GetMovieDetails(mediaType string, id int) (*tmdb.MovieDetail, error) {
 	ret := _m.Called(mediaType, id)
@@ -258,6 +492,180 @@ func (_c *MockAPI_GetNowPlaying_Call) RunAndReturn(run func(int) ([]models.Movie
 	return _c
 }
 
+// GetPerson provides a mock function with given fields: id
+func (_m *MockAPI) GetPerson(id int) (*models.Person, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPerson")
+	}
+
+	var r0 *models.Person
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Person, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Person); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Person)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetPerson_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPerson'
+type MockAPI_GetPerson_Call struct {
+	*mock.Call
+}
+
+// GetPerson is a helper method to define mock.On call
+//   - id int
+func (_e *MockAPI_Expecter) GetPerson(id interface{}) *MockAPI_GetPerson_Call {
+	return &MockAPI_GetPerson_Call{Call: _e.mock.On("GetPerson", id)}
+}
+
+func (_c *MockAPI_GetPerson_Call) Run(run func(id int)) *MockAPI_GetPerson_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetPerson_Call) Return(_a0 *models.Person, _a1 error) *MockAPI_GetPerson_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetPerson_Call) RunAndReturn(run func(int) (*models.Person, error)) *MockAPI_GetPerson_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonCredits provides a mock function with given fields: id
+func (_m *MockAPI) GetPersonCredits(id int) (*models.PersonCredits, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonCredits")
+	}
+
+	var r0 *models.PersonCredits
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.PersonCredits, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.PersonCredits); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PersonCredits)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetPersonCredits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonCredits'
+type MockAPI_GetPersonCredits_Call struct {
+	*mock.Call
+}
+
+// GetPersonCredits is a helper method to define mock.On call
+//   - id int
+func (_e *MockAPI_Expecter) GetPersonCredits(id interface{}) *MockAPI_GetPersonCredits_Call {
+	return &MockAPI_GetPersonCredits_Call{Call: _e.mock.On("GetPersonCredits", id)}
+}
+
+func (_c *MockAPI_GetPersonCredits_Call) Run(run func(id int)) *MockAPI_GetPersonCredits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetPersonCredits_Call) Return(_a0 *models.PersonCredits, _a1 error) *MockAPI_GetPersonCredits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetPersonCredits_Call) RunAndReturn(run func(int) (*models.PersonCredits, error)) *MockAPI_GetPersonCredits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonImages provides a mock function with given fields: id
+func (_m *MockAPI) GetPersonImages(id int) ([]models.PersonImage, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonImages")
+	}
+
+	var r0 []models.PersonImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]models.PersonImage, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) []models.PersonImage); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PersonImage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetPersonImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonImages'
+type MockAPI_GetPersonImages_Call struct {
+	*mock.Call
+}
+
+// GetPersonImages is a helper method to define mock.On call
+//   - id int
+func (_e *MockAPI_Expecter) GetPersonImages(id interface{}) *MockAPI_GetPersonImages_Call {
+	return &MockAPI_GetPersonImages_Call{Call: _e.mock.On("GetPersonImages", id)}
+}
+
+func (_c *MockAPI_GetPersonImages_Call) Run(run func(id int)) *MockAPI_GetPersonImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetPersonImages_Call) Return(_a0 []models.PersonImage, _a1 error) *MockAPI_GetPersonImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetPersonImages_Call) RunAndReturn(run func(int) ([]models.PersonImage, error)) *MockAPI_GetPersonImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPopular provides a mock function with given fields: page
 func (_m *MockAPI) GetPopular(page int) ([]models.Movie, error) {
 	ret := _m.Called(page)
@@ -317,23 +725,23 @@ func (_c *MockAPI_GetPopular_Call) RunAndReturn(run func(int) ([]models.Movie, e
 }
 
 // GetProviders provides a mock function with given fields: mediaType, id
-func (_m *MockAPI) GetProviders(mediaType string, id int) (json.RawMessage, error) {
+func (_m *MockAPI) GetProviders(mediaType string, id int) (*tmdb.WatchProvidersResponse, error) {
 	ret := _m.Called(mediaType, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProviders")
 	}
 
-	var r0 json.RawMessage
+	var r0 *tmdb.WatchProvidersResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int) (json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(string, int) (*tmdb.WatchProvidersResponse, error)); ok {
 		return rf(mediaType, id)
 	}
-	if rf, ok := ret.Get(0).(func(string, int) json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(string, int) *tmdb.WatchProvidersResponse); ok {
 		r0 = rf(mediaType, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(json.RawMessage)
+			r0 = ret.Get(0).(*tmdb.WatchProvidersResponse)
 		}
 	}
 
@@ -365,12 +773,308 @@ func (_c *MockAPI_GetProviders_Call) Run(run func(mediaType string, id int)) *Mo
 	return _c
 }
 
-func (_c *MockAPI_GetProviders_Call) Return(_a0 json.RawMessage, _a1 error) *MockAPI_GetProviders_Call {
+func (_c *MockAPI_GetProviders_Call) Return(_a0 *tmdb.WatchProvidersResponse, _a1 error) *MockAPI_GetProviders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetProviders_Call) RunAndReturn(run func(string, int) (*tmdb.WatchProvidersResponse, error)) *MockAPI_GetProviders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecommendations provides a mock function with given fields: mediaType, id, page
+func (_m *MockAPI) GetRecommendations(mediaType string, id int, page int) ([]models.Movie, error) {
+	ret := _m.Called(mediaType, id, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecommendations")
+	}
+
+	var r0 []models.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]models.Movie, error)); ok {
+		return rf(mediaType, id, page)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []models.Movie); ok {
+		r0 = rf(mediaType, id, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(mediaType, id, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecommendations'
+type MockAPI_GetRecommendations_Call struct {
+	*mock.Call
+}
+
+// GetRecommendations is a helper method to define mock.On call
+//   - mediaType string
+//   - id int
+//   - page int
+func (_e *MockAPI_Expecter) GetRecommendations(mediaType interface{}, id interface{}, page interface{}) *MockAPI_GetRecommendations_Call {
+	return &MockAPI_GetRecommendations_Call{Call: _e.mock.On("GetRecommendations", mediaType, id, page)}
+}
+
+func (_c *MockAPI_GetRecommendations_Call) Run(run func(mediaType string, id int, page int)) *MockAPI_GetRecommendations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetRecommendations_Call) Return(_a0 []models.Movie, _a1 error) *MockAPI_GetRecommendations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetRecommendations_Call) RunAndReturn(run func(string, int, int) ([]models.Movie, error)) *MockAPI_GetRecommendations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeason provides a mock function with given fields: tvID, seasonNumber
+func (_m *MockAPI) GetSeason(tvID int, seasonNumber int) (*models.Season, error) {
+	ret := _m.Called(tvID, seasonNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeason")
+	}
+
+	var r0 *models.Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) (*models.Season, error)); ok {
+		return rf(tvID, seasonNumber)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) *models.Season); ok {
+		r0 = rf(tvID, seasonNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Season)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(tvID, seasonNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeason'
+type MockAPI_GetSeason_Call struct {
+	*mock.Call
+}
+
+// GetSeason is a helper method to define mock.On call
+//   - tvID int
+//   - seasonNumber int
+func (_e *MockAPI_Expecter) GetSeason(tvID interface{}, seasonNumber interface{}) *MockAPI_GetSeason_Call {
+	return &MockAPI_GetSeason_Call{Call: _e.mock.On("GetSeason", tvID, seasonNumber)}
+}
+
+func (_c *MockAPI_GetSeason_Call) Run(run func(tvID int, seasonNumber int)) *MockAPI_GetSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetSeason_Call) Return(_a0 *models.Season, _a1 error) *MockAPI_GetSeason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetSeason_Call) RunAndReturn(run func(int, int) (*models.Season, error)) *MockAPI_GetSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSimilar provides a mock function with given fields: mediaType, id, page
+func (_m *MockAPI) GetSimilar(mediaType string, id int, page int) ([]models.Movie, error) {
+	ret := _m.Called(mediaType, id, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSimilar")
+	}
+
+	var r0 []models.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]models.Movie, error)); ok {
+		return rf(mediaType, id, page)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []models.Movie); ok {
+		r0 = rf(mediaType, id, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(mediaType, id, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetSimilar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimilar'
+type MockAPI_GetSimilar_Call struct {
+	*mock.Call
+}
+
+// GetSimilar is a helper method to define mock.On call
+//   - mediaType string
+//   - id int
+//   - page int
+func (_e *MockAPI_Expecter) GetSimilar(mediaType interface{}, id interface{}, page interface{}) *MockAPI_GetSimilar_Call {
+	return &MockAPI_GetSimilar_Call{Call: _e.mock.On("GetSimilar", mediaType, id, page)}
+}
+
+func (_c *MockAPI_GetSimilar_Call) Run(run func(mediaType string, id int, page int)) *MockAPI_GetSimilar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetSimilar_Call) Return(_a0 []models.Movie, _a1 error) *MockAPI_GetSimilar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetSimilar_Call) RunAndReturn(run func(string, int, int) ([]models.Movie, error)) *MockAPI_GetSimilar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTVDetails provides a mock function with given fields: id
+func (_m *MockAPI) GetTVDetails(id int) (*models.TVShow, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTVDetails")
+	}
+
+	var r0 *models.TVShow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.TVShow, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.TVShow); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TVShow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetTVDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTVDetails'
+type MockAPI_GetTVDetails_Call struct {
+	*mock.Call
+}
+
+// GetTVDetails is a helper method to define mock.On call
+//   - id int
+func (_e *MockAPI_Expecter) GetTVDetails(id interface{}) *MockAPI_GetTVDetails_Call {
+	return &MockAPI_GetTVDetails_Call{Call: _e.mock.On("GetTVDetails", id)}
+}
+
+func (_c *MockAPI_GetTVDetails_Call) Run(run func(id int)) *MockAPI_GetTVDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetTVDetails_Call) Return(_a0 *models.TVShow, _a1 error) *MockAPI_GetTVDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetTVDetails_Call) RunAndReturn(run func(int) (*models.TVShow, error)) *MockAPI_GetTVDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTVList provides a mock function with given fields: category, page
+func (_m *MockAPI) GetTVList(category string, page int) ([]models.Movie, error) {
+	ret := _m.Called(category, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTVList")
+	}
+
+	var r0 []models.Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]models.Movie, error)); ok {
+		return rf(category, page)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []models.Movie); ok {
+		r0 = rf(category, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(category, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetTVList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTVList'
+type MockAPI_GetTVList_Call struct {
+	*mock.Call
+}
+
+// GetTVList is a helper method to define mock.On call
+//   - category string
+//   - page int
+func (_e *MockAPI_Expecter) GetTVList(category interface{}, page interface{}) *MockAPI_GetTVList_Call {
+	return &MockAPI_GetTVList_Call{Call: _e.mock.On("GetTVList", category, page)}
+}
+
+func (_c *MockAPI_GetTVList_Call) Run(run func(category string, page int)) *MockAPI_GetTVList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetTVList_Call) Return(_a0 []models.Movie, _a1 error) *MockAPI_GetTVList_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAPI_GetProviders_Call) RunAndReturn(run func(string, int) (json.RawMessage, error)) *MockAPI_GetProviders_Call {
+func (_c *MockAPI_GetTVList_Call) RunAndReturn(run func(string, int) ([]models.Movie, error)) *MockAPI_GetTVList_Call {
 	_c.Call.Return(run)
 	return _c
 }
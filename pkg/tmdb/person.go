@@ -0,0 +1,100 @@
+package tmdb
+
+import (
+	"fmt"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// PersonDetail represents a person from the TMDB API.
+type PersonDetail struct {
+	ID                 int      `json:"id"`
+	Name               string   `json:"name"`
+	AlsoKnownAs        []string `json:"also_known_as"`
+	Biography          string   `json:"biography"`
+	Birthday           string   `json:"birthday"`
+	Deathday           string   `json:"deathday"`
+	PlaceOfBirth       string   `json:"place_of_birth"`
+	KnownForDepartment string   `json:"known_for_department"`
+	ProfilePath        string   `json:"profile_path"`
+	Homepage           string   `json:"homepage"`
+	IMDbID             string   `json:"imdb_id"`
+	Popularity         float64  `json:"popularity"`
+}
+
+// PersonCredit represents a movie or TV credit of a person from the TMDB
+// API. Movies have a Title and ReleaseDate; TV shows a Name and FirstAirDate.
+type PersonCredit struct {
+	ID           int     `json:"id"`
+	MediaType    string  `json:"media_type"`
+	Title        string  `json:"title"`
+	Name         string  `json:"name"`
+	ReleaseDate  string  `json:"release_date"`
+	FirstAirDate string  `json:"first_air_date"`
+	PosterPath   string  `json:"poster_path"`
+	VoteAverage  float64 `json:"vote_average"`
+	Character    string  `json:"character"`
+	Job          string  `json:"job"`
+	Department   string  `json:"department"`
+	EpisodeCount int     `json:"episode_count"`
+}
+
+// CombinedCreditsResponse represents a person's movie and TV credits from the
+// TMDB API.
+type CombinedCreditsResponse struct {
+	Cast []PersonCredit `json:"cast"`
+	Crew []PersonCredit `json:"crew"`
+}
+
+// ProfileImage represents a profile photo from the TMDB API.
+type ProfileImage struct {
+	FilePath    string  `json:"file_path"`
+	AspectRatio float64 `json:"aspect_ratio"`
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	VoteAverage float64 `json:"vote_average"`
+}
+
+// PersonImagesResponse represents a person's profile photos from the TMDB API.
+type PersonImagesResponse struct {
+	Profiles []ProfileImage `json:"profiles"`
+}
+
+// GetPerson returns a person's biography and details.
+func (c *Client) GetPerson(id int) (*models.Person, error) {
+	var res PersonDetail
+	if err := c.fetch(fmt.Sprintf("/person/%d", id), &res); err != nil {
+		return nil, err
+	}
+
+	person := res.ToDomain()
+
+	return &person, nil
+}
+
+// GetPersonCredits returns a person's movie and TV credits.
+func (c *Client) GetPersonCredits(id int) (*models.PersonCredits, error) {
+	var res CombinedCreditsResponse
+	if err := c.fetch(fmt.Sprintf("/person/%d/combined_credits", id), &res); err != nil {
+		return nil, err
+	}
+
+	credits := res.ToDomain()
+
+	return &credits, nil
+}
+
+// GetPersonImages returns a person's profile photos.
+func (c *Client) GetPersonImages(id int) ([]models.PersonImage, error) {
+	var res PersonImagesResponse
+	if err := c.fetch(fmt.Sprintf("/person/%d/images", id), &res); err != nil {
+		return nil, err
+	}
+
+	images := make([]models.PersonImage, len(res.Profiles))
+	for i, p := range res.Profiles {
+		images[i] = models.PersonImage(p)
+	}
+
+	return images, nil
+}
@@ -0,0 +1,55 @@
+package tmdb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+func TestClient_GetPersonCredits(t *testing.T) {
+	client := newTestClient(t, map[string]string{"/person/287/combined_credits": `{
+		"cast": [
+			{"id": 550, "media_type": "movie", "title": "Fight Club", "release_date": "1999-10-15", "character": "Tyler Durden"},
+			{"id": 1400, "media_type": "tv", "name": "Friends", "first_air_date": "1994-09-22", "character": "Will Colbert", "episode_count": 1}
+		],
+		"crew": [{"id": 1422, "media_type": "movie", "title": "Moneyball", "job": "Producer", "department": "Production"}]
+	}`})
+
+	credits, err := client.GetPersonCredits(287)
+	require.NoError(t, err)
+
+	require.Len(t, credits.Cast, 2)
+	assert.Equal(t, "Tyler Durden", credits.Cast[0].Character)
+	assert.Equal(t, "Friends", credits.Cast[1].Title, "TV names become titles")
+	assert.Equal(t, "1994-09-22", credits.Cast[1].ReleaseDate)
+	require.Len(t, credits.Crew, 1)
+	assert.Equal(t, "Producer", credits.Crew[0].Job)
+}
+
+func TestClient_GetCredits_IncludesCrew(t *testing.T) {
+	client := newTestClient(t, map[string]string{"/movie/550/credits": `{
+		"cast": [{"id": 287, "name": "Brad Pitt", "character": "Tyler Durden"}],
+		"crew": [{"id": 7467, "name": "David Fincher", "job": "Director", "department": "Directing"}]
+	}`})
+
+	credits, err := client.GetCredits("movie", 550)
+	require.NoError(t, err)
+
+	require.Len(t, credits.Crew, 1)
+	assert.Equal(t, "Director", credits.Crew[0].Job)
+}
+
+func TestGetPersonCredits_CacheHit(t *testing.T) {
+	client, inner := newCachedClient(t)
+	inner.On("GetPersonCredits", 287).Return(&models.PersonCredits{}, nil).Once()
+
+	_, err := client.GetPersonCredits(287)
+	require.NoError(t, err)
+	_, err = client.GetPersonCredits(287)
+	require.NoError(t, err)
+
+	inner.AssertNumberOfCalls(t, "GetPersonCredits", 1)
+}
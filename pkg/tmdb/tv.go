@@ -0,0 +1,121 @@
+package tmdb
+
+import (
+	"fmt"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+)
+
+// TV list categories served by /tv/{category}.
+const (
+	TVPopular     = "popular"
+	TVOnTheAir    = "on_the_air"
+	TVAiringToday = "airing_today"
+	TVTopRated    = "top_rated"
+)
+
+// TVDetail represents a TV show from the TMDB API.
+type TVDetail struct {
+	ID               int       `json:"id"`
+	Name             string    `json:"name"`
+	OriginalName     string    `json:"original_name"`
+	Overview         string    `json:"overview"`
+	PosterPath       string    `json:"poster_path"`
+	BackdropPath     string    `json:"backdrop_path"`
+	FirstAirDate     string    `json:"first_air_date"`
+	LastAirDate      string    `json:"last_air_date"`
+	Status           string    `json:"status"`
+	InProduction     bool      `json:"in_production"`
+	NumberOfSeasons  int       `json:"number_of_seasons"`
+	NumberOfEpisodes int       `json:"number_of_episodes"`
+	EpisodeRunTime   []int     `json:"episode_run_time"`
+	VoteAverage      float64   `json:"vote_average"`
+	Genres           []Genre   `json:"genres"`
+	Networks         []Network `json:"networks"`
+	Seasons          []Season  `json:"seasons"`
+	LastEpisodeToAir *Episode  `json:"last_episode_to_air"`
+	NextEpisodeToAir *Episode  `json:"next_episode_to_air"`
+}
+
+// Network represents a TV network from the TMDB API.
+type Network struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	LogoPath      string `json:"logo_path"`
+	OriginCountry string `json:"origin_country"`
+}
+
+// Season represents a TV season from the TMDB API. Show details list seasons
+// with EpisodeCount; the season endpoint lists Episodes instead.
+type Season struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	Overview     string    `json:"overview"`
+	SeasonNumber int       `json:"season_number"`
+	EpisodeCount int       `json:"episode_count"`
+	AirDate      string    `json:"air_date"`
+	PosterPath   string    `json:"poster_path"`
+	Episodes     []Episode `json:"episodes"`
+}
+
+// Episode represents a TV episode from the TMDB API.
+type Episode struct {
+	ID            int     `json:"id"`
+	ShowID        int     `json:"show_id"`
+	Name          string  `json:"name"`
+	Overview      string  `json:"overview"`
+	SeasonNumber  int     `json:"season_number"`
+	EpisodeNumber int     `json:"episode_number"`
+	AirDate       string  `json:"air_date"`
+	Runtime       int     `json:"runtime"`
+	StillPath     string  `json:"still_path"`
+	VoteAverage   float64 `json:"vote_average"`
+}
+
+// GetTVList returns a page of TV shows in one of the TV list categories.
+func (c *Client) GetTVList(category string, page int) ([]models.Movie, error) {
+	var res MovieListResponse
+	path := fmt.Sprintf("/tv/%s?page=%d", category, page)
+	if err := c.fetch(path, &res); err != nil {
+		return nil, err
+	}
+
+	return toDomainListWithDefault(res.Results, "tv"), nil
+}
+
+// GetTVDetails returns a TV show with its seasons, networks and airing status.
+func (c *Client) GetTVDetails(id int) (*models.TVShow, error) {
+	var res TVDetail
+	if err := c.fetch(fmt.Sprintf("/tv/%d", id), &res); err != nil {
+		return nil, err
+	}
+
+	show := res.ToDomain()
+
+	return &show, nil
+}
+
+// GetSeason returns a season of a TV show with its episodes.
+func (c *Client) GetSeason(tvID int, seasonNumber int) (*models.Season, error) {
+	var res Season
+	if err := c.fetch(fmt.Sprintf("/tv/%d/season/%d", tvID, seasonNumber), &res); err != nil {
+		return nil, err
+	}
+
+	season := res.ToDomain(tvID)
+
+	return &season, nil
+}
+
+// GetEpisode returns a single episode of a TV show.
+func (c *Client) GetEpisode(tvID int, seasonNumber int, episodeNumber int) (*models.Episode, error) {
+	var res Episode
+	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d", tvID, seasonNumber, episodeNumber)
+	if err := c.fetch(path, &res); err != nil {
+		return nil, err
+	}
+
+	episode := res.ToDomain(tvID)
+
+	return &episode, nil
+}
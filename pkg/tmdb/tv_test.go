@@ -0,0 +1,132 @@
+package tmdb_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milansax96/movie-terminal-api/internal/models"
+	"github.com/milansax96/movie-terminal-api/pkg/tmdb"
+)
+
+func newTestClient(t *testing.T, routes map[string]string) *tmdb.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return &tmdb.Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+}
+
+func TestClient_GetTVDetails(t *testing.T) {
+	client := newTestClient(t, map[string]string{"/tv/1399": `{
+		"id": 1399, "name": "Game of Thrones", "status": "Ended", "number_of_seasons": 8,
+		"networks": [{"id": 49, "name": "HBO", "origin_country": "US"}],
+		"genres": [{"id": 18, "name": "Drama"}],
+		"seasons": [{"season_number": 0, "name": "Specials", "episode_count": 3}, {"season_number": 1, "name": "Season 1", "episode_count": 10}],
+		"next_episode_to_air": {"id": 7, "name": "Finale", "season_number": 8, "episode_number": 6, "air_date": "2019-05-19"}
+	}`})
+
+	show, err := client.GetTVDetails(1399)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Game of Thrones", show.Name)
+	assert.Equal(t, "Ended", show.Status)
+	assert.Equal(t, 8, show.NumberOfSeasons)
+	assert.Equal(t, []models.Network{{ID: 49, Name: "HBO", OriginCountry: "US"}}, show.Networks)
+	assert.Equal(t, "tv", show.Genres[0].MediaType)
+	require.Len(t, show.Seasons, 2)
+	assert.Equal(t, 10, show.Seasons[1].EpisodeCount)
+	assert.Equal(t, "2019-05-19", show.NextAirDate)
+	assert.Equal(t, 1399, show.NextEpisode.ShowID)
+}
+
+func TestClient_GetSeason(t *testing.T) {
+	client := newTestClient(t, map[string]string{"/tv/1399/season/1": `{
+		"season_number": 1, "name": "Season 1",
+		"episodes": [{"id": 63056, "name": "Winter Is Coming", "season_number": 1, "episode_number": 1, "runtime": 62}]
+	}`})
+
+	season, err := client.GetSeason(1399, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1399, season.ShowID)
+	require.Len(t, season.Episodes, 1)
+	assert.Equal(t, "Winter Is Coming", season.Episodes[0].Name)
+	assert.Equal(t, 1399, season.Episodes[0].ShowID)
+}
+
+func TestClient_NotFound(t *testing.T) {
+	client := newTestClient(t, nil)
+
+	_, err := client.GetEpisode(1399, 9, 1)
+
+	assert.ErrorIs(t, err, tmdb.ErrNotFound)
+}
+
+func TestGetSeason_CacheHit(t *testing.T) {
+	client, inner := newCachedClient(t)
+	inner.On("GetSeason", 1399, 1).Return(&models.Season{ShowID: 1399, SeasonNumber: 1}, nil).Once()
+	inner.On("GetSeason", 1399, 2).Return(&models.Season{ShowID: 1399, SeasonNumber: 2}, nil).Once()
+
+	first, _ := client.GetSeason(1399, 1)
+	second, _ := client.GetSeason(1399, 1)
+	other, _ := client.GetSeason(1399, 2)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 2, other.SeasonNumber)
+
+	inner.AssertNumberOfCalls(t, "GetSeason", 2)
+}
+
+func TestGetTVList_CacheHit(t *testing.T) {
+	client, inner := newCachedClient(t)
+	inner.On("GetTVList", tmdb.TVAiringToday, 1).Return([]models.Movie{{ID: 1399}}, nil).Once()
+	inner.On("GetTVList", tmdb.TVOnTheAir, 1).Return([]models.Movie{{ID: 66732}}, nil).Once()
+
+	first, _ := client.GetTVList(tmdb.TVAiringToday, 1)
+	second, _ := client.GetTVList(tmdb.TVAiringToday, 1)
+	onTheAir, _ := client.GetTVList(tmdb.TVOnTheAir, 1)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 66732, onTheAir[0].ID)
+
+	inner.AssertNumberOfCalls(t, "GetTVList", 2)
+}
+
+func TestClient_GetRecommendations_DefaultsMediaType(t *testing.T) {
+	client := newTestClient(t, map[string]string{"/tv/1399/recommendations": `{
+		"results": [{"id": 1402, "name": "The Walking Dead"}]
+	}`})
+
+	shows, err := client.GetRecommendations("tv", 1399, 1)
+	require.NoError(t, err)
+
+	require.Len(t, shows, 1)
+	assert.Equal(t, "tv", shows[0].MediaType)
+	assert.Equal(t, "The Walking Dead", shows[0].Title)
+}
+
+func TestClient_GetKeywords(t *testing.T) {
+	client := newTestClient(t, map[string]string{
+		"/movie/550/keywords": `{"id": 550, "keywords": [{"id": 1523, "name": "dual identity"}]}`,
+		"/tv/1399/keywords":   `{"id": 1399, "results": [{"id": 818, "name": "based on novel or book"}]}`,
+	})
+
+	movie, err := client.GetKeywords("movie", 550)
+	require.NoError(t, err)
+	tv, err := client.GetKeywords("tv", 1399)
+	require.NoError(t, err)
+
+	assert.Equal(t, []tmdb.Keyword{{ID: 1523, Name: "dual identity"}}, movie)
+	assert.Equal(t, []tmdb.Keyword{{ID: 818, Name: "based on novel or book"}}, tv)
+}